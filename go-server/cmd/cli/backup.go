@@ -0,0 +1,285 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/connector-recruitment/pkg/seal"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// runExport writes the server's ExportConnectors stream to a JSON Lines file:
+// the export header, then one connector per line. With --public-key each
+// connector's secret is sealed to that key, so the file can only be restored
+// by whoever holds the private key. The file is written under a temporary
+// name and renamed once complete.
+func runExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	file := fs.String("file", "-", "JSON Lines file to write, - for stdout")
+	addr := fs.String("addr", "localhost:"+getenv("GRPC_PORT", "50051"), "gRPC address of the connector service")
+	tenant := fs.String("tenant", "", "Export only this tenant's connectors")
+	publicKey := fs.String("public-key", "", "PEM file with the RSA public key to seal secrets to; secrets are left out without it")
+	actor := fs.String("actor", "", "Caller recorded in the audit log")
+	adminToken := fs.String("admin-token", os.Getenv("ADMIN_TOKEN"), "Admin bearer token the server requires, default $ADMIN_TOKEN")
+	_ = fs.Parse(args)
+
+	req := &connectorv1.ExportConnectorsRequest{TenantId: *tenant}
+	if *publicKey != "" {
+		pem, err := os.ReadFile(*publicKey)
+		if err != nil {
+			return err
+		}
+		if _, err := seal.ParsePublicKeyPEM(pem); err != nil {
+			return err
+		}
+		req.PublicKeyPem = string(pem)
+	}
+
+	conn, err := dialServer(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if *actor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-actor", *actor)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*adminToken)
+	stream, err := connectorv1.NewConnectorServiceClient(conn).ExportConnectors(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	output := os.Stdout
+	if *file != "-" {
+		f, err := os.OpenFile(*file+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+		output = f
+	}
+
+	w := bufio.NewWriter(output)
+	var exported int
+	for {
+		record, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("export failed: %w", err)
+		}
+		line, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(record)
+		if err != nil {
+			return err
+		}
+		w.Write(line)
+		w.WriteByte('\n')
+		if record.GetConnector() != nil {
+			exported++
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if output != os.Stdout {
+		if err := output.Sync(); err != nil {
+			return err
+		}
+		if err := os.Rename(output.Name(), *file); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d connectors\n", exported)
+	return nil
+}
+
+// runRestore sends an export written by runExport to the server's
+// RestoreConnectors RPC. Sealed secrets are opened here with --private-key,
+// so the private key never leaves the operator's machine.
+func runRestore(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	file := fs.String("file", "-", "JSON Lines export to restore, - for stdin")
+	addr := fs.String("addr", "localhost:"+getenv("GRPC_PORT", "50051"), "gRPC address of the connector service")
+	privateKey := fs.String("private-key", "", "PEM file with the RSA private key the export's secrets were sealed to")
+	actor := fs.String("actor", "", "Caller recorded in the audit log")
+	adminToken := fs.String("admin-token", os.Getenv("ADMIN_TOKEN"), "Admin bearer token the server requires, default $ADMIN_TOKEN")
+	_ = fs.Parse(args)
+
+	input := os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input = f
+	}
+	header, records, lines, err := readExportFile(input)
+	if err != nil {
+		return err
+	}
+
+	var key *rsa.PrivateKey
+	if *privateKey != "" {
+		pem, err := os.ReadFile(*privateKey)
+		if err != nil {
+			return err
+		}
+		if key, err = seal.ParsePrivateKeyPEM(pem); err != nil {
+			return err
+		}
+		if header.KeyFingerprint != "" && seal.Fingerprint(&key.PublicKey) != header.KeyFingerprint {
+			return fmt.Errorf("the private key does not match the key the export was sealed to")
+		}
+	} else if header.KeyFingerprint != "" {
+		return fmt.Errorf("the export contains sealed secrets; pass --private-key to restore them")
+	}
+
+	requests := make([]*connectorv1.RestoreConnectorsRequest, 0, len(records))
+	for i, record := range records {
+		req, err := openExportRecord(record, key)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lines[i], err)
+		}
+		requests = append(requests, req)
+	}
+
+	conn, err := dialServer(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if *actor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-actor", *actor)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*adminToken)
+	stream, err := connectorv1.NewConnectorServiceClient(conn).RestoreConnectors(ctx)
+	if err != nil {
+		return err
+	}
+	headerReq := &connectorv1.RestoreConnectorsRequest{
+		Record: &connectorv1.ExportRecord{Record: &connectorv1.ExportRecord_Header{Header: header}},
+	}
+	for _, req := range append([]*connectorv1.RestoreConnectorsRequest{headerReq}, requests...) {
+		if err := stream.Send(req); err != nil {
+			if err == io.EOF {
+				// The server ended the stream early; CloseAndRecv returns its error.
+				break
+			}
+			return err
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("restore failed: %w", err)
+	}
+
+	summary, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(res.Summary)
+	if err != nil {
+		return err
+	}
+	report := importReport{Results: make([]importLineResult, 0, len(res.Results)), Summary: summary}
+	for _, result := range res.Results {
+		report.Results = append(report.Results, importLineResult{
+			Line:        lines[result.Index],
+			WorkspaceID: result.WorkspaceId,
+			TenantID:    result.TenantId,
+			Status:      result.Status.String(),
+			ConnectorID: result.ConnectorId,
+			Error:       result.Error,
+		})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+
+	if failed := res.Summary.Invalid + res.Summary.Failed; failed > 0 {
+		return fmt.Errorf("%d of %d connectors could not be restored", failed, res.Summary.Total)
+	}
+	return nil
+}
+
+// readExportFile parses an export: the header on the first non-blank line,
+// then one connector per line, returned with their line numbers.
+func readExportFile(r io.Reader) (*connectorv1.ExportHeader, []*connectorv1.ExportedConnector, []int, error) {
+	var (
+		header  *connectorv1.ExportHeader
+		records []*connectorv1.ExportedConnector
+		lines   []int
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxImportLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		record := &connectorv1.ExportRecord{}
+		if err := protojson.Unmarshal(raw, record); err != nil {
+			return nil, nil, nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		switch {
+		case header == nil && record.GetHeader() != nil:
+			header = record.GetHeader()
+		case header == nil:
+			return nil, nil, nil, fmt.Errorf("line %d: the export must start with its header", line)
+		case record.GetConnector() != nil:
+			records = append(records, record.GetConnector())
+			lines = append(lines, line)
+		default:
+			return nil, nil, nil, fmt.Errorf("line %d: expected a connector record", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+	if header == nil {
+		return nil, nil, nil, fmt.Errorf("the export is empty")
+	}
+	return header, records, lines, nil
+}
+
+// openExportRecord turns an exported connector into a restore request,
+// replacing its sealed secret with the opened token.
+func openExportRecord(exported *connectorv1.ExportedConnector, key *rsa.PrivateKey) (*connectorv1.RestoreConnectorsRequest, error) {
+	req := &connectorv1.RestoreConnectorsRequest{}
+	if sealed := exported.GetSecret(); sealed != nil && key != nil {
+		id, err := uuid.Parse(exported.Id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid connector ID: %w", err)
+		}
+		token, err := seal.Open(key, &seal.Sealed{
+			WrappedKey: sealed.WrappedKey,
+			Nonce:      sealed.Nonce,
+			Ciphertext: sealed.Ciphertext,
+		}, []byte(id.String()))
+		if err != nil {
+			return nil, fmt.Errorf("connector %s: %w", exported.Id, err)
+		}
+		req.Token = string(token)
+	}
+
+	plain := proto.Clone(exported).(*connectorv1.ExportedConnector)
+	plain.Secret = nil
+	req.Record = &connectorv1.ExportRecord{Record: &connectorv1.ExportRecord_Connector{Connector: plain}}
+	return req, nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxImportLineSize bounds one JSON Lines record; tokens are the largest field.
+const maxImportLineSize = 64 * 1024
+
+// importLineResult is an ImportConnectorsResult tied back to its input line.
+type importLineResult struct {
+	Line        int    `json:"line"`
+	WorkspaceID string `json:"workspace_id"`
+	TenantID    string `json:"tenant_id"`
+	Status      string `json:"status"`
+	ConnectorID string `json:"connector_id,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+type importReport struct {
+	Results []importLineResult `json:"results"`
+	Summary json.RawMessage    `json:"summary"`
+}
+
+// runImport streams connectors from a JSON Lines file to the server's
+// ImportConnectors RPC. Each line holds the fields of a CreateConnector
+// request. The whole file is parsed before anything is sent, so a malformed
+// line does not leave a partial import behind.
+func runImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	file := fs.String("file", "-", "JSON Lines file to import, - for stdin")
+	addr := fs.String("addr", "localhost:"+getenv("GRPC_PORT", "50051"), "gRPC address of the connector service")
+	actor := fs.String("actor", "", "Caller recorded in the audit log")
+	_ = fs.Parse(args)
+
+	input := os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input = f
+	}
+	requests, lines, err := readImportFile(input)
+	if err != nil {
+		return err
+	}
+	if len(requests) == 0 {
+		return fmt.Errorf("no connectors to import")
+	}
+
+	conn, err := dialServer(*addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if *actor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-actor", *actor)
+	}
+	stream, err := connectorv1.NewConnectorServiceClient(conn).ImportConnectors(ctx)
+	if err != nil {
+		return err
+	}
+	for _, req := range requests {
+		if err := stream.Send(&connectorv1.ImportConnectorsRequest{Connector: req}); err != nil {
+			if err == io.EOF {
+				// The server ended the stream early; CloseAndRecv returns its error.
+				break
+			}
+			return err
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		// An import stopped part way sends what it did as an error detail.
+		for _, detail := range status.Convert(err).Details() {
+			if partial, ok := detail.(*connectorv1.ImportConnectorsResponse); ok {
+				if writeErr := writeImportReport(partial, lines); writeErr != nil {
+					return writeErr
+				}
+			}
+		}
+		return fmt.Errorf("import failed: %w", err)
+	}
+	if err := writeImportReport(res, lines); err != nil {
+		return err
+	}
+
+	if failed := res.Summary.Invalid + res.Summary.Failed; failed > 0 {
+		return fmt.Errorf("%d of %d connectors could not be imported", failed, res.Summary.Total)
+	}
+	return nil
+}
+
+// writeImportReport prints res as JSON, tying each result to its input line.
+func writeImportReport(res *connectorv1.ImportConnectorsResponse, lines []int) error {
+	summary, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(res.Summary)
+	if err != nil {
+		return err
+	}
+	report := importReport{Results: make([]importLineResult, 0, len(res.Results)), Summary: summary}
+	for _, result := range res.Results {
+		report.Results = append(report.Results, importLineResult{
+			Line:        lines[result.Index],
+			WorkspaceID: result.WorkspaceId,
+			TenantID:    result.TenantId,
+			Status:      result.Status.String(),
+			ConnectorID: result.ConnectorId,
+			Error:       result.Error,
+		})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// readImportFile parses one CreateConnectorRequest per non-blank line and
+// returns them with their line numbers.
+func readImportFile(r io.Reader) ([]*connectorv1.CreateConnectorRequest, []int, error) {
+	var (
+		requests []*connectorv1.CreateConnectorRequest
+		lines    []int
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxImportLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		record := bytes.TrimSpace(scanner.Bytes())
+		if len(record) == 0 {
+			continue
+		}
+		req := &connectorv1.CreateConnectorRequest{}
+		if err := protojson.Unmarshal(record, req); err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		requests = append(requests, req)
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return requests, lines, nil
+}
+
+func getenv(key, defaultVal string) string {
+	if val, ok := os.LookupEnv(key); ok {
+		return val
+	}
+	return defaultVal
+}
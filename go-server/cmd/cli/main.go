@@ -2,62 +2,155 @@ package main
 
 import (
 	"context"
-	"flag"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/connector-recruitment/internal/app/config"
+	appConnector "github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
 	awsSM "github.com/connector-recruitment/internal/infrastructure/aws/secretsmanager"
 	pgRepo "github.com/connector-recruitment/internal/infrastructure/postgres"
-	slackInfra "github.com/connector-recruitment/internal/infrastructure/slack"
 	"github.com/connector-recruitment/pkg/logger"
-	"github.com/connector-recruitment/pkg/slackutil"
+	"github.com/connector-recruitment/pkg/observability"
+	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, args []string) error
+}
+
+var commands = []command{
+	{name: "send", usage: "send a Slack message through a connector", run: runSend},
+	{name: "migrate-secrets", usage: "move connector secrets to the UUID based naming scheme", run: runMigrateSecrets},
+	{name: "reconcile", usage: "report drift between connectors and secrets, optionally repairing it", run: runReconcile},
+	{name: "verify-audit", usage: "check the audit log hash chain for tampering", run: runVerifyAudit},
+	{name: "import", usage: "create connectors in bulk from a JSON Lines file", run: runImport},
+	{name: "export", usage: "back up connectors, optionally with sealed secrets, to a JSON Lines file", run: runExport},
+	{name: "restore", usage: "recreate connectors from an export", run: runRestore},
+	{name: "migrate", usage: "apply, revert, list or create database schema migrations", run: runMigrate},
+}
+
 func main() {
-	connectorID := flag.String("connector-id", "", "Connector ID")
-	message := flag.String("message", "", "Message to send")
-	flag.Parse()
+	args := os.Args[1:]
+
+	// Without a subcommand the CLI keeps its original behaviour of sending a message.
+	name := "send"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == name {
+			ctx := context.Background()
+			shutdown := initTelemetry(ctx)
+			err := cmd.run(ctx, args)
+			shutdown()
+			if err != nil {
+				logger.Fatal().Err(err).Str("command", name).Msg("command failed")
+			}
+			return
+		}
+	}
 
-	if *connectorID == "" || *message == "" {
-		logger.Fatal().Msg("Both --connector-id and --message must be provided")
+	fmt.Fprintf(os.Stderr, "unknown command %q\n\nCommands:\n", name)
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-16s %s\n", cmd.name, cmd.usage)
 	}
+	os.Exit(2)
+}
+
+type dependencies struct {
+	cfg            *config.Config
+	db             *sqlx.DB
+	secretsManager domain.SecretsManager
+	connectors     domain.ConnectorRepository
+}
 
+func loadDependencies(ctx context.Context) (*dependencies, error) {
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		logger.Fatal().Err(err).Msg("failed to load config")
+		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
-	ctx := context.Background()
-
-	db, err := pgRepo.InitDb(ctx, cfg.DBDSN)
+	db, err := pgRepo.InitDb(ctx, cfg.DBDSN,
+		pgRepo.WithPool(cfg.DBMaxOpenConns, cfg.DBMaxIdleConns, cfg.DBConnMaxLifetime),
+	)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("failed to initialize database")
+		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
-	defer db.Close()
 
 	awsCfg, err := config.LoadAWSConfig(cfg.AWSRegion, cfg.AWSEndpoint)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("failed to load AWS config")
+		db.Close()
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
 	}
 	smClient := awsSM.NewClient(awsCfg,
 		awsSM.WithRetryMaxAttempts(cfg.AWSRetryMaxAttempts),
 		awsSM.WithRetryMaxBackoff(cfg.AWSRetryMaxBackoff),
 	)
 
-	slackClient := slackInfra.NewSlackClient(
-		cfg.SlackBaseURL,
-		cfg.SlackClientID,
-		cfg.SlackClientSecret,
-		cfg.SlackRedirectURL,
-		cfg.SlackScopes,
-		slackInfra.WithRetry(cfg.SlackRetryMax, cfg.SlackRetryWait),
-		slackInfra.WithRateLimit(cfg.SlackRateLimitRPS, cfg.SlackRateLimitBurst),
-	)
+	// Changes made from the CLI go through the outbox too; the server relays them.
+	var connectors domain.ConnectorRepository = pgRepo.NewConnectorRepository(db)
+	if cfg.OutboxEnabled() {
+		connectors = appConnector.NewEventingRepository(connectors, pgRepo.NewTransactor(db),
+			pgRepo.NewOutboxRepository(db), cfg.EventSource)
+	}
+
+	return &dependencies{
+		cfg:            cfg,
+		db:             db,
+		secretsManager: smClient,
+		connectors:     connectors,
+	}, nil
+}
 
-	err = slackutil.SendSlackMessage(ctx, *connectorID, *message, db, smClient, slackClient)
+// initTelemetry configures logging from the LOG_* environment variables and
+// sets up OpenTelemetry from the OTEL_* ones so CLI calls show up in the same
+// traces as the server work they cause. It returns the function that flushes
+// the providers.
+func initTelemetry(ctx context.Context) func() {
+	loggingCfg, err := config.LoadLoggingConfig()
 	if err != nil {
-		logger.Fatal().Err(err).Msg("failed to send Slack message")
+		logger.Fatal().Err(err).Msg("failed to load logging configuration")
+	}
+	if err := logger.Configure(logger.Options{
+		Level:        loggingCfg.Level,
+		Format:       loggingCfg.Format,
+		RedactFields: loggingCfg.RedactFields,
+	}); err != nil {
+		logger.Fatal().Err(err).Msg("failed to configure logging")
 	}
+	telemetryCfg, err := config.LoadTelemetryConfig("connector-recruitment-cli")
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to load telemetry configuration")
+	}
+	telemetry, err := observability.InitTelemetry(ctx, telemetryCfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to initialize telemetry")
+	}
+	return func() {
+		if err := telemetry.Shutdown(ctx); err != nil {
+			logger.Error().Err(err).Msg("error shutting down telemetry providers")
+		}
+	}
+}
 
-	logger.Info().Msg("Slack message sent successfully")
+// dialServer connects to the connector service's gRPC port, propagating the
+// CLI's trace context.
+func dialServer(addr string) (*grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	return conn, nil
 }
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+
+	pgRepo "github.com/connector-recruitment/internal/infrastructure/postgres"
+	"github.com/connector-recruitment/migrations"
+)
+
+const migrateUsage = "usage: migrate up | down [-steps N] | status | create [-dir DIR] NAME"
+
+func runMigrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	sub, args := args[0], args[1:]
+	if sub == "create" {
+		return runMigrateCreate(args)
+	}
+
+	fs := flag.NewFlagSet("migrate "+sub, flag.ExitOnError)
+	dsn := fs.String("dsn", os.Getenv("DB_DSN"), "Postgres connection string; defaults to DB_DSN")
+	steps := fs.Int("steps", 1, "Number of migrations to revert (down only)")
+	_ = fs.Parse(args)
+	if *dsn == "" {
+		return errors.New("no database: set DB_DSN or pass -dsn")
+	}
+
+	db, err := pgRepo.InitDb(ctx, *dsn)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer db.Close()
+
+	migrator, err := pgRepo.NewMigrator(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	switch sub {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied %03d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+	case "down":
+		if *steps < 1 {
+			return errors.New("-steps must be at least 1")
+		}
+		reverted, err := migrator.Down(ctx, *steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %03d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("no migrations to revert")
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.UTC().Format("2006-01-02 15:04:05Z")
+			}
+			if s.Unknown {
+				appliedAt += " (unknown to this binary)"
+			}
+			fmt.Fprintf(w, "%03d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9]+`)
+
+// runMigrateCreate writes an empty up/down pair numbered after the newest
+// migration in dir. The binary must be rebuilt to embed it.
+func runMigrateCreate(args []string) error {
+	fs := flag.NewFlagSet("migrate create", flag.ExitOnError)
+	dir := fs.String("dir", "migrations", "Directory holding the migration files")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New(migrateUsage)
+	}
+	name := strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(fs.Arg(0)), "_"), "_")
+	if name == "" {
+		return fmt.Errorf("invalid migration name %q", fs.Arg(0))
+	}
+
+	existing, err := pgRepo.LoadMigrations(os.DirFS(*dir))
+	if err != nil {
+		return err
+	}
+	version := 1
+	if len(existing) > 0 {
+		version = existing[len(existing)-1].Version + 1
+	}
+
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(*dir, fmt.Sprintf("%03d_%s.%s.sql", version, name, direction))
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(file, "-- %03d_%s (%s)\n", version, name, direction)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Println("created", path)
+	}
+	return nil
+}
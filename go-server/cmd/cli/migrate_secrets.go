@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	appConnector "github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+func runMigrateSecrets(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("migrate-secrets", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Only report which connectors would be migrated")
+	deleteOld := fs.Bool("delete-old", false, "Delete the old secret once a connector has been switched")
+	_ = fs.Parse(args)
+
+	deps, err := loadDependencies(ctx)
+	if err != nil {
+		return err
+	}
+	defer deps.db.Close()
+
+	var opts []appConnector.SecretMigratorOption
+	if *dryRun {
+		opts = append(opts, appConnector.WithDryRun())
+	}
+	if *deleteOld {
+		opts = append(opts, appConnector.WithDeleteOldSecrets())
+	}
+
+	migrator := appConnector.NewSecretMigrator(
+		deps.connectors,
+		deps.secretsManager,
+		domain.NewSecretNaming(deps.cfg.SecretNamePrefix, deps.cfg.Environment),
+		opts...,
+	)
+
+	report, err := migrator.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.Info().
+		Int("migrated", report.Migrated).
+		Int("skipped", report.Skipped).
+		Int("failed", report.Failed).
+		Bool("dry_run", *dryRun).
+		Msg("Secret migration finished")
+	if report.Failed > 0 {
+		return fmt.Errorf("%d connectors could not be migrated", report.Failed)
+	}
+	return nil
+}
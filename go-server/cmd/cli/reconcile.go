@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+
+	appConnector "github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+)
+
+func runReconcile(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	repair := fs.Bool("repair", false, "Schedule orphaned secrets for deletion and mark connectors without a secret as errored")
+	failOnDrift := fs.Bool("fail-on-drift", false, "Exit non-zero when drift is found")
+	_ = fs.Parse(args)
+
+	deps, err := loadDependencies(ctx)
+	if err != nil {
+		return err
+	}
+	defer deps.db.Close()
+
+	reconciler := appConnector.NewDriftReconciler(
+		deps.connectors,
+		deps.secretsManager,
+		domain.NewSecretNaming(deps.cfg.SecretNamePrefix, deps.cfg.Environment),
+		deps.cfg.DriftGracePeriod,
+	)
+
+	report, err := reconciler.Run(ctx, *repair)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+
+	if len(report.RepairErrors) > 0 {
+		return errors.New("some drift could not be repaired")
+	}
+	if *failOnDrift && report.HasDrift() {
+		return errors.New("drift detected")
+	}
+	return nil
+}
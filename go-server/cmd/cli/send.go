@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	appConnector "github.com/connector-recruitment/internal/app/connector"
+	pgRepo "github.com/connector-recruitment/internal/infrastructure/postgres"
+	slackInfra "github.com/connector-recruitment/internal/infrastructure/slack"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/requestctx"
+	"github.com/connector-recruitment/pkg/slackutil"
+)
+
+func runSend(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("send", flag.ExitOnError)
+	connectorID := fs.String("connector-id", "", "Connector ID")
+	message := fs.String("message", "", "Message to send")
+	actor := fs.String("actor", "", "Caller recorded in the audit log")
+	_ = fs.Parse(args)
+
+	if *connectorID == "" || *message == "" {
+		return errors.New("both --connector-id and --message must be provided")
+	}
+
+	deps, err := loadDependencies(ctx)
+	if err != nil {
+		return err
+	}
+	defer deps.db.Close()
+
+	cfg := deps.cfg
+	slackClient := slackInfra.NewSlackClient(
+		cfg.SlackBaseURL,
+		cfg.SlackClientID,
+		cfg.SlackClientSecret,
+		cfg.SlackRedirectURL,
+		cfg.SlackScopes,
+		slackInfra.WithRetry(cfg.SlackRetryMax, cfg.SlackRetryWait),
+		slackInfra.WithRateLimit(cfg.SlackRateLimitRPS, cfg.SlackRateLimitBurst),
+	)
+
+	// The token read is audited directly, as the CLI bypasses the server.
+	if *actor != "" {
+		ctx = requestctx.WithActor(ctx, requestctx.AssertedActor(*actor))
+	}
+	audit := appConnector.NewAuditService(pgRepo.NewAuditRepository(deps.db))
+	if err := slackutil.SendSlackMessage(ctx, *connectorID, *message, deps.db, deps.secretsManager, slackClient, audit); err != nil {
+		return fmt.Errorf("failed to send Slack message: %w", err)
+	}
+
+	logger.Info().Msg("Slack message sent successfully")
+	return nil
+}
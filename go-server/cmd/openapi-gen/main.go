@@ -0,0 +1,25 @@
+// Command openapi-gen writes the OpenAPI document for the REST gateway. It is
+// run by go generate in internal/transport/http; the output is committed and
+// embedded in the server binary.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	httpTransport "github.com/connector-recruitment/internal/transport/http"
+)
+
+func main() {
+	out := flag.String("out", "openapi.json", "file to write the document to")
+	flag.Parse()
+
+	b, err := httpTransport.GenerateOpenAPISpec()
+	if err != nil {
+		log.Fatalf("failed to generate OpenAPI document: %v", err)
+	}
+	if err := os.WriteFile(*out, b, 0o644); err != nil {
+		log.Fatalf("failed to write %s: %v", *out, err)
+	}
+}
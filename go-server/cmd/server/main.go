@@ -6,21 +6,28 @@ import (
 	"github.com/connector-recruitment/internal/infrastructure/redis"
 	"github.com/connector-recruitment/pkg/resilience"
 	"net/http"
+	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/connector-recruitment/internal/app/config"
 	appConnector "github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/app/health"
+	"github.com/connector-recruitment/internal/domain"
 	awsSM "github.com/connector-recruitment/internal/infrastructure/aws/secretsmanager"
+	natsInfra "github.com/connector-recruitment/internal/infrastructure/nats"
 	pgRepo "github.com/connector-recruitment/internal/infrastructure/postgres"
 	slackInfra "github.com/connector-recruitment/internal/infrastructure/slack"
+	webhookInfra "github.com/connector-recruitment/internal/infrastructure/webhook"
 	grpcTransport "github.com/connector-recruitment/internal/transport/grpc"
 	httpTransport "github.com/connector-recruitment/internal/transport/http"
+	"github.com/connector-recruitment/migrations"
 	"github.com/connector-recruitment/pkg/logger"
 	"github.com/connector-recruitment/pkg/observability"
 
 	_ "github.com/lib/pq"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 func main() {
@@ -28,62 +35,226 @@ func main() {
 	if err != nil {
 		logger.Fatal().Err(err).Msg("failed to load configuration")
 	}
+	if err := logger.Configure(logger.Options{
+		Level:        cfg.Logging.Level,
+		Format:       cfg.Logging.Format,
+		RedactFields: cfg.Logging.RedactFields,
+	}); err != nil {
+		logger.Fatal().Err(err).Msg("failed to configure logging")
+	}
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	tp, err := observability.InitTracer(ctx, "connector-recruitment")
+	telemetry, err := observability.InitTelemetry(ctx, cfg.Telemetry)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("failed to initialize tracer")
+		logger.Fatal().Err(err).Msg("failed to initialize telemetry")
 	}
 	defer func() {
-		if shutdownErr := observability.ShutdownTracer(ctx, tp); shutdownErr != nil {
-			logger.Error().Err(shutdownErr).Msg("error shutting down tracer provider")
+		if shutdownErr := telemetry.Shutdown(ctx); shutdownErr != nil {
+			logger.Error().Err(shutdownErr).Msg("error shutting down telemetry providers")
 		}
 	}()
 
-	db, err := pgRepo.InitDb(ctx, cfg.DBDSN)
+	var metrics *observability.Metrics
+	if cfg.MetricsEnabled {
+		metrics = observability.NewMetrics()
+	}
+
+	db, err := pgRepo.InitDb(ctx, cfg.DBDSN,
+		pgRepo.WithPool(cfg.DBMaxOpenConns, cfg.DBMaxIdleConns, cfg.DBConnMaxLifetime),
+	)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("failed to initialize database")
 	}
 	defer db.Close()
+	metrics.RegisterDB("connectors", db.DB)
+
+	if cfg.MigrateOnStart {
+		migrator, err := pgRepo.NewMigrator(db, migrations.FS)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to load migrations")
+		}
+		if _, err := migrator.Up(ctx); err != nil {
+			logger.Fatal().Err(err).Msg("failed to apply migrations")
+		}
+	}
 
-	redisClient, err := redis.IntClient(ctx, cfg.RedisAddr)
+	redisClient, err := redis.IntClient(ctx, cfg.RedisAddr, redis.WithDB(cfg.RedisDB))
 	if err != nil {
 		logger.Fatal().Err(err).Msg("failed to connect to Redis")
 	}
 	defer redisClient.Close()
+	metrics.InstrumentRedis(redisClient)
 
 	awsCfg, err := config.LoadAWSConfig(cfg.AWSRegion, cfg.AWSEndpoint)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("failed to load AWS config")
 	}
-	smClient := awsSM.NewClient(awsCfg,
+	awsSMClient := awsSM.NewClient(awsCfg,
 		awsSM.WithRetryMaxAttempts(cfg.AWSRetryMaxAttempts),
 		awsSM.WithRetryMaxBackoff(cfg.AWSRetryMaxBackoff),
 	)
+	smClient := observability.InstrumentSecretsManager(awsSMClient, metrics)
 
-	slackClient := slackInfra.NewSlackClient(
+	slackOpts := []slackInfra.ClientOption{
+		slackInfra.WithRetry(cfg.SlackRetryMax, cfg.SlackRetryWait),
+		slackInfra.WithRateLimit(cfg.SlackRateLimitRPS, cfg.SlackRateLimitBurst),
+		slackInfra.WithMetrics(metrics),
+	}
+	if cfg.SlackRateLimitBackend == "redis" {
+		slackOpts = append(slackOpts,
+			slackInfra.WithSharedRateLimit(redis.NewRateLimiter(redisClient, "slack:ratelimit:")))
+	}
+	baseSlackClient := slackInfra.NewSlackClient(
 		cfg.SlackBaseURL,
 		cfg.SlackClientID,
 		cfg.SlackClientSecret,
 		cfg.SlackRedirectURL,
 		cfg.SlackScopes,
-		slackInfra.WithRetry(cfg.SlackRetryMax, cfg.SlackRetryWait),
-		slackInfra.WithRateLimit(cfg.SlackRateLimitRPS, cfg.SlackRateLimitBurst),
+		slackOpts...,
 	)
+	slackClient := observability.InstrumentSlackClient(baseSlackClient, metrics)
 
-	repository := pgRepo.NewConnectorRepository(db)
+	healthChecks := []health.Check{
+		{Name: health.CheckPostgres, Critical: true, Probe: db.PingContext},
+		{Name: health.CheckRedis, Critical: true, Probe: func(ctx context.Context) error {
+			return redisClient.Ping(ctx).Err()
+		}},
+		health.PingCheck(health.CheckSecretsManager, true, awsSMClient),
+	}
+	if cfg.HealthSlackCheckEnabled {
+		// Slack outages degrade sending but do not make the service unready.
+		healthChecks = append(healthChecks, health.PingCheck(health.CheckSlack, false, baseSlackClient))
+	}
+	healthMonitor := health.NewMonitor(healthChecks, cfg.HealthCheckInterval, health.WithTimeout(cfg.HealthCheckTimeout))
+	go healthMonitor.Start(ctx)
+
+	repository := observability.InstrumentConnectorRepository(pgRepo.NewConnectorRepository(db), metrics)
+	publisher, closePublisher, err := newEventPublisher(ctx, cfg, redisClient)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to initialize event publisher")
+	}
+	defer closePublisher()
+	webhookSubscriptions := pgRepo.NewWebhookSubscriptionRepository(db)
+	webhookDeliveries := pgRepo.NewWebhookDeliveryRepository(db)
+	if cfg.WebhooksEnabled {
+		var senderOpts []webhookInfra.SenderOption
+		if cfg.WebhookAllowInsecureURLs {
+			senderOpts = append(senderOpts, webhookInfra.WithPrivateAddresses())
+		}
+		publisher = appConnector.NewFanoutPublisher(publisher,
+			appConnector.NewWebhookDispatcher(webhookSubscriptions, webhookDeliveries))
+		webhookWorker := appConnector.NewWebhookDeliveryWorker(webhookSubscriptions, webhookDeliveries,
+			webhookInfra.NewSender(cfg.WebhookTimeout, senderOpts...), cfg.WebhookDeliveryInterval,
+			appConnector.WithWebhookRetryPolicy(cfg.WebhookMaxAttempts, cfg.WebhookBackoffBase, cfg.WebhookBackoffMax),
+			appConnector.WithWebhookDisableAfter(cfg.WebhookDisableAfterFailures))
+		go webhookWorker.Start(ctx)
+	}
+	if publisher == nil && cfg.WatchEnabled {
+		// Nothing to publish to, but the relay still has to prune the outbox.
+		publisher = appConnector.DiscardPublisher{}
+	}
+	var watcher *appConnector.ConnectorWatcher
+	if cfg.WatchEnabled {
+		outboxListener, err := pgRepo.NewOutboxListener(cfg.DBDSN)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to listen for outbox notifications")
+		}
+		defer outboxListener.Close()
+		watcher = appConnector.NewConnectorWatcher(pgRepo.NewEventLog(db), cfg.WatchPollInterval,
+			appConnector.WithWatchNotifications(outboxListener.Notifications()),
+			appConnector.WithWatchHeartbeat(cfg.WatchHeartbeat),
+			appConnector.WithWatchBufferSize(cfg.WatchBufferSize))
+		go watcher.Start(ctx)
+	}
+	if publisher != nil {
+		outbox := pgRepo.NewOutboxRepository(db)
+		repository = appConnector.NewEventingRepository(repository, pgRepo.NewTransactor(db), outbox, cfg.EventSource)
+		outboxRelay := appConnector.NewOutboxRelay(outbox, publisher, cfg.OutboxRelayInterval)
+		go outboxRelay.Start(ctx)
+	}
+	tenantRepository := pgRepo.NewTenantRepository(db)
+	operationLog := pgRepo.NewOperationRepository(db)
+	auditLog := pgRepo.NewAuditRepository(db)
+	secretNaming := domain.NewSecretNaming(cfg.SecretNamePrefix, cfg.Environment)
 	oauthManager := appConnector.NewOAuthStateManager(redisClient, cfg.OAuthStateTimeout)
-	service := appConnector.NewService(repository, smClient, slackClient,
-		resilience.New("github.com/connector-recruitment", cfg), appConnector.WithOAuthManager(oauthManager))
+	breakers := resilience.NewRegistry(cfg,
+		resilience.WithMetrics(metrics),
+		resilience.WithClientErrors(appConnector.IsClientError),
+		resilience.WithClientErrors(slackInfra.IsClientError),
+	)
+	for _, name := range []string{resilience.BreakerSlack, resilience.BreakerSecretsManager, resilience.BreakerPostgres} {
+		breakers.Get(name)
+	}
+	service := appConnector.NewService(repository, smClient, slackClient, breakers,
+		appConnector.WithSlackBreakerPerWorkspace(cfg.CircuitBreakerSlackPerWorkspace),
+		appConnector.WithOAuthManager(oauthManager),
+		appConnector.WithTenantRepository(tenantRepository),
+		appConnector.WithIdempotencyStore(pgRepo.NewIdempotencyRepository(db)),
+		appConnector.WithSecretNaming(secretNaming),
+		appConnector.WithOperationLog(operationLog),
+		appConnector.WithAuditLog(auditLog))
+	tenantService := appConnector.NewTenantService(tenantRepository)
+	auditService := appConnector.NewAuditService(auditLog)
+	webhookService := appConnector.NewWebhookService(webhookSubscriptions, webhookDeliveries, tenantRepository,
+		cfg.WebhookAllowInsecureURLs)
 
-	grpcServer, lis, err := grpcTransport.NewServer(service, cfg.GRPCPort)
+	tenantDeletionJob := appConnector.NewTenantDeletionJob(tenantRepository, repository, smClient, cfg.TenantDeletionInterval)
+	go tenantDeletionJob.Start(ctx)
+
+	operationReconciler := appConnector.NewOperationReconciler(operationLog, repository, smClient,
+		cfg.OperationReconcileInterval, cfg.OperationGracePeriod)
+	go operationReconciler.Start(ctx)
+
+	driftReconciler := appConnector.NewDriftReconciler(repository, smClient, secretNaming, cfg.DriftGracePeriod)
+	go driftReconciler.Start(ctx, cfg.DriftReconcileInterval, cfg.DriftReconcileRepair)
+
+	purgeJob := appConnector.NewPurgeJob(repository, cfg.PurgeInterval)
+	go purgeJob.Start(ctx)
+
+	handlerOpts := []grpcTransport.HandlerOption{
+		grpcTransport.WithMetrics(metrics),
+		grpcTransport.WithHealth(healthMonitor),
+		grpcTransport.WithCircuitBreakers(breakers),
+		grpcTransport.WithAdminToken(cfg.AdminToken),
+		grpcTransport.WithWatcher(watcher),
+		grpcTransport.WithImporter(appConnector.NewImporter(service,
+			appConnector.WithImportConcurrency(cfg.ImportConcurrency),
+			appConnector.WithImportMaxItems(cfg.ImportMaxItems))),
+	}
+	grpcTransport.SetRateLimit(cfg.RPCRateLimitRPS, cfg.RPCRateLimitBurst)
+	grpcServer, lis, err := grpcTransport.NewServer(service, tenantService, auditService, webhookService,
+		cfg.GRPCPort, handlerOpts...)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("failed to create gRPC server")
 	}
 
-	httpServer := httpTransport.NewHTTPServer(service, oauthManager, cfg)
+	gateway, closeGateway, err := httpTransport.NewGateway(ctx, "localhost:"+cfg.GRPCPort)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to create REST gateway")
+	}
+	defer func() { _ = closeGateway() }()
+
+	connectPath, connectHandler := grpcTransport.NewConnectHandler(grpcTransport.NewHandler(service, handlerOpts...))
+	httpOpts := []httpTransport.ServerOption{
+		httpTransport.WithGateway(gateway),
+		httpTransport.WithConnectHandler(connectPath, connectHandler),
+		httpTransport.WithHealth(healthMonitor),
+	}
+	if metrics != nil {
+		httpOpts = append(httpOpts, httpTransport.WithMetricsHandler(metrics.Handler()))
+	}
+	httpServer := httpTransport.NewHTTPServer(service, oauthManager, cfg, httpOpts...)
+
+	go reloadOnSIGHUP(ctx, cfg, func(settings config.Reloadable) {
+		if err := logger.SetLevel(settings.LogLevel); err != nil {
+			logger.Error().Err(err).Msg("failed to apply log level")
+		}
+		baseSlackClient.SetRateLimit(settings.SlackRateLimitRPS, settings.SlackRateLimitBurst)
+		grpcTransport.SetRateLimit(settings.RPCRateLimitRPS, settings.RPCRateLimitBurst)
+		breakers.SetMaxFailures(settings.CircuitBreakerMaxFailures)
+	})
 
 	errCh := make(chan error, 2)
 
@@ -109,6 +280,12 @@ func main() {
 		logger.Error().Err(serverErr).Msg("Server encountered an error; shutting down...")
 	}
 
+	healthMonitor.Drain()
+	if cfg.HealthDrainDelay > 0 {
+		logger.Info().Dur("delay", cfg.HealthDrainDelay).Msg("Marked not ready; waiting before stopping servers")
+		time.Sleep(cfg.HealthDrainDelay)
+	}
+
 	go func() {
 		logger.Info().Msg("Attempting gRPC graceful stop")
 		grpcServer.GracefulStop()
@@ -126,3 +303,49 @@ func main() {
 
 	logger.Info().Msg("All servers stopped. Exiting.")
 }
+
+// reloadOnSIGHUP reloads the configuration on every SIGHUP and passes the
+// reloadable settings to apply. An invalid configuration is logged and the
+// running settings are kept. Changes that need a restart are compared with
+// the startup configuration, which is what still runs, and only logged.
+func reloadOnSIGHUP(ctx context.Context, startup *config.Config, apply func(config.Reloadable)) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		}
+
+		next, err := config.LoadConfig()
+		if err != nil {
+			logger.Error().Err(err).Msg("Configuration reload failed; keeping the current settings")
+			continue
+		}
+		if changed := startup.RestartRequired(next); len(changed) > 0 {
+			logger.Warn().Strs("fields", changed).Msg("Configuration changes ignored until restart")
+		}
+		settings := next.Reloadable()
+		apply(settings)
+		logger.Info().Interface("settings", settings).Msg("Configuration reloaded")
+	}
+}
+
+// newEventPublisher returns nil when event publishing is turned off.
+func newEventPublisher(ctx context.Context, cfg *config.Config, redisClient *goredis.Client) (domain.EventPublisher, func(), error) {
+	switch cfg.EventPublisher {
+	case "nats":
+		publisher, err := natsInfra.NewJetStreamPublisher(ctx, cfg.NATSURL, cfg.NATSStream, cfg.NATSSubjectPrefix, cfg.NATSDedupeWindow)
+		if err != nil {
+			return nil, nil, err
+		}
+		return publisher, publisher.Close, nil
+	case "redis":
+		return redis.NewStreamPublisher(redisClient, cfg.RedisEventStream, cfg.RedisEventStreamMaxLen), func() {}, nil
+	default:
+		return nil, func() {}, nil
+	}
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/joho/godotenv"
+	"github.com/rs/zerolog"
+)
+
+// LoggingConfig configures pkg/logger. Like TelemetryConfig it is read on its
+// own so the CLI can use it without the server's required settings.
+type LoggingConfig struct {
+	// Level is the minimum level logged: trace, debug, info, warn or error.
+	Level string
+	// Format is console (colorized, for humans) or json (one object per line).
+	Format string
+	// RedactFields are extra field names scrubbed from every event.
+	RedactFields []string
+	// LevelEndpointEnabled serves /debug/log-level to read and change the
+	// level at runtime.
+	LevelEndpointEnabled bool
+}
+
+// LoadLoggingConfig reads LOG_LEVEL, LOG_FORMAT, LOG_REDACT_FIELDS and
+// LOG_LEVEL_ENDPOINT_ENABLED.
+func LoadLoggingConfig() (LoggingConfig, error) {
+	_ = godotenv.Load()
+
+	s, err := newSource()
+	if err != nil {
+		return LoggingConfig{}, err
+	}
+	cfg := loadLoggingConfig(s)
+	if err := s.err(); err != nil {
+		return LoggingConfig{}, err
+	}
+	return cfg, nil
+}
+
+func loadLoggingConfig(s *source) LoggingConfig {
+	cfg := LoggingConfig{
+		Level:                strings.ToLower(s.getString("LOG_LEVEL", "info")),
+		Format:               strings.ToLower(s.getString("LOG_FORMAT", "console")),
+		RedactFields:         s.getList("LOG_REDACT_FIELDS"),
+		LevelEndpointEnabled: s.getBool("LOG_LEVEL_ENDPOINT_ENABLED", false),
+	}
+
+	if level, err := zerolog.ParseLevel(cfg.Level); err != nil || level == zerolog.NoLevel {
+		s.fail("unsupported LOG_LEVEL %q", cfg.Level)
+	}
+	switch cfg.Format {
+	case "console", "json":
+	default:
+		s.fail("unsupported LOG_FORMAT %q (expected console or json)", cfg.Format)
+	}
+	return cfg
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Reloadable holds the settings a running server applies on SIGHUP without
+// restarting.
+type Reloadable struct {
+	LogLevel                  string
+	SlackRateLimitRPS         float64
+	SlackRateLimitBurst       int
+	RPCRateLimitRPS           float64
+	RPCRateLimitBurst         int
+	CircuitBreakerMaxFailures int
+}
+
+func (c *Config) Reloadable() Reloadable {
+	return Reloadable{
+		LogLevel:                  c.Logging.Level,
+		SlackRateLimitRPS:         c.SlackRateLimitRPS,
+		SlackRateLimitBurst:       c.SlackRateLimitBurst,
+		RPCRateLimitRPS:           c.RPCRateLimitRPS,
+		RPCRateLimitBurst:         c.RPCRateLimitBurst,
+		CircuitBreakerMaxFailures: c.CircuitBreakerMaxFailures,
+	}
+}
+
+// RestartRequired lists the fields that differ between c and next but only
+// take effect after a restart.
+func (c *Config) RestartRequired(next *Config) []string {
+	current, updated := c.withoutReloadable(), next.withoutReloadable()
+	currentValue, updatedValue := reflect.ValueOf(current), reflect.ValueOf(updated)
+
+	var changed []string
+	for i := 0; i < currentValue.NumField(); i++ {
+		if !reflect.DeepEqual(currentValue.Field(i).Interface(), updatedValue.Field(i).Interface()) {
+			changed = append(changed, currentValue.Type().Field(i).Name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+func (c *Config) withoutReloadable() Config {
+	cfg := *c
+	cfg.Logging.Level = ""
+	cfg.SlackRateLimitRPS, cfg.SlackRateLimitBurst = 0, 0
+	cfg.RPCRateLimitRPS, cfg.RPCRateLimitBurst = 0, 0
+	cfg.CircuitBreakerMaxFailures = 0
+	return cfg
+}
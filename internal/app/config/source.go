@@ -0,0 +1,194 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// source resolves each setting from the environment first, then from the
+// YAML file named by CONFIG_FILE, then from its default. Malformed values
+// are collected as errors instead of silently falling back to the default.
+//
+// File keys nest and are joined with underscores, so
+//
+//	slack:
+//	  retry_max: 5
+//
+// sets SLACK_RETRY_MAX. Lists may be YAML sequences; key=value settings such
+// as OTEL_RESOURCE_ATTRIBUTES are written as strings, as in the environment.
+type source struct {
+	path string
+	file map[string]string
+	used map[string]bool
+	errs []error
+}
+
+func newSource() (*source, error) {
+	s := &source{
+		path: os.Getenv("CONFIG_FILE"),
+		file: make(map[string]string),
+		used: make(map[string]bool),
+	}
+	if s.path == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file: %w", err)
+	}
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse config file %s: %w", s.path, err)
+	}
+	if err := flatten("", doc, s.file); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", s.path, err)
+	}
+	return s, nil
+}
+
+func flatten(prefix string, doc map[string]interface{}, out map[string]string) error {
+	for key, value := range doc {
+		name := strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(key))
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if err := flatten(name, v, out); err != nil {
+				return err
+			}
+		case []interface{}:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				if _, nested := item.(map[string]interface{}); nested {
+					return fmt.Errorf("%s: list items must be plain values", name)
+				}
+				items = append(items, fmt.Sprint(item))
+			}
+			out[name] = strings.Join(items, ",")
+		case nil:
+			out[name] = ""
+		default:
+			out[name] = fmt.Sprint(v)
+		}
+	}
+	return nil
+}
+
+func (s *source) lookup(key string) (string, bool) {
+	s.used[key] = true
+	if val, ok := os.LookupEnv(key); ok {
+		return val, true
+	}
+	val, ok := s.file[key]
+	return val, ok
+}
+
+// fail records a validation error; LoadConfig reports them all together.
+func (s *source) fail(format string, args ...interface{}) {
+	s.errs = append(s.errs, fmt.Errorf(format, args...))
+}
+
+// unknownKeys reports file settings nothing asked for, which are most likely
+// typos.
+func (s *source) unknownKeys() {
+	var unknown []string
+	for key := range s.file {
+		if !s.used[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		s.fail("%s: unknown setting %s", s.path, key)
+	}
+}
+
+func (s *source) err() error {
+	return errors.Join(s.errs...)
+}
+
+func (s *source) getString(key, defaultVal string) string {
+	if val, ok := s.lookup(key); ok {
+		return val
+	}
+	return defaultVal
+}
+
+func (s *source) getList(key string) []string {
+	val, _ := s.lookup(key)
+	var values []string
+	for _, v := range strings.Split(val, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// getMap parses a comma separated list of key=value pairs with URL encoded
+// values, the format of OTEL_RESOURCE_ATTRIBUTES and OTEL_EXPORTER_OTLP_HEADERS.
+func (s *source) getMap(key string) map[string]string {
+	values := make(map[string]string)
+	for _, pair := range s.getList(key) {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			s.fail("%s: %q is not a key=value pair", key, pair)
+			continue
+		}
+		if unescaped, err := url.PathUnescape(strings.TrimSpace(v)); err == nil {
+			v = unescaped
+		}
+		values[strings.TrimSpace(k)] = v
+	}
+	return values
+}
+
+// The typed getters treat an empty value as unset.
+
+func (s *source) getInt(key string, defaultVal int) int {
+	val, ok := s.lookup(key)
+	if !ok || val == "" {
+		return defaultVal
+	}
+	intVal, err := strconv.Atoi(strings.TrimSpace(val))
+	if err != nil {
+		s.fail("%s: %q is not an integer", key, val)
+		return defaultVal
+	}
+	return intVal
+}
+
+func (s *source) getBool(key string, defaultVal bool) bool {
+	val, ok := s.lookup(key)
+	if !ok || val == "" {
+		return defaultVal
+	}
+	boolVal, err := strconv.ParseBool(strings.TrimSpace(val))
+	if err != nil {
+		s.fail("%s: %q is not a boolean", key, val)
+		return defaultVal
+	}
+	return boolVal
+}
+
+func (s *source) getFloat(key string, defaultVal float64) float64 {
+	val, ok := s.lookup(key)
+	if !ok || val == "" {
+		return defaultVal
+	}
+	floatVal, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	if err != nil {
+		s.fail("%s: %q is not a number", key, val)
+		return defaultVal
+	}
+	return floatVal
+}
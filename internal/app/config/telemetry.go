@@ -0,0 +1,141 @@
+package config
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// Telemetry exporters, set per signal with OTEL_TRACES_EXPORTER,
+// OTEL_METRICS_EXPORTER and OTEL_LOGS_EXPORTER.
+const (
+	TelemetryExporterOTLP    = "otlp"
+	TelemetryExporterConsole = "console"
+	TelemetryExporterNone    = "none"
+)
+
+// TelemetryConfig configures the OpenTelemetry tracer, meter and logger
+// providers. It is read from the standard OTEL_* environment variables, so the
+// server and the CLI share it.
+type TelemetryConfig struct {
+	Disabled           bool
+	ServiceName        string
+	ResourceAttributes map[string]string
+
+	TracesExporter  string
+	MetricsExporter string
+	LogsExporter    string
+
+	// OTLP exporter settings, shared by all signals. Endpoint is host:port.
+	Endpoint          string
+	Insecure          bool
+	Headers           map[string]string
+	Certificate       string
+	ClientCertificate string
+	ClientKey         string
+	Timeout           time.Duration
+
+	Sampler    string
+	SamplerArg float64
+
+	MetricExportInterval time.Duration
+
+	// Required makes startup fail when the collector cannot be reached;
+	// otherwise the OTLP signals are disabled and the service starts anyway.
+	Required bool
+}
+
+// LoadTelemetryConfig reads the telemetry configuration. serviceName is used
+// unless OTEL_SERVICE_NAME is set.
+func LoadTelemetryConfig(serviceName string) (TelemetryConfig, error) {
+	_ = godotenv.Load()
+
+	s, err := newSource()
+	if err != nil {
+		return TelemetryConfig{}, err
+	}
+	cfg := loadTelemetryConfig(s, serviceName)
+	if err := s.err(); err != nil {
+		return TelemetryConfig{}, err
+	}
+	return cfg, nil
+}
+
+func loadTelemetryConfig(s *source, serviceName string) TelemetryConfig {
+	cfg := TelemetryConfig{
+		Disabled:           s.getBool("OTEL_SDK_DISABLED", false),
+		ServiceName:        s.getString("OTEL_SERVICE_NAME", serviceName),
+		ResourceAttributes: s.getMap("OTEL_RESOURCE_ATTRIBUTES"),
+
+		TracesExporter:  s.getString("OTEL_TRACES_EXPORTER", TelemetryExporterOTLP),
+		MetricsExporter: s.getString("OTEL_METRICS_EXPORTER", TelemetryExporterOTLP),
+		LogsExporter:    s.getString("OTEL_LOGS_EXPORTER", TelemetryExporterOTLP),
+
+		Headers:           s.getMap("OTEL_EXPORTER_OTLP_HEADERS"),
+		Certificate:       s.getString("OTEL_EXPORTER_OTLP_CERTIFICATE", ""),
+		ClientCertificate: s.getString("OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE", ""),
+		ClientKey:         s.getString("OTEL_EXPORTER_OTLP_CLIENT_KEY", ""),
+		Timeout:           time.Duration(s.getInt("OTEL_EXPORTER_OTLP_TIMEOUT", 10000)) * time.Millisecond,
+
+		Sampler:    s.getString("OTEL_TRACES_SAMPLER", "parentbased_always_on"),
+		SamplerArg: s.getFloat("OTEL_TRACES_SAMPLER_ARG", 1.0),
+
+		MetricExportInterval: time.Duration(s.getInt("OTEL_METRIC_EXPORT_INTERVAL", 60000)) * time.Millisecond,
+
+		Required: s.getBool("TELEMETRY_REQUIRED", false),
+	}
+	if _, ok := cfg.ResourceAttributes["deployment.environment"]; !ok {
+		cfg.ResourceAttributes["deployment.environment"] = s.getString("APP_ENV", "development")
+	}
+
+	// An http:// endpoint means plaintext and https:// means TLS; a bare
+	// host:port uses TLS unless OTEL_EXPORTER_OTLP_INSECURE is set.
+	endpoint := s.getString("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4317")
+	insecure := false
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			s.fail("invalid OTEL_EXPORTER_OTLP_ENDPOINT %q", endpoint)
+		} else {
+			endpoint, insecure = u.Host, u.Scheme == "http"
+		}
+	}
+	cfg.Endpoint = endpoint
+	cfg.Insecure = s.getBool("OTEL_EXPORTER_OTLP_INSECURE", insecure)
+
+	for name, exporter := range map[string]string{
+		"OTEL_TRACES_EXPORTER":  cfg.TracesExporter,
+		"OTEL_METRICS_EXPORTER": cfg.MetricsExporter,
+		"OTEL_LOGS_EXPORTER":    cfg.LogsExporter,
+	} {
+		switch exporter {
+		case TelemetryExporterOTLP, TelemetryExporterConsole, TelemetryExporterNone:
+		default:
+			s.fail("unsupported %s %q (expected otlp, console or none)", name, exporter)
+		}
+	}
+
+	switch cfg.Sampler {
+	case "always_on", "always_off", "traceidratio",
+		"parentbased_always_on", "parentbased_always_off", "parentbased_traceidratio":
+	default:
+		s.fail("unsupported OTEL_TRACES_SAMPLER %q", cfg.Sampler)
+	}
+	if cfg.SamplerArg < 0 || cfg.SamplerArg > 1 {
+		s.fail("OTEL_TRACES_SAMPLER_ARG must be between 0 and 1")
+	}
+
+	if (cfg.ClientCertificate == "") != (cfg.ClientKey == "") {
+		s.fail("OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE and OTEL_EXPORTER_OTLP_CLIENT_KEY must be set together")
+	}
+	return cfg
+}
+
+// UsesOTLP reports whether any signal is exported to the collector.
+func (c TelemetryConfig) UsesOTLP() bool {
+	return !c.Disabled && (c.TracesExporter == TelemetryExporterOTLP ||
+		c.MetricsExporter == TelemetryExporterOTLP ||
+		c.LogsExporter == TelemetryExporterOTLP)
+}
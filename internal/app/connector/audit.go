@@ -0,0 +1,183 @@
+package connector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/requestctx"
+)
+
+const (
+	auditTargetConnector      = "connector"
+	auditTargetCircuitBreaker = "circuit_breaker"
+)
+
+func WithAuditLog(log domain.AuditLog) ServiceOption {
+	return func(s *Service) {
+		s.auditLog = log
+	}
+}
+
+// recordAudit appends an event describing an operation on a connector. A
+// failure to write the event is logged but does not fail the operation.
+func recordAudit(ctx context.Context, log domain.AuditLog, action domain.AuditAction, conn *domain.Connector, diff json.RawMessage, opErr error) {
+	if log == nil {
+		return
+	}
+
+	event := &domain.AuditEvent{
+		Action:     action,
+		TargetType: auditTargetConnector,
+		Diff:       diff,
+	}
+	if conn != nil {
+		event.TenantID = conn.TenantID
+		event.TargetID = conn.ID.String()
+	}
+	appendAudit(ctx, log, event, opErr)
+}
+
+// appendAudit fills in the caller, time and outcome of event and appends it.
+func appendAudit(ctx context.Context, log domain.AuditLog, event *domain.AuditEvent, opErr error) {
+	event.OccurredAt = time.Now().UTC()
+	event.Actor = requestctx.Actor(ctx)
+	event.RequestID = requestctx.RequestID(ctx)
+	event.TraceID = requestctx.TraceID(ctx)
+	event.Outcome = domain.AuditOutcomeSuccess
+	if opErr != nil {
+		event.Outcome = domain.AuditOutcomeFailure
+	}
+
+	// The event is written even when the caller's context was cancelled.
+	if err := log.Append(context.WithoutCancel(ctx), event); err != nil {
+		logger.Ctx(ctx).Error().Err(err).
+			Str("action", string(event.Action)).
+			Str("target_id", event.TargetID).
+			Msg("Failed to write audit event")
+	}
+}
+
+type fieldChange struct {
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// connectorDiff lists the connector fields that differ between before and
+// after; either side may be nil. The secret version is left out because it
+// can hold token material.
+func connectorDiff(before, after *domain.Connector) json.RawMessage {
+	oldFields, newFields := auditFields(before), auditFields(after)
+
+	changes := make(map[string]fieldChange)
+	for name, value := range oldFields {
+		if newFields[name] != value {
+			changes[name] = fieldChange{Old: value, New: newFields[name]}
+		}
+	}
+	for name, value := range newFields {
+		if _, seen := oldFields[name]; !seen && value != "" {
+			changes[name] = fieldChange{New: value}
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	// Map keys are marshalled in sorted order, so the diff is deterministic.
+	raw, err := json.Marshal(changes)
+	if err != nil {
+		return nil
+	}
+	return raw
+}
+
+func auditFields(conn *domain.Connector) map[string]string {
+	if conn == nil {
+		return nil
+	}
+	fields := map[string]string{
+		"workspace_id":       conn.WorkspaceID,
+		"tenant_id":          conn.TenantID,
+		"default_channel_id": conn.DefaultChannelID,
+		"secret_name":        conn.SecretName,
+		"status":             string(conn.Status),
+	}
+	if conn.DeletedAt != nil {
+		fields["deleted_at"] = conn.DeletedAt.UTC().Format(time.RFC3339)
+	}
+	return fields
+}
+
+type AuditService struct {
+	log domain.AuditLog
+}
+
+func NewAuditService(log domain.AuditLog) *AuditService {
+	return &AuditService{log: log}
+}
+
+// Record appends an event for an operation on conn made outside Service, such
+// as the CLI reading a connector's token.
+func (s *AuditService) Record(ctx context.Context, action domain.AuditAction, conn *domain.Connector, opErr error) {
+	recordAudit(ctx, s.log, action, conn, nil, opErr)
+}
+
+// RecordCircuitBreakerMode appends an event for an operator setting the mode
+// of the circuit breaker name.
+func (s *AuditService) RecordCircuitBreakerMode(ctx context.Context, name, mode string, opErr error) {
+	if s == nil || s.log == nil {
+		return
+	}
+	diff, _ := json.Marshal(map[string]fieldChange{"mode": {New: mode}})
+	appendAudit(ctx, s.log, &domain.AuditEvent{
+		Action:     domain.AuditActionCircuitBreakerSetMode,
+		TargetType: auditTargetCircuitBreaker,
+		TargetID:   name,
+		Diff:       diff,
+	}, opErr)
+}
+
+func (s *AuditService) ListAuditEvents(ctx context.Context, filter domain.AuditFilter, limit int, afterID int64) ([]domain.AuditEvent, int64, error) {
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return nil, 0, fmt.Errorf("start time must be before end time: %w", ErrInvalidInput)
+	}
+
+	events, next, err := s.log.List(ctx, filter, limit, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit events: %w", err)
+	}
+	return events, next, nil
+}
+
+// VerifyChain walks the whole audit log and returns the number of events
+// checked. A broken chain is reported as a *domain.AuditChainError.
+func (s *AuditService) VerifyChain(ctx context.Context) (int, error) {
+	const pageSize = 500
+
+	var (
+		checked  int
+		prevID   int64
+		prevHash string
+	)
+	for {
+		events, next, err := s.log.List(ctx, domain.AuditFilter{}, pageSize, prevID)
+		if err != nil {
+			return checked, fmt.Errorf("failed to read audit events: %w", err)
+		}
+		if err := domain.VerifyAuditChain(events, prevID, prevHash); err != nil {
+			return checked, err
+		}
+		checked += len(events)
+		if len(events) > 0 {
+			last := events[len(events)-1]
+			prevID, prevHash = last.ID, last.Hash
+		}
+		if next == 0 {
+			return checked, nil
+		}
+	}
+}
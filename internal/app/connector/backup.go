@@ -0,0 +1,298 @@
+package connector
+
+import (
+	"context"
+	"crypto/rsa"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/seal"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ExportFormatVersion is written into every export. Bump it whenever the
+// meaning of an exported connector changes, and teach restore to convert the
+// older versions, so backups taken before a schema change stay restorable.
+const ExportFormatVersion = 1
+
+const exportPageSize = 200
+
+// ExportedConnector is one connector of an export. Secret is set when the
+// export was sealed to a public key and the connector's secret was found.
+type ExportedConnector struct {
+	Connector domain.Connector
+	Secret    *seal.Sealed
+}
+
+// RestoreItem is one connector to recreate from an export. Token is the
+// connector's secret, already opened by the caller; without it the existing
+// secret is used if there is one. Invalid is set when the caller already
+// rejected the item.
+type RestoreItem struct {
+	Connector domain.Connector
+	Token     string
+	Invalid   error
+}
+
+// CheckExportFormat rejects exports this version of the service cannot read.
+func CheckExportFormat(version int) error {
+	if version < 1 || version > ExportFormatVersion {
+		return fmt.Errorf("%w: unsupported export format version %d, expected 1 to %d",
+			ErrInvalidInput, version, ExportFormatVersion)
+	}
+	return nil
+}
+
+// SecretAssociatedData binds a sealed secret to the connector it belongs to.
+func SecretAssociatedData(conn *domain.Connector) []byte {
+	return []byte(conn.ID.String())
+}
+
+// ExportConnectors sends every live connector, or only tenantID's when it is
+// set, to send. With a recipient key each connector's secret is sealed to it
+// and the export is audited per connector; without one only the definitions
+// are exported. Connectors of suspended tenants are left out, and exporting
+// a suspended tenant by ID fails with ErrTenantNotActive.
+func (s *Service) ExportConnectors(ctx context.Context, tenantID string, recipient *rsa.PublicKey, send func(ExportedConnector) error) (err error) {
+	ctx, span := startSpan(ctx, "connector.Service.ExportConnectors",
+		attrTenantID.String(tenantID), attribute.Bool("export.with_secrets", recipient != nil))
+	defer func() { endSpan(span, err) }()
+
+	if tenantID != "" {
+		if err := s.ensureTenantActive(ctx, tenantID); err != nil {
+			return fmt.Errorf("failed to export connectors: %w", err)
+		}
+	}
+
+	var (
+		cursor   *domain.ListCursor
+		exported int
+		skipped  int
+		tenants  = newActiveTenants(s.tenants)
+	)
+	for {
+		connectors, next, err := s.repo.ListConnectors(ctx, exportPageSize, cursor)
+		if err != nil {
+			return fmt.Errorf("failed to list connectors: %w", err)
+		}
+		for i := range connectors {
+			conn := connectors[i]
+			if tenantID != "" && conn.TenantID != tenantID {
+				continue
+			}
+			active, err := tenants.check(ctx, conn.TenantID)
+			if err != nil {
+				return fmt.Errorf("failed to export connectors: %w", err)
+			}
+			if !active {
+				skipped++
+				continue
+			}
+			item := ExportedConnector{Connector: conn}
+			if recipient != nil {
+				if item.Secret, err = s.sealSecret(ctx, &conn, recipient); err != nil {
+					return err
+				}
+			}
+			if err := send(item); err != nil {
+				return err
+			}
+			exported++
+		}
+		if next == nil {
+			break
+		}
+		cursor = next
+	}
+
+	logger.Ctx(ctx).Info().
+		Str("tenant_id", tenantID).
+		Int("connectors", exported).
+		Int("skipped_inactive_tenants", skipped).
+		Bool("with_secrets", recipient != nil).
+		Msg("Connector export finished")
+	return nil
+}
+
+// sealSecret returns nil for a connector whose secret is missing; it is
+// exported without one and restored as errored.
+func (s *Service) sealSecret(ctx context.Context, conn *domain.Connector, recipient *rsa.PublicKey) (*seal.Sealed, error) {
+	token, err := s.secretsManager.GetToken(ctx, conn.SecretName)
+	if err != nil {
+		if errors.Is(handleAWSError(err), ErrNotFound) {
+			logger.Ctx(ctx).Warn().Str("connector_id", conn.ID.String()).Msg("Exporting connector without its missing secret")
+			return nil, nil
+		}
+		recordAudit(ctx, s.auditLog, domain.AuditActionConnectorExport, conn, nil, err)
+		return nil, fmt.Errorf("failed to read secret of connector %s: %w", conn.ID, err)
+	}
+
+	sealed, err := seal.Seal(recipient, []byte(token), SecretAssociatedData(conn))
+	recordAudit(ctx, s.auditLog, domain.AuditActionConnectorExport, conn, nil, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seal secret of connector %s: %w", conn.ID, err)
+	}
+	return sealed, nil
+}
+
+// RestoreConnectors recreates the connectors next returns, until it returns
+// io.EOF, from an export in the given format version. Each keeps its original
+// ID, so references held elsewhere stay valid. Connectors that still exist
+// are left alone and reported as already existing. Any other error from next
+// stops the restore; connectors already recreated are kept.
+func (s *Service) RestoreConnectors(ctx context.Context, formatVersion int, next func() (RestoreItem, error)) (_ *ImportReport, err error) {
+	ctx, span := startSpan(ctx, "connector.Service.RestoreConnectors", attribute.Int("export.format_version", formatVersion))
+	defer func() { endSpan(span, err) }()
+
+	if err := CheckExportFormat(formatVersion); err != nil {
+		return nil, err
+	}
+
+	var (
+		results []ImportResult
+		seen    = make(map[string]int)
+		readErr error
+	)
+	for index := 0; ; index++ {
+		item, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			readErr = err
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			readErr = err
+			break
+		}
+
+		conn := item.Connector
+		result := ImportResult{Index: index, WorkspaceID: conn.WorkspaceID, TenantID: conn.TenantID, ConnectorID: conn.ID}
+		if item.Invalid != nil {
+			result.Status, result.Err = ImportStatusInvalid, item.Invalid
+			results = append(results, result)
+			continue
+		}
+		if first, ok := seen[conn.ID.String()]; ok {
+			result.Status, result.Err = ImportStatusDuplicate, fmt.Errorf("duplicate of item %d", first)
+			results = append(results, result)
+			continue
+		}
+		seen[conn.ID.String()] = index
+
+		results = append(results, s.restoreFromExport(ctx, result, &conn, item.Token))
+	}
+
+	report := &ImportReport{Results: results, Summary: summarize(results)}
+	logger.Ctx(ctx).Info().
+		Int("total", report.Summary.Total).
+		Int("restored", report.Summary.Created).
+		Int("already_exists", report.Summary.AlreadyExists).
+		Int("invalid", report.Summary.Invalid).
+		Int("failed", report.Summary.Failed).
+		Msg("Connector restore finished")
+	return report, readErr
+}
+
+func (s *Service) restoreFromExport(ctx context.Context, result ImportResult, conn *domain.Connector, token string) ImportResult {
+	restored, err := s.recoverConnector(ctx, conn, token)
+	var existsErr *ConnectorExistsError
+	switch {
+	case err == nil:
+		result.Status = ImportStatusCreated
+	case errors.As(err, &existsErr):
+		result.Status, result.ConnectorID = ImportStatusAlreadyExists, existsErr.ConnectorID
+	case errors.Is(err, ErrAlreadyExists):
+		result.Status, result.Err = ImportStatusAlreadyExists, err
+	case errors.Is(err, ErrInvalidInput):
+		result.Status, result.Err = ImportStatusInvalid, err
+	default:
+		logger.Ctx(ctx).Warn().Err(err).Str("connector_id", conn.ID.String()).Msg("Failed to restore connector from export")
+		result.Status, result.Err = ImportStatusFailed, err
+	}
+
+	// Connectors that were left alone are not audited.
+	if result.Status != ImportStatusAlreadyExists {
+		recordAudit(ctx, s.auditLog, domain.AuditActionConnectorRecover, conn, connectorDiff(nil, restored), err)
+	}
+	return result
+}
+
+func (s *Service) recoverConnector(ctx context.Context, exported *domain.Connector, token string) (*domain.Connector, error) {
+	if err := s.ensureTenantActive(ctx, exported.TenantID); err != nil {
+		return nil, fmt.Errorf("failed to restore connector: %w", err)
+	}
+	if err := s.ensureIDFree(ctx, exported); err != nil {
+		return nil, err
+	}
+	if err := s.ensureConnectorAbsent(ctx, exported.WorkspaceID, exported.TenantID); err != nil {
+		return nil, err
+	}
+
+	conn := *exported
+	conn.SecretName = s.naming.Name(conn.ID)
+	conn.SecretVersion = "v1"
+	conn.DeletedAt = nil
+
+	if token == "" {
+		// A restore into the same account may find the secret still there.
+		if _, err := s.secretsManager.GetToken(ctx, conn.SecretName); err != nil {
+			if !errors.Is(handleAWSError(err), ErrNotFound) {
+				return nil, fmt.Errorf("failed to check for existing secret: %w", err)
+			}
+			logger.Ctx(ctx).Warn().Str("connector_id", conn.ID.String()).Msg("Restoring connector without a secret; marking it errored")
+			conn.Status = domain.ConnectorStatusErrored
+		}
+		if err := s.repo.Create(ctx, &conn); err != nil {
+			return nil, s.restoreCreateError(ctx, &conn, err)
+		}
+		return &conn, nil
+	}
+
+	op, err := s.beginOperation(ctx, domain.OperationKindCreate, &conn)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.secretsManager.StoreToken(ctx, conn.SecretName, token); err != nil {
+		if op != nil {
+			s.compensateCreate(ctx, op, conn.SecretName)
+		}
+		return nil, fmt.Errorf("failed to store secret: %w", err)
+	}
+	s.advanceOperation(ctx, op, domain.OperationStateSecretStored)
+
+	if err := s.repo.Create(ctx, &conn); err != nil {
+		s.compensateCreate(ctx, op, conn.SecretName)
+		return nil, s.restoreCreateError(ctx, &conn, err)
+	}
+	s.advanceOperation(ctx, op, domain.OperationStateCompleted)
+	return &conn, nil
+}
+
+// ensureIDFree reports a connector whose ID is still taken, including by a
+// soft-deleted row, which RestoreConnector brings back instead.
+func (s *Service) ensureIDFree(ctx context.Context, conn *domain.Connector) error {
+	if _, err := s.repo.GetByID(ctx, conn.ID); err == nil {
+		return &ConnectorExistsError{ConnectorID: conn.ID}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to check for existing connector: %w", err)
+	}
+	if _, err := s.repo.GetDeletedByID(ctx, conn.ID); err == nil {
+		return fmt.Errorf("connector %s is deleted; restore it with RestoreConnector: %w", conn.ID, ErrAlreadyExists)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to check for deleted connector: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) restoreCreateError(ctx context.Context, conn *domain.Connector, err error) error {
+	if errors.Is(err, domain.ErrConflict) {
+		return s.conflictError(ctx, conn.WorkspaceID, conn.TenantID, err)
+	}
+	return fmt.Errorf("failed to restore connector: %w", err)
+}
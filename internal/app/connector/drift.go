@@ -0,0 +1,213 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+// DriftReport describes how Secrets Manager and the connectors table disagree.
+type DriftReport struct {
+	StartedAt         time.Time `json:"started_at"`
+	ScannedSecrets    int       `json:"scanned_secrets"`
+	ScannedConnectors int       `json:"scanned_connectors"`
+	OrphanedSecrets   []string  `json:"orphaned_secrets"`
+	// UnmanagedSecrets are legacy secrets no connector references. The legacy
+	// prefix is shared by every environment in the account, so they may belong
+	// to another one; they are listed for review but never deleted.
+	UnmanagedSecrets []string        `json:"unmanaged_secrets"`
+	MissingSecrets   []MissingSecret `json:"missing_secrets"`
+	Recovered        []string        `json:"recovered_connectors"`
+	Repaired         bool            `json:"repaired"`
+	RepairErrors     []string        `json:"repair_errors,omitempty"`
+}
+
+type MissingSecret struct {
+	ConnectorID string `json:"connector_id"`
+	SecretName  string `json:"secret_name"`
+}
+
+func (r *DriftReport) HasDrift() bool {
+	return len(r.OrphanedSecrets) > 0 || len(r.MissingSecrets) > 0 || len(r.Recovered) > 0
+}
+
+// DriftReconciler compares the secrets under the connector prefixes with the
+// connectors that reference them. With repair enabled, orphaned secrets are
+// scheduled for deletion and connectors without a secret are marked errored.
+// Only secrets under this environment's own prefix are ever deleted.
+type DriftReconciler struct {
+	repo           domain.ConnectorRepository
+	secretsManager domain.SecretsManager
+	// ownPrefix is where this environment names its secrets; prefixes adds the
+	// legacy prefix shared with other environments.
+	ownPrefix   string
+	prefixes    []string
+	gracePeriod time.Duration
+}
+
+// NewDriftReconciler ignores secrets younger than gracePeriod, since a create
+// in progress stores its secret before the connector row exists.
+func NewDriftReconciler(repo domain.ConnectorRepository, sm domain.SecretsManager, naming domain.SecretNaming, gracePeriod time.Duration) *DriftReconciler {
+	return &DriftReconciler{
+		repo:           repo,
+		secretsManager: sm,
+		ownPrefix:      naming.ListPrefix(),
+		prefixes:       []string{naming.ListPrefix(), domain.LegacySecretPrefix},
+		gracePeriod:    gracePeriod,
+	}
+}
+
+func (d *DriftReconciler) Start(ctx context.Context, interval time.Duration, repair bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			report, err := d.Run(ctx, repair)
+			if err != nil {
+				logger.Ctx(ctx).Error().Err(err).Msg("Drift reconciliation failed")
+				continue
+			}
+			logger.Ctx(ctx).Info().
+				Int("orphaned_secrets", len(report.OrphanedSecrets)).
+				Int("missing_secrets", len(report.MissingSecrets)).
+				Int("recovered_connectors", len(report.Recovered)).
+				Bool("repaired", report.Repaired).
+				Msg("Drift reconciliation completed")
+		case <-ctx.Done():
+			logger.Ctx(ctx).Info().Msg("Drift reconciler stopped.")
+			return
+		}
+	}
+}
+
+func (d *DriftReconciler) Run(ctx context.Context, repair bool) (*DriftReport, error) {
+	report := &DriftReport{
+		StartedAt:        time.Now().UTC(),
+		OrphanedSecrets:  []string{},
+		UnmanagedSecrets: []string{},
+		MissingSecrets:   []MissingSecret{},
+		Recovered:        []string{},
+		Repaired:         repair,
+	}
+
+	secrets := make(map[string]domain.SecretSummary)
+	for _, prefix := range d.prefixes {
+		listed, err := d.secretsManager.ListSecrets(ctx, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets with prefix %s: %w", prefix, err)
+		}
+		for _, secret := range listed {
+			secrets[secret.Name] = secret
+		}
+	}
+	report.ScannedSecrets = len(secrets)
+
+	referenced := make(map[string]bool)
+	const pageSize = 100
+	var cursor *domain.ListCursor
+	for {
+		connectors, nextCursor, err := d.repo.ListConnectors(ctx, pageSize, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list connectors: %w", err)
+		}
+
+		for _, connector := range connectors {
+			report.ScannedConnectors++
+			referenced[connector.SecretName] = true
+			if !d.inScope(connector.SecretName) {
+				continue
+			}
+			_, exists := secrets[connector.SecretName]
+
+			switch {
+			case !exists && connector.Status != domain.ConnectorStatusErrored:
+				report.MissingSecrets = append(report.MissingSecrets, MissingSecret{
+					ConnectorID: connector.ID.String(),
+					SecretName:  connector.SecretName,
+				})
+				if repair {
+					d.setStatus(ctx, report, connector, domain.ConnectorStatusErrored)
+				}
+			case exists && connector.Status == domain.ConnectorStatusErrored:
+				report.Recovered = append(report.Recovered, connector.ID.String())
+				if repair {
+					d.setStatus(ctx, report, connector, domain.ConnectorStatusActive)
+				}
+			}
+		}
+
+		if nextCursor == nil {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	cutoff := report.StartedAt.Add(-d.gracePeriod)
+	for name, secret := range secrets {
+		if referenced[name] || secret.CreatedAt.After(cutoff) {
+			continue
+		}
+		if strings.HasPrefix(name, d.ownPrefix) {
+			report.OrphanedSecrets = append(report.OrphanedSecrets, name)
+		} else {
+			report.UnmanagedSecrets = append(report.UnmanagedSecrets, name)
+		}
+	}
+	sort.Strings(report.OrphanedSecrets)
+	sort.Strings(report.UnmanagedSecrets)
+
+	if repair {
+		orphaned := report.OrphanedSecrets[:0]
+		for _, name := range report.OrphanedSecrets {
+			if d.deleteOrphan(ctx, report, name) {
+				orphaned = append(orphaned, name)
+			}
+		}
+		report.OrphanedSecrets = orphaned
+	}
+
+	return report, nil
+}
+
+// inScope reports whether name falls under one of the scanned prefixes;
+// connectors pointing elsewhere cannot be judged from the listing.
+func (d *DriftReconciler) inScope(name string) bool {
+	for _, prefix := range d.prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteOrphan deletes the secret unless a connector references it after
+// all, such as one restored or imported since the scan, or a soft-deleted one
+// whose restore would need it. It reports whether the secret is an orphan.
+func (d *DriftReconciler) deleteOrphan(ctx context.Context, report *DriftReport, name string) bool {
+	referenced, err := d.repo.ReferencesSecret(ctx, name)
+	if err != nil {
+		report.RepairErrors = append(report.RepairErrors, fmt.Sprintf("check secret %s: %v", name, err))
+		return true
+	}
+	if referenced {
+		logger.Ctx(ctx).Info().Str("secret_name", name).Msg("Secret is referenced by a connector after all; not deleting it")
+		return false
+	}
+	if err := d.secretsManager.DeleteToken(ctx, name); err != nil {
+		report.RepairErrors = append(report.RepairErrors, fmt.Sprintf("delete secret %s: %v", name, err))
+	}
+	return true
+}
+
+func (d *DriftReconciler) setStatus(ctx context.Context, report *DriftReport, connector domain.Connector, status domain.ConnectorStatus) {
+	if err := d.repo.UpdateStatus(ctx, connector.ID, status); err != nil {
+		report.RepairErrors = append(report.RepairErrors, fmt.Sprintf("mark connector %s %s: %v", connector.ID, status, err))
+	}
+}
@@ -1,28 +1,125 @@
 package connector
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/resilience"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 var (
-	ErrInvalidInput = errors.New("invalid input provided")
-	ErrNotFound     = errors.New("record not found")
+	ErrInvalidInput    = errors.New("invalid input provided")
+	ErrNotFound        = errors.New("record not found")
+	ErrAlreadyExists   = errors.New("record already exists")
+	ErrTenantNotActive = errors.New("tenant is not active")
+	ErrRestoreExpired  = errors.New("recovery window has expired")
+
+	ErrWatchUnavailable   = errors.New("connector watch is not available")
+	ErrResumeTokenExpired = errors.New("resume token has expired; start a new watch")
+	ErrWatchLagging       = errors.New("watch stream fell behind; resume from the last token")
 )
 
+// ConnectorExistsError is returned when a connector already exists for the
+// requested workspace and tenant.
+type ConnectorExistsError struct {
+	ConnectorID uuid.UUID
+}
+
+func (e *ConnectorExistsError) Error() string {
+	return fmt.Sprintf("connector %s already exists for this workspace and tenant", e.ConnectorID)
+}
+
+func (e *ConnectorExistsError) Unwrap() error {
+	return ErrAlreadyExists
+}
+
+// validationError is implemented by the errors protoc-gen-validate generates
+// for each request message.
+type validationError interface {
+	error
+	Field() string
+	Reason() string
+}
+
 func GRPCError(err error) error {
+	var invalid validationError
 	switch {
-	case errors.Is(err, ErrInvalidInput):
+	case errors.Is(err, ErrInvalidInput), errors.As(err, &invalid):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, ErrNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrSlackChannelNotFound):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrSlackInvalidAuth):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrSlackRateLimited):
+		return status.Error(codes.ResourceExhausted, "slack rate limit exceeded; try again later")
+	case errors.Is(err, ErrAlreadyExists):
+		return alreadyExistsError(err)
+	case errors.Is(err, ErrTenantNotActive), errors.Is(err, ErrRestoreExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrResumeTokenExpired):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, ErrWatchLagging):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, ErrWatchUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, resilience.ErrOpen), errors.Is(err, resilience.ErrTooManyRequests):
+		return status.Error(codes.Unavailable, "a dependency is unavailable; try again later")
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
 }
 
+// IsClientError reports whether err was caused by the request rather than by
+// a failing dependency, so circuit breakers do not count it.
+func IsClientError(err error) bool {
+	var invalid validationError
+	var existsErr *ConnectorExistsError
+	var notFoundErr *types.ResourceNotFoundException
+	var invalidRequestErr *types.InvalidRequestException
+	var invalidParameterErr *types.InvalidParameterException
+	var resourceExistsErr *types.ResourceExistsException
+	return errors.Is(err, ErrInvalidInput) ||
+		errors.Is(err, ErrNotFound) ||
+		errors.Is(err, ErrAlreadyExists) ||
+		errors.Is(err, ErrTenantNotActive) ||
+		errors.Is(err, ErrRestoreExpired) ||
+		errors.Is(err, domain.ErrConflict) ||
+		errors.Is(err, domain.ErrSlackChannelNotFound) ||
+		errors.Is(err, domain.ErrSlackInvalidAuth) ||
+		errors.Is(err, sql.ErrNoRows) ||
+		errors.As(err, &invalid) ||
+		errors.As(err, &existsErr) ||
+		errors.As(err, &notFoundErr) ||
+		errors.As(err, &invalidRequestErr) ||
+		errors.As(err, &invalidParameterErr) ||
+		errors.As(err, &resourceExistsErr)
+}
+
+func alreadyExistsError(err error) error {
+	st := status.New(codes.AlreadyExists, err.Error())
+	var existsErr *ConnectorExistsError
+	if !errors.As(err, &existsErr) {
+		return st.Err()
+	}
+	detailed, detailErr := st.WithDetails(&errdetails.ResourceInfo{
+		ResourceType: "connector",
+		ResourceName: existsErr.ConnectorID.String(),
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
 func handleAWSError(err error) error {
 	var notFoundErr *types.ResourceNotFoundException
 	var invalidRequestErr *types.InvalidRequestException
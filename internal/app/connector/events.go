@@ -0,0 +1,168 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+)
+
+// EventingRepository decorates a ConnectorRepository so that every lifecycle
+// change made through it, by Service or by the background jobs, enqueues a
+// CloudEvent in the same transaction as the row change.
+type EventingRepository struct {
+	domain.ConnectorRepository
+	transactor domain.Transactor
+	outbox     domain.Outbox
+	source     string
+}
+
+func NewEventingRepository(repo domain.ConnectorRepository, transactor domain.Transactor, outbox domain.Outbox, source string) domain.ConnectorRepository {
+	return &EventingRepository{
+		ConnectorRepository: repo,
+		transactor:          transactor,
+		outbox:              outbox,
+		source:              source,
+	}
+}
+
+func (r *EventingRepository) Create(ctx context.Context, c *domain.Connector) error {
+	return r.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := r.ConnectorRepository.Create(ctx, c); err != nil {
+			return err
+		}
+		return r.enqueue(ctx, domain.ConnectorEventCreated, c)
+	})
+}
+
+func (r *EventingRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		conn, err := r.ConnectorRepository.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := r.ConnectorRepository.Delete(ctx, id); err != nil {
+			return err
+		}
+		deletedAt := time.Now().UTC()
+		conn.DeletedAt = &deletedAt
+		conn.UpdatedAt = deletedAt
+		return r.enqueue(ctx, domain.ConnectorEventDeleted, conn)
+	})
+}
+
+// Purge publishes a deleted event only for live connectors; soft-deleted ones
+// announced their deletion already.
+func (r *EventingRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	return r.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		live, err := r.ConnectorRepository.GetByID(ctx, id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err := r.ConnectorRepository.Purge(ctx, id); err != nil {
+			return err
+		}
+		if live == nil {
+			return nil
+		}
+		deletedAt := time.Now().UTC()
+		live.DeletedAt = &deletedAt
+		live.UpdatedAt = deletedAt
+		return r.enqueue(ctx, domain.ConnectorEventDeleted, live)
+	})
+}
+
+func (r *EventingRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	return r.updateAndEnqueue(ctx, id, domain.ConnectorEventRestored, func(ctx context.Context) error {
+		return r.ConnectorRepository.Restore(ctx, id)
+	})
+}
+
+func (r *EventingRepository) UpdateConnector(ctx context.Context, id uuid.UUID, token string) error {
+	return r.updateAndEnqueue(ctx, id, domain.ConnectorEventRotated, func(ctx context.Context) error {
+		return r.ConnectorRepository.UpdateConnector(ctx, id, token)
+	})
+}
+
+func (r *EventingRepository) UpdateSecretName(ctx context.Context, id uuid.UUID, secretName string) error {
+	return r.updateAndEnqueue(ctx, id, domain.ConnectorEventUpdated, func(ctx context.Context) error {
+		return r.ConnectorRepository.UpdateSecretName(ctx, id, secretName)
+	})
+}
+
+func (r *EventingRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ConnectorStatus) error {
+	eventType := domain.ConnectorEventUpdated
+	if status == domain.ConnectorStatusErrored {
+		eventType = domain.ConnectorEventDisabled
+	}
+	return r.updateAndEnqueue(ctx, id, eventType, func(ctx context.Context) error {
+		return r.ConnectorRepository.UpdateStatus(ctx, id, status)
+	})
+}
+
+// updateAndEnqueue applies update and describes the connector as it reads
+// afterwards within the same transaction.
+func (r *EventingRepository) updateAndEnqueue(ctx context.Context, id uuid.UUID, eventType domain.ConnectorEventType, update func(ctx context.Context) error) error {
+	return r.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := update(ctx); err != nil {
+			return err
+		}
+		conn, err := r.ConnectorRepository.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		return r.enqueue(ctx, eventType, conn)
+	})
+}
+
+func (r *EventingRepository) enqueue(ctx context.Context, eventType domain.ConnectorEventType, conn *domain.Connector) error {
+	event, err := newConnectorEvent(r.source, eventType, conn)
+	if err != nil {
+		return err
+	}
+	if err := r.outbox.Enqueue(ctx, event); err != nil {
+		return fmt.Errorf("failed to enqueue %s event: %w", eventType, err)
+	}
+	return nil
+}
+
+func newConnectorEvent(source string, eventType domain.ConnectorEventType, conn *domain.Connector) (*domain.CloudEvent, error) {
+	data, err := json.Marshal(domain.ConnectorEventData{
+		ID:               conn.ID.String(),
+		WorkspaceID:      conn.WorkspaceID,
+		TenantID:         conn.TenantID,
+		DefaultChannelID: conn.DefaultChannelID,
+		Status:           conn.Status,
+		CreatedAt:        conn.CreatedAt,
+		UpdatedAt:        conn.UpdatedAt,
+		DeletedAt:        conn.DeletedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s event: %w", eventType, err)
+	}
+
+	return &domain.CloudEvent{
+		SpecVersion:     domain.CloudEventsSpecVersion,
+		ID:              uuid.NewString(),
+		Source:          source,
+		Type:            string(eventType),
+		Subject:         conn.ID.String(),
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		TenantID:        conn.TenantID,
+		Data:            data,
+	}, nil
+}
+
+// DiscardPublisher drops every event. It keeps the outbox relay marking and
+// pruning entries when the outbox only feeds watchers.
+type DiscardPublisher struct{}
+
+func (DiscardPublisher) Publish(context.Context, *domain.CloudEvent) error {
+	return nil
+}
@@ -0,0 +1,224 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultImportConcurrency = 4
+	defaultImportMaxItems    = 5000
+)
+
+type ImportStatus string
+
+const (
+	ImportStatusCreated       ImportStatus = "created"
+	ImportStatusAlreadyExists ImportStatus = "already_exists"
+	// ImportStatusDuplicate marks an item repeating the workspace and tenant
+	// of an earlier item in the same import.
+	ImportStatusDuplicate ImportStatus = "duplicate"
+	ImportStatusInvalid   ImportStatus = "invalid"
+	ImportStatusFailed    ImportStatus = "failed"
+)
+
+// ImportItem is one connector to import. Invalid is set when the caller
+// already rejected the item; it is reported without being created.
+type ImportItem struct {
+	Input   CreateInput
+	Invalid error
+}
+
+// ImportResult reports one item, by its position in the import.
+type ImportResult struct {
+	Index       int
+	WorkspaceID string
+	TenantID    string
+	Status      ImportStatus
+	ConnectorID uuid.UUID
+	Err         error
+}
+
+type ImportSummary struct {
+	Total         int
+	Created       int
+	AlreadyExists int
+	Duplicates    int
+	Invalid       int
+	Failed        int
+	// Truncated is set when more items were sent than an import accepts.
+	Truncated bool
+}
+
+type ImportReport struct {
+	Results []ImportResult
+	Summary ImportSummary
+}
+
+type ImporterOption func(*Importer)
+
+// WithImportConcurrency sets how many connectors are created at once.
+func WithImportConcurrency(n int) ImporterOption {
+	return func(im *Importer) {
+		im.concurrency = n
+	}
+}
+
+// WithImportMaxItems caps the number of items in one import.
+func WithImportMaxItems(n int) ImporterOption {
+	return func(im *Importer) {
+		im.maxItems = n
+	}
+}
+
+// Importer creates connectors in bulk through Service.CreateConnector, so each
+// one gets the same checks, idempotency and audit trail as a single create.
+// Items are created concurrently; a failing item is reported and the rest of
+// the import carries on.
+type Importer struct {
+	service     *Service
+	concurrency int
+	maxItems    int
+}
+
+func NewImporter(svc *Service, opts ...ImporterOption) *Importer {
+	im := &Importer{
+		service:     svc,
+		concurrency: defaultImportConcurrency,
+		maxItems:    defaultImportMaxItems,
+	}
+	for _, opt := range opts {
+		opt(im)
+	}
+	return im
+}
+
+// Import creates the items next returns until it returns io.EOF. The first
+// item over the limit is reported as invalid and ends the import, which is
+// marked truncated. Any other error from next stops the import and is
+// returned along with the report of the items read so far; connectors
+// already created are kept.
+func (im *Importer) Import(ctx context.Context, next func() (ImportItem, error)) (*ImportReport, error) {
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		results   []ImportResult
+		truncated bool
+		seen      = make(map[[2]string]int)
+		slots     = make(chan struct{}, im.concurrency)
+	)
+	record := func(result ImportResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		results = append(results, result)
+	}
+
+	var readErr error
+	for index := 0; ; index++ {
+		item, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			readErr = err
+			break
+		}
+
+		result := ImportResult{Index: index, WorkspaceID: item.Input.WorkspaceID, TenantID: item.Input.TenantID}
+		if index >= im.maxItems {
+			result.Status = ImportStatusInvalid
+			result.Err = fmt.Errorf("%w: an import is limited to %d connectors; this and later items were not imported", ErrInvalidInput, im.maxItems)
+			record(result)
+			truncated = true
+			break
+		}
+		if item.Invalid != nil {
+			result.Status, result.Err = ImportStatusInvalid, item.Invalid
+			record(result)
+			continue
+		}
+		key := [2]string{item.Input.WorkspaceID, item.Input.TenantID}
+		if first, ok := seen[key]; ok {
+			result.Status, result.Err = ImportStatusDuplicate, fmt.Errorf("duplicate of item %d", first)
+			record(result)
+			continue
+		}
+		seen[key] = index
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			readErr = ctx.Err()
+		}
+		if readErr != nil {
+			break
+		}
+		wg.Add(1)
+		go func(result ImportResult, input CreateInput) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			record(im.create(ctx, result, input))
+		}(result, item.Input)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+	report := &ImportReport{Results: results, Summary: summarize(results)}
+	report.Summary.Truncated = truncated
+	logger.Ctx(ctx).Info().
+		Int("total", report.Summary.Total).
+		Int("created", report.Summary.Created).
+		Int("already_exists", report.Summary.AlreadyExists).
+		Int("duplicates", report.Summary.Duplicates).
+		Int("invalid", report.Summary.Invalid).
+		Int("failed", report.Summary.Failed).
+		Bool("truncated", report.Summary.Truncated).
+		Msg("Connector import finished")
+	return report, readErr
+}
+
+func (im *Importer) create(ctx context.Context, result ImportResult, input CreateInput) ImportResult {
+	conn, err := im.service.CreateConnector(ctx, input)
+	var existsErr *ConnectorExistsError
+	switch {
+	case err == nil:
+		result.Status, result.ConnectorID = ImportStatusCreated, conn.ID
+	case errors.As(err, &existsErr):
+		result.Status, result.ConnectorID = ImportStatusAlreadyExists, existsErr.ConnectorID
+	case errors.Is(err, ErrInvalidInput):
+		result.Status, result.Err = ImportStatusInvalid, err
+	default:
+		logger.Ctx(ctx).Warn().Err(err).
+			Str("workspace_id", input.WorkspaceID).
+			Str("tenant_id", input.TenantID).
+			Msg("Failed to import connector")
+		result.Status, result.Err = ImportStatusFailed, err
+	}
+	return result
+}
+
+func summarize(results []ImportResult) ImportSummary {
+	summary := ImportSummary{Total: len(results)}
+	for _, result := range results {
+		switch result.Status {
+		case ImportStatusCreated:
+			summary.Created++
+		case ImportStatusAlreadyExists:
+			summary.AlreadyExists++
+		case ImportStatusDuplicate:
+			summary.Duplicates++
+		case ImportStatusInvalid:
+			summary.Invalid++
+		case ImportStatusFailed:
+			summary.Failed++
+		}
+	}
+	return summary
+}
@@ -0,0 +1,177 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+// OperationReconciler finishes connector operations that were interrupted, for
+// example by a crash between the Secrets Manager and Postgres writes. Creates
+// whose connector row exists are completed, those whose secret was stored are
+// rolled forward from the recorded row, the rest are rolled back, and deletes
+// are driven to completion unless the connector has since been restored.
+type OperationReconciler struct {
+	operations     domain.OperationLog
+	repo           domain.ConnectorRepository
+	secretsManager domain.SecretsManager
+	interval       time.Duration
+	gracePeriod    time.Duration
+}
+
+// NewOperationReconciler only picks up operations untouched for gracePeriod so
+// requests that are still in flight are left alone.
+func NewOperationReconciler(operations domain.OperationLog, repo domain.ConnectorRepository, sm domain.SecretsManager, interval, gracePeriod time.Duration) *OperationReconciler {
+	return &OperationReconciler{
+		operations:     operations,
+		repo:           repo,
+		secretsManager: sm,
+		interval:       interval,
+		gracePeriod:    gracePeriod,
+	}
+}
+
+func (r *OperationReconciler) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			logger.Ctx(ctx).Info().Msg("Operation reconciler stopped.")
+			return
+		}
+	}
+}
+
+func (r *OperationReconciler) RunOnce(ctx context.Context) {
+	const pageSize = 100
+
+	ops, err := r.operations.ListUnfinished(ctx, time.Now().UTC().Add(-r.gracePeriod), pageSize)
+	if err != nil {
+		logger.Ctx(ctx).Error().Err(err).Msg("Error fetching unfinished connector operations")
+		return
+	}
+
+	for i := range ops {
+		op := &ops[i]
+		if err := r.reconcile(ctx, op); err != nil {
+			logger.Ctx(ctx).Warn().
+				Err(err).
+				Str("operation_id", op.ID.String()).
+				Str("kind", string(op.Kind)).
+				Str("state", string(op.State)).
+				Msg("Error reconciling connector operation, will retry")
+			if recordErr := r.operations.RecordFailure(ctx, op.ID, err.Error()); recordErr != nil {
+				logger.Ctx(ctx).Warn().Err(recordErr).Str("operation_id", op.ID.String()).Msg("Failed to record reconcile failure")
+			}
+			continue
+		}
+		logger.Ctx(ctx).Info().
+			Str("operation_id", op.ID.String()).
+			Str("kind", string(op.Kind)).
+			Str("state", string(op.State)).
+			Msg("Connector operation reconciled")
+	}
+}
+
+func (r *OperationReconciler) reconcile(ctx context.Context, op *domain.ConnectorOperation) error {
+	switch op.Kind {
+	case domain.OperationKindCreate:
+		return r.reconcileCreate(ctx, op)
+	case domain.OperationKindDelete:
+		return r.reconcileDelete(ctx, op)
+	default:
+		return fmt.Errorf("unknown operation kind %q", op.Kind)
+	}
+}
+
+func (r *OperationReconciler) reconcileCreate(ctx context.Context, op *domain.ConnectorOperation) error {
+	// Progress is saved best effort, so the recorded state can lag behind a
+	// create that finished. Never undo a create whose connector exists.
+	if _, err := r.repo.GetByID(ctx, op.ConnectorID); err == nil {
+		return r.advance(ctx, op, domain.OperationStateCompleted)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to look up connector: %w", err)
+	}
+
+	if op.State == domain.OperationStateSecretStored {
+		done, err := r.rollForwardCreate(ctx, op)
+		if err != nil || done {
+			return err
+		}
+	}
+
+	// There is no connector row, so undo the secret.
+	if err := r.advance(ctx, op, domain.OperationStateCompensating); err != nil {
+		return err
+	}
+	if err := r.secretsManager.DeleteToken(ctx, op.SecretName); err != nil {
+		return fmt.Errorf("failed to delete secret: %w", err)
+	}
+	return r.advance(ctx, op, domain.OperationStateCompensated)
+}
+
+// rollForwardCreate inserts the recorded connector row, which the caller has
+// checked is missing. It reports false when the row can no longer be created
+// and the create has to be rolled back.
+func (r *OperationReconciler) rollForwardCreate(ctx context.Context, op *domain.ConnectorOperation) (bool, error) {
+	if op.Connector == nil {
+		return false, nil
+	}
+	if op.Connector.Status == "" {
+		op.Connector.Status = domain.ConnectorStatusActive
+	}
+	if err := r.repo.Create(ctx, op.Connector); err != nil {
+		if errors.Is(err, domain.ErrConflict) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create connector: %w", err)
+	}
+	return true, r.advance(ctx, op, domain.OperationStateCompleted)
+}
+
+func (r *OperationReconciler) reconcileDelete(ctx context.Context, op *domain.ConnectorOperation) error {
+	if op.State == domain.OperationStateStarted {
+		if err := r.repo.Delete(ctx, op.ConnectorID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to delete connector: %w", err)
+		}
+		if err := r.advance(ctx, op, domain.OperationStateRowDeleted); err != nil {
+			return err
+		}
+	}
+
+	// A restore may have brought the connector back, secret and all, since
+	// the delete. Only a row that is still soft-deleted, or already purged,
+	// gives up its secret.
+	if _, err := r.repo.GetDeletedByID(ctx, op.ConnectorID); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to look up deleted connector: %w", err)
+		}
+		if _, err := r.repo.GetByID(ctx, op.ConnectorID); err == nil {
+			return r.advance(ctx, op, domain.OperationStateCancelled)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to look up connector: %w", err)
+		}
+	}
+
+	if err := r.secretsManager.DeleteToken(ctx, op.SecretName); err != nil {
+		return fmt.Errorf("failed to delete secret: %w", err)
+	}
+	return r.advance(ctx, op, domain.OperationStateCompleted)
+}
+
+func (r *OperationReconciler) advance(ctx context.Context, op *domain.ConnectorOperation, state domain.OperationState) error {
+	if err := r.operations.Advance(ctx, op.ID, state); err != nil {
+		return fmt.Errorf("failed to advance operation to %s: %w", state, err)
+	}
+	op.State = state
+	return nil
+}
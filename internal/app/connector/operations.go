@@ -0,0 +1,78 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// beginOperation persists the intent to create or delete conn before any
+// external side effect happens. It returns a nil operation when the service
+// runs without an operation log.
+func (s *Service) beginOperation(ctx context.Context, kind domain.OperationKind, conn *domain.Connector) (*domain.ConnectorOperation, error) {
+	if s.operations == nil {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	op := &domain.ConnectorOperation{
+		ID:          uuid.New(),
+		Kind:        kind,
+		State:       domain.OperationStateStarted,
+		ConnectorID: conn.ID,
+		SecretName:  conn.SecretName,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if kind == domain.OperationKindCreate {
+		op.Connector = conn
+	}
+
+	if err := s.operations.Begin(ctx, op); err != nil {
+		logger.Ctx(ctx).Error().Err(err).Str("connector_id", conn.ID.String()).Msg("Failed to record connector operation")
+		return nil, fmt.Errorf("failed to record %s operation: %w", kind, err)
+	}
+	return op, nil
+}
+
+// advanceOperation is best effort: if the state cannot be saved the reconciler
+// sees an older state and repeats steps that are all idempotent.
+func (s *Service) advanceOperation(ctx context.Context, op *domain.ConnectorOperation, state domain.OperationState) {
+	if op == nil {
+		return
+	}
+	if err := s.operations.Advance(ctx, op.ID, state); err != nil {
+		logger.Ctx(ctx).Warn().
+			Err(err).
+			Str("operation_id", op.ID.String()).
+			Str("state", string(state)).
+			Msg("Failed to advance connector operation")
+		return
+	}
+	op.State = state
+}
+
+func (s *Service) failOperation(ctx context.Context, op *domain.ConnectorOperation, cause error) {
+	if op == nil {
+		return
+	}
+	if err := s.operations.RecordFailure(ctx, op.ID, cause.Error()); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Str("operation_id", op.ID.String()).Msg("Failed to record connector operation failure")
+	}
+}
+
+// compensateCreate removes the secret written by a create that cannot finish.
+// If that fails too the operation is left compensating for the reconciler.
+func (s *Service) compensateCreate(ctx context.Context, op *domain.ConnectorOperation, secretName string) {
+	s.advanceOperation(ctx, op, domain.OperationStateCompensating)
+	if err := s.secretsManager.DeleteToken(ctx, secretName); err != nil {
+		logger.Ctx(ctx).Error().Err(err).Str("secret_name", secretName).Msg("Failed to clean up secret after failed create")
+		s.failOperation(ctx, op, err)
+		return
+	}
+	s.advanceOperation(ctx, op, domain.OperationStateCompensated)
+}
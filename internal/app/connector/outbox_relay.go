@@ -0,0 +1,96 @@
+package connector
+
+import (
+	"context"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+const (
+	relayBatchSize = 100
+	// relayLease is how long a claimed entry stays with one relay before
+	// another may publish it again.
+	relayLease = time.Minute
+	// publishedRetention keeps published entries around for debugging.
+	publishedRetention = 24 * time.Hour
+)
+
+// OutboxRelay publishes outbox entries in the order they were written. Delivery
+// is at least once: consumers should dedupe on the CloudEvent ID.
+type OutboxRelay struct {
+	outbox    domain.Outbox
+	publisher domain.EventPublisher
+	interval  time.Duration
+}
+
+func NewOutboxRelay(outbox domain.Outbox, publisher domain.EventPublisher, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outbox:    outbox,
+		publisher: publisher,
+		interval:  interval,
+	}
+}
+
+func (r *OutboxRelay) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			logger.Ctx(ctx).Info().Msg("Outbox relay stopped.")
+			return
+		}
+	}
+}
+
+func (r *OutboxRelay) RunOnce(ctx context.Context) {
+	for {
+		entries, err := r.outbox.Claim(ctx, relayBatchSize, relayLease)
+		if err != nil {
+			logger.Ctx(ctx).Error().Err(err).Msg("Failed to claim outbox entries")
+			return
+		}
+
+		for _, entry := range entries {
+			if !r.publish(ctx, entry) {
+				// Stop here so later events for the same connector are not
+				// published ahead of this one; the lease brings it back.
+				return
+			}
+		}
+
+		if len(entries) < relayBatchSize {
+			break
+		}
+	}
+
+	if err := r.outbox.PrunePublished(ctx, time.Now().Add(-publishedRetention)); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("Failed to prune published outbox entries")
+	}
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, entry domain.OutboxEntry) bool {
+	event := entry.Event
+	if err := r.publisher.Publish(ctx, &event); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).
+			Str("event_id", event.ID).
+			Str("event_type", event.Type).
+			Int("attempts", entry.Attempts+1).
+			Msg("Failed to publish event")
+		if recordErr := r.outbox.RecordFailure(ctx, event.ID, err.Error()); recordErr != nil {
+			logger.Ctx(ctx).Error().Err(recordErr).Str("event_id", event.ID).Msg("Failed to record publish failure")
+		}
+		return false
+	}
+
+	if err := r.outbox.MarkPublished(ctx, event.ID); err != nil {
+		// The event goes out again once its lease ends; consumers dedupe it.
+		logger.Ctx(ctx).Error().Err(err).Str("event_id", event.ID).Msg("Failed to mark event as published")
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package connector
+
+import (
+	"context"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+// PurgeJob hard-deletes connectors whose soft delete is older than the secret
+// recovery window, at which point they can no longer be restored.
+type PurgeJob struct {
+	repo     domain.ConnectorRepository
+	interval time.Duration
+}
+
+func NewPurgeJob(repo domain.ConnectorRepository, interval time.Duration) *PurgeJob {
+	return &PurgeJob{
+		repo:     repo,
+		interval: interval,
+	}
+}
+
+func (j *PurgeJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			j.RunOnce(ctx)
+		case <-ctx.Done():
+			logger.Ctx(ctx).Info().Msg("Connector purge job stopped.")
+			return
+		}
+	}
+}
+
+func (j *PurgeJob) RunOnce(ctx context.Context) {
+	const pageSize = 100
+	cutoff := time.Now().UTC().Add(-domain.SecretRecoveryWindow)
+
+	for {
+		connectors, err := j.repo.ListDeletedBefore(ctx, cutoff, pageSize)
+		if err != nil {
+			logger.Ctx(ctx).Error().Err(err).Msg("Error fetching connectors to purge")
+			return
+		}
+
+		for _, connector := range connectors {
+			if err := j.repo.Purge(ctx, connector.ID); err != nil {
+				logger.Ctx(ctx).Warn().
+					Err(err).
+					Str("connector_id", connector.ID.String()).
+					Msg("Error purging connector")
+				return
+			}
+			logger.Ctx(ctx).Info().Str("connector_id", connector.ID.String()).Msg("Connector purged")
+		}
+
+		if len(connectors) < pageSize {
+			return
+		}
+	}
+}
@@ -9,20 +9,56 @@ import (
 
 	"github.com/connector-recruitment/internal/domain"
 	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/observability"
+	"github.com/connector-recruitment/pkg/requestctx"
 )
 
+// rotationActor identifies the rotation job in the audit log.
+const rotationActor = "system:rotation"
+
 type RotationService struct {
 	repo           domain.ConnectorRepository
 	secretsManager domain.SecretsManager
 	interval       time.Duration
+	auditLog       domain.AuditLog
+	metrics        *observability.Metrics
+	tenants        domain.TenantRepository
+}
+
+type RotationOption func(*RotationService)
+
+func WithRotationAuditLog(log domain.AuditLog) RotationOption {
+	return func(rs *RotationService) {
+		rs.auditLog = log
+	}
+}
+
+// WithRotationMetrics counts each connector's rotation by outcome.
+func WithRotationMetrics(m *observability.Metrics) RotationOption {
+	return func(rs *RotationService) {
+		rs.metrics = m
+	}
+}
+
+// WithRotationTenantRepository skips the connectors of suspended tenants.
+func WithRotationTenantRepository(tenants domain.TenantRepository) RotationOption {
+	return func(rs *RotationService) {
+		rs.tenants = tenants
+	}
 }
 
-func NewRotationService(repo domain.ConnectorRepository, sm domain.SecretsManager, interval time.Duration) *RotationService {
-	return &RotationService{
+func NewRotationService(repo domain.ConnectorRepository, sm domain.SecretsManager, interval time.Duration, opts ...RotationOption) *RotationService {
+	rs := &RotationService{
 		repo:           repo,
 		secretsManager: sm,
 		interval:       interval,
 	}
+
+	for _, opt := range opts {
+		opt(rs)
+	}
+
+	return rs
 }
 
 func (rs *RotationService) Start(ctx context.Context) {
@@ -34,30 +70,49 @@ func (rs *RotationService) Start(ctx context.Context) {
 		case <-ticker.C:
 			rs.rotateSecrets(ctx)
 		case <-ctx.Done():
-			logger.Info().Msg("Secret rotation stopped.")
+			logger.Ctx(ctx).Info().Msg("Secret rotation stopped.")
 			return
 		}
 	}
 }
 
 func (rs *RotationService) rotateSecrets(ctx context.Context) {
-	logger.Info().Msg("Starting secret rotation...")
+	logger.Ctx(ctx).Info().Msg("Starting secret rotation...")
+	ctx = requestctx.WithActor(ctx, rotationActor)
 
 	const pageSize = 100
 	var cursor *domain.ListCursor
+	tenants := newActiveTenants(rs.tenants)
 
 	for {
 		connectors, nextCursor, err := rs.repo.ListConnectors(ctx, pageSize, cursor)
 		if err != nil {
-			logger.Error().Err(err).Msg("Error fetching connectors for rotation")
+			logger.Ctx(ctx).Error().Err(err).Msg("Error fetching connectors for rotation")
 			return
 		}
 
 		for _, connector := range connectors {
-			connCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			active, err := tenants.check(ctx, connector.TenantID)
+			if err != nil {
+				logger.Ctx(ctx).Warn().
+					Err(err).
+					Str("connector_id", connector.ID.String()).
+					Msg("Error checking tenant for rotation")
+				continue
+			}
+			if !active {
+				continue
+			}
 
-			if err := rs.rotateConnectorSecret(connCtx, connector); err != nil {
-				logger.Warn().
+			connCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			connCtx, span := startSpan(connCtx, "connector.RotationService.RotateSecret", connectorAttributes(&connector)...)
+
+			err = rs.rotateConnectorSecret(connCtx, connector)
+			recordAudit(connCtx, rs.auditLog, domain.AuditActionSecretRotate, &connector, nil, err)
+			rs.metrics.RecordRotation(err)
+			endSpan(span, err)
+			if err != nil {
+				logger.Ctx(ctx).Warn().
 					Err(err).
 					Str("connector_id", connector.ID.String()).
 					Msg("Error rotating secret for connector")
@@ -66,7 +121,7 @@ func (rs *RotationService) rotateSecrets(ctx context.Context) {
 			}
 
 			cancel()
-			logger.Info().
+			logger.Ctx(ctx).Info().
 				Str("connector_id", connector.ID.String()).
 				Msg("Successfully rotated secret for connector")
 		}
@@ -78,7 +133,7 @@ func (rs *RotationService) rotateSecrets(ctx context.Context) {
 		cursor = nextCursor
 	}
 
-	logger.Info().Msg("Secret rotation completed.")
+	logger.Ctx(ctx).Info().Msg("Secret rotation completed.")
 }
 
 func (rs *RotationService) rotateConnectorSecret(ctx context.Context, connector domain.Connector) error {
@@ -87,14 +142,14 @@ func (rs *RotationService) rotateConnectorSecret(ctx context.Context, connector
 		return fmt.Errorf("failed to generate new token: %w", err)
 	}
 
-	secretName := fmt.Sprintf("connector-%s-%s", connector.WorkspaceID, connector.TenantID)
+	secretName := connector.SecretName
 	if err := rs.secretsManager.StoreToken(ctx, secretName, newToken); err != nil {
 		return fmt.Errorf("failed to store token: %w", err)
 	}
 
 	if err := rs.repo.UpdateConnector(ctx, connector.ID, newToken); err != nil {
 		if rollbackErr := rs.secretsManager.StoreToken(ctx, secretName, connector.SecretVersion); rollbackErr != nil {
-			logger.Warn().
+			logger.Ctx(ctx).Warn().
 				Err(rollbackErr).
 				Str("connector_id", connector.ID.String()).
 				Msg("Failed to rollback secret for connector")
@@ -0,0 +1,138 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+type SecretMigrationReport struct {
+	Migrated int
+	Skipped  int
+	Failed   int
+}
+
+// SecretMigrator moves connector tokens from their stored secret name to the
+// name produced by the configured naming strategy. A connector is only switched
+// to the new name once the copied value has been read back and compared.
+type SecretMigrator struct {
+	repo           domain.ConnectorRepository
+	secretsManager domain.SecretsManager
+	naming         domain.SecretNaming
+	deleteOld      bool
+	dryRun         bool
+}
+
+type SecretMigratorOption func(*SecretMigrator)
+
+// WithDeleteOldSecrets removes the old secret after a connector is switched.
+func WithDeleteOldSecrets() SecretMigratorOption {
+	return func(m *SecretMigrator) {
+		m.deleteOld = true
+	}
+}
+
+// WithDryRun reports what would be migrated without touching any secret.
+func WithDryRun() SecretMigratorOption {
+	return func(m *SecretMigrator) {
+		m.dryRun = true
+	}
+}
+
+func NewSecretMigrator(repo domain.ConnectorRepository, sm domain.SecretsManager, naming domain.SecretNaming, opts ...SecretMigratorOption) *SecretMigrator {
+	m := &SecretMigrator{
+		repo:           repo,
+		secretsManager: sm,
+		naming:         naming,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+func (m *SecretMigrator) Run(ctx context.Context) (SecretMigrationReport, error) {
+	const pageSize = 100
+	var report SecretMigrationReport
+	var cursor *domain.ListCursor
+
+	for {
+		connectors, nextCursor, err := m.repo.ListConnectors(ctx, pageSize, cursor)
+		if err != nil {
+			return report, fmt.Errorf("failed to list connectors: %w", err)
+		}
+
+		for _, connector := range connectors {
+			target := m.naming.Name(connector.ID)
+			if connector.SecretName == target {
+				report.Skipped++
+				continue
+			}
+			if m.dryRun {
+				logger.Ctx(ctx).Info().
+					Str("connector_id", connector.ID.String()).
+					Str("from", connector.SecretName).
+					Str("to", target).
+					Msg("Would migrate secret")
+				report.Migrated++
+				continue
+			}
+			if err := m.migrateConnector(ctx, connector, target); err != nil {
+				logger.Ctx(ctx).Error().
+					Err(err).
+					Str("connector_id", connector.ID.String()).
+					Msg("Failed to migrate secret")
+				report.Failed++
+				continue
+			}
+			report.Migrated++
+		}
+
+		if nextCursor == nil {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	return report, nil
+}
+
+func (m *SecretMigrator) migrateConnector(ctx context.Context, connector domain.Connector, target string) error {
+	token, err := m.secretsManager.GetToken(ctx, connector.SecretName)
+	if err != nil {
+		return fmt.Errorf("failed to read secret %s: %w", connector.SecretName, err)
+	}
+
+	if err := m.secretsManager.StoreToken(ctx, target, token); err != nil {
+		return fmt.Errorf("failed to copy secret to %s: %w", target, err)
+	}
+
+	copied, err := m.secretsManager.GetToken(ctx, target)
+	if err != nil {
+		return fmt.Errorf("failed to verify secret %s: %w", target, err)
+	}
+	if copied != token {
+		return fmt.Errorf("secret %s does not match its source", target)
+	}
+
+	if err := m.repo.UpdateSecretName(ctx, connector.ID, target); err != nil {
+		return fmt.Errorf("failed to switch connector to %s: %w", target, err)
+	}
+
+	logger.Ctx(ctx).Info().
+		Str("connector_id", connector.ID.String()).
+		Str("secret_name", target).
+		Msg("Secret migrated")
+
+	if m.deleteOld {
+		if err := m.secretsManager.DeleteToken(ctx, connector.SecretName); err != nil {
+			logger.Ctx(ctx).Warn().
+				Err(err).
+				Str("secret_name", connector.SecretName).
+				Msg("Failed to delete old secret after migration")
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package connector
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -21,20 +22,60 @@ func WithOAuthManager(manager *OAuthStateManager) ServiceOption {
 	}
 }
 
+func WithTenantRepository(tenants domain.TenantRepository) ServiceOption {
+	return func(s *Service) {
+		s.tenants = tenants
+	}
+}
+
+func WithIdempotencyStore(store domain.IdempotencyStore) ServiceOption {
+	return func(s *Service) {
+		s.idempotency = store
+	}
+}
+
+func WithSecretNaming(naming domain.SecretNaming) ServiceOption {
+	return func(s *Service) {
+		s.naming = naming
+	}
+}
+
+func WithOperationLog(log domain.OperationLog) ServiceOption {
+	return func(s *Service) {
+		s.operations = log
+	}
+}
+
+// WithSlackBreakerPerWorkspace trips Slack calls per workspace rather than
+// for all of Slack at once.
+func WithSlackBreakerPerWorkspace(enabled bool) ServiceOption {
+	return func(s *Service) {
+		s.slackPerWorkspace = enabled
+	}
+}
+
 type Service struct {
 	repo           domain.ConnectorRepository
 	secretsManager domain.SecretsManager
 	slackClient    domain.SlackClient
-	cb             *resilience.CircuitBreaker
-	oauthManager   *OAuthStateManager
+	breakers       *resilience.Registry
+	// slackPerWorkspace gives each Slack workspace its own breaker.
+	slackPerWorkspace bool
+	oauthManager      *OAuthStateManager
+	tenants           domain.TenantRepository
+	idempotency       domain.IdempotencyStore
+	naming            domain.SecretNaming
+	operations        domain.OperationLog
+	auditLog          domain.AuditLog
 }
 
-func NewService(repo domain.ConnectorRepository, sm domain.SecretsManager, sc domain.SlackClient, cb *resilience.CircuitBreaker, opts ...ServiceOption) *Service {
+func NewService(repo domain.ConnectorRepository, sm domain.SecretsManager, sc domain.SlackClient, breakers *resilience.Registry, opts ...ServiceOption) *Service {
 	s := &Service{
 		repo:           repo,
 		secretsManager: sm,
 		slackClient:    sc,
-		cb:             cb,
+		breakers:       breakers,
+		naming:         domain.NewSecretNaming("connector", "development"),
 	}
 
 	for _, opt := range opts {
@@ -45,67 +86,221 @@ func NewService(repo domain.ConnectorRepository, sm domain.SecretsManager, sc do
 }
 
 func (s *Service) CreateConnector(ctx context.Context, input CreateInput) (*domain.Connector, error) {
+	ctx, span := startSpan(ctx, "connector.Service.CreateConnector",
+		attrWorkspaceID.String(input.WorkspaceID), attrTenantID.String(input.TenantID))
+	conn, err := s.createConnector(ctx, input)
+	target := conn
+	if target == nil {
+		target = &domain.Connector{WorkspaceID: input.WorkspaceID, TenantID: input.TenantID}
+	} else {
+		span.SetAttributes(attrConnectorID.String(conn.ID.String()))
+	}
+	recordAudit(ctx, s.auditLog, domain.AuditActionConnectorCreate, target, connectorDiff(nil, conn), err)
+	endSpan(span, err)
+	return conn, err
+}
+
+func (s *Service) createConnector(ctx context.Context, input CreateInput) (*domain.Connector, error) {
 	if len(input.WorkspaceID) < 3 || len(input.TenantID) == 0 || len(input.Token) == 0 {
 		return nil, fmt.Errorf("invalid input: %w", ErrInvalidInput)
 	}
 
-	result, err := s.cb.Execute(ctx, func() (interface{}, error) {
-		logger.Info().
-			Str("default_channel", input.DefaultChannel).
-			Msg("Resolving channel ID")
+	if err := s.ensureTenantActive(ctx, input.TenantID); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Str("tenant_id", input.TenantID).Msg("Rejecting connector creation for tenant")
+		return nil, fmt.Errorf("failed to create connector: %w", err)
+	}
 
-		channelID, err := s.slackClient.ResolveChannelID(ctx, input.Token, input.DefaultChannel)
-		if err != nil {
-			logger.Error().Err(err).Msg("Failed to resolve channel ID")
-			return nil, fmt.Errorf("failed to resolve channel: %w", err)
+	requestHash := input.hash()
+	if input.IdempotencyKey != "" && s.idempotency != nil {
+		conn, err := s.replayIdempotentCreate(ctx, input.IdempotencyKey, requestHash)
+		if err != nil || conn != nil {
+			return conn, err
 		}
+	}
 
-		secretName := fmt.Sprintf("connector-%s-%s", input.WorkspaceID, input.TenantID)
-		logger.Info().
-			Str("secret_name", secretName).
-			Msg("Storing Slack token in Secrets Manager (not logging token value)")
+	if err := s.ensureConnectorAbsent(ctx, input.WorkspaceID, input.TenantID); err != nil {
+		return nil, err
+	}
 
-		if err := s.secretsManager.StoreToken(ctx, secretName, input.Token); err != nil {
-			logger.Error().Err(err).Msg("Failed to store token in Secrets Manager")
-			return nil, fmt.Errorf("failed to store secret: %w", err)
-		}
+	conn, err := s.provisionConnector(ctx, input)
+	if err != nil {
+		logger.Ctx(ctx).Error().Err(err).Msg("Failed to provision connector")
+		return nil, fmt.Errorf("failed to create connector: %w", err)
+	}
 
-		now := time.Now().UTC()
-		conn := &domain.Connector{
-			ID:               uuid.New(),
-			WorkspaceID:      input.WorkspaceID,
-			TenantID:         input.TenantID,
-			DefaultChannelID: channelID,
-			CreatedAt:        now,
-			UpdatedAt:        now,
-			SecretVersion:    "v1",
+	if input.IdempotencyKey != "" && s.idempotency != nil {
+		record := &domain.IdempotencyRecord{
+			Key:         input.IdempotencyKey,
+			RequestHash: requestHash,
+			ConnectorID: conn.ID,
+			CreatedAt:   time.Now().UTC(),
 		}
-
-		logger.Info().Interface("connector", conn).Msg("Creating connector in DB")
-		if err := s.repo.Create(ctx, conn); err != nil {
-			logger.Error().Err(err).Msg("Failed to create connector in database")
-			cleanupErr := s.secretsManager.DeleteToken(ctx, secretName)
-			if cleanupErr != nil {
-				logger.Error().Err(cleanupErr).
-					Msg("Failed to clean up secret after DB error")
-			}
-			return nil, fmt.Errorf("failed to create connector: %w", err)
+		if err := s.idempotency.Save(ctx, record); err != nil {
+			logger.Ctx(ctx).Warn().Err(err).Str("connector_id", conn.ID.String()).Msg("Failed to record idempotency key")
 		}
+	}
 
-		return conn, nil
+	return conn, nil
+}
+
+// provisionConnector resolves the channel, stores the token and records the
+// connector. Each dependency has its own breaker, so one failing does not
+// stop calls to the others.
+func (s *Service) provisionConnector(ctx context.Context, input CreateInput) (*domain.Connector, error) {
+	logger.Ctx(ctx).Info().
+		Str("default_channel", input.DefaultChannel).
+		Msg("Resolving channel ID")
+
+	var channelID string
+	err := s.slackBreaker(input.WorkspaceID).Do(ctx, func() (err error) {
+		channelID, err = s.slackClient.ResolveChannelID(ctx, input.Token, input.DefaultChannel)
+		return err
 	})
 	if err != nil {
-		logger.Error().Err(err).Msg("Circuit breaker execution failed for CreateConnector")
+		logger.Ctx(ctx).Error().Err(err).Msg("Failed to resolve channel ID")
+		return nil, fmt.Errorf("failed to resolve channel: %w", err)
+	}
+
+	id := uuid.New()
+	now := time.Now().UTC()
+	conn := &domain.Connector{
+		ID:               id,
+		WorkspaceID:      input.WorkspaceID,
+		TenantID:         input.TenantID,
+		DefaultChannelID: channelID,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		SecretVersion:    "v1",
+		SecretName:       s.naming.Name(id),
+		Status:           domain.ConnectorStatusActive,
+	}
+
+	op, err := s.beginOperation(ctx, domain.OperationKindCreate, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Ctx(ctx).Info().
+		Str("secret_name", conn.SecretName).
+		Msg("Storing Slack token in Secrets Manager (not logging token value)")
+
+	if err := s.breakers.Get(resilience.BreakerSecretsManager).Do(ctx, func() error {
+		return s.secretsManager.StoreToken(ctx, conn.SecretName, input.Token)
+	}); err != nil {
+		logger.Ctx(ctx).Error().Err(err).Msg("Failed to store token in Secrets Manager")
+		if op != nil {
+			// The write may have landed despite the error.
+			s.compensateCreate(ctx, op, conn.SecretName)
+		}
+		return nil, fmt.Errorf("failed to store secret: %w", err)
+	}
+	s.advanceOperation(ctx, op, domain.OperationStateSecretStored)
+
+	logger.Ctx(ctx).Info().
+		Str("connector_id", conn.ID.String()).
+		Str("workspace_id", conn.WorkspaceID).
+		Str("tenant_id", conn.TenantID).
+		Str("secret_name", conn.SecretName).
+		Msg("Creating connector in DB")
+	if err := s.breakers.Get(resilience.BreakerPostgres).Do(ctx, func() error {
+		return s.repo.Create(ctx, conn)
+	}); err != nil {
+		logger.Ctx(ctx).Error().Err(err).Msg("Failed to create connector in database")
+		s.compensateCreate(ctx, op, conn.SecretName)
+		if errors.Is(err, domain.ErrConflict) {
+			return nil, s.conflictError(ctx, input.WorkspaceID, input.TenantID, err)
+		}
 		return nil, fmt.Errorf("failed to create connector: %w", err)
 	}
+	s.advanceOperation(ctx, op, domain.OperationStateCompleted)
+
+	return conn, nil
+}
+
+func (s *Service) slackBreaker(workspaceID string) *resilience.CircuitBreaker {
+	if s.slackPerWorkspace {
+		return s.breakers.Get(resilience.SlackWorkspace(workspaceID))
+	}
+	return s.breakers.Get(resilience.BreakerSlack)
+}
+
+// replayIdempotentCreate returns the connector a previous request with the same
+// idempotency key created, or nil when the key has not been seen.
+func (s *Service) replayIdempotentCreate(ctx context.Context, key, requestHash string) (*domain.Connector, error) {
+	record, err := s.idempotency.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to look up idempotency key: %w", err)
+	}
+	if record.RequestHash != requestHash {
+		return nil, fmt.Errorf("idempotency key reused with a different request: %w", ErrInvalidInput)
+	}
+
+	logger.Ctx(ctx).Info().
+		Str("connector_id", record.ConnectorID.String()).
+		Msg("Replaying idempotent CreateConnector")
+	return s.getConnector(ctx, record.ConnectorID)
+}
+
+func (s *Service) ensureConnectorAbsent(ctx context.Context, workspaceID, tenantID string) error {
+	existing, err := s.repo.GetByWorkspaceAndTenant(ctx, workspaceID, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to check for existing connector: %w", err)
+	}
+	return fmt.Errorf("failed to create connector: %w", &ConnectorExistsError{ConnectorID: existing.ID})
+}
 
-	return result.(*domain.Connector), nil
+// conflictError resolves a unique violation raised by a concurrent create into
+// an error carrying the winning connector's ID.
+func (s *Service) conflictError(ctx context.Context, workspaceID, tenantID string, cause error) error {
+	existing, err := s.repo.GetByWorkspaceAndTenant(ctx, workspaceID, tenantID)
+	if err != nil {
+		return fmt.Errorf("%v: %w", cause, ErrAlreadyExists)
+	}
+	return &ConnectorExistsError{ConnectorID: existing.ID}
 }
 
 func (s *Service) GetConnector(ctx context.Context, id uuid.UUID) (*domain.Connector, error) {
+	ctx, span := startSpan(ctx, "connector.Service.GetConnector", attrConnectorID.String(id.String()))
+	conn, err := s.getConnector(ctx, id)
+	if err == nil {
+		// A suspended tenant's connectors stay hidden until it is reactivated.
+		if err = s.ensureTenantActive(ctx, conn.TenantID); err != nil {
+			err = fmt.Errorf("failed to get connector: %w", err)
+		}
+	}
+	target := conn
+	if target == nil {
+		target = &domain.Connector{ID: id}
+	} else {
+		span.SetAttributes(connectorAttributes(conn)...)
+	}
+	recordAudit(ctx, s.auditLog, domain.AuditActionConnectorRead, target, nil, err)
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// ensureTenantActive is EnsureTenantActive for services built without a
+// tenant repository, which skip the check.
+func (s *Service) ensureTenantActive(ctx context.Context, tenantID string) error {
+	if s.tenants == nil {
+		return nil
+	}
+	return EnsureTenantActive(ctx, s.tenants, tenantID)
+}
+
+func (s *Service) getConnector(ctx context.Context, id uuid.UUID) (*domain.Connector, error) {
 	conn, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		logger.Warn().Err(err).Str("connector_id", id.String()).Msg("Connector not found")
+		logger.Ctx(ctx).Warn().Err(err).Str("connector_id", id.String()).Msg("Connector not found")
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("failed to get connector: %w", ErrNotFound)
 		}
@@ -114,52 +309,181 @@ func (s *Service) GetConnector(ctx context.Context, id uuid.UUID) (*domain.Conne
 	return conn, nil
 }
 
-func (s *Service) DeleteConnector(ctx context.Context, id uuid.UUID, workspaceID, tenantID string) error {
-	logger.Info().
+func (s *Service) DeleteConnector(ctx context.Context, id uuid.UUID, workspaceID, tenantID string) (err error) {
+	ctx, span := startSpan(ctx, "connector.Service.DeleteConnector", connectorAttributes(
+		&domain.Connector{ID: id, WorkspaceID: workspaceID, TenantID: tenantID})...)
+	defer func() { endSpan(span, err) }()
+
+	logger.Ctx(ctx).Info().
 		Str("connector_id", id.String()).
 		Msg("Attempting to delete connector")
 
+	conn, err := s.getConnector(ctx, id)
+	if err == nil && (conn.WorkspaceID != workspaceID || conn.TenantID != tenantID) {
+		logger.Ctx(ctx).Warn().Str("connector_id", id.String()).Msg("Connector does not belong to the given workspace and tenant")
+		err = ErrNotFound
+	}
+	if err != nil {
+		recordAudit(ctx, s.auditLog, domain.AuditActionConnectorDelete,
+			&domain.Connector{ID: id, WorkspaceID: workspaceID, TenantID: tenantID}, nil, err)
+		return fmt.Errorf("failed to delete connector: %w", err)
+	}
+	if err := s.ensureTenantActive(ctx, conn.TenantID); err != nil {
+		recordAudit(ctx, s.auditLog, domain.AuditActionConnectorDelete, conn, nil, err)
+		return fmt.Errorf("failed to delete connector: %w", err)
+	}
+
+	err = s.deleteConnector(ctx, conn)
+	var diff json.RawMessage
+	if err == nil {
+		deleted := *conn
+		deletedAt := time.Now().UTC()
+		deleted.DeletedAt = &deletedAt
+		diff = connectorDiff(conn, &deleted)
+	}
+	recordAudit(ctx, s.auditLog, domain.AuditActionConnectorDelete, conn, diff, err)
+	return err
+}
+
+func (s *Service) deleteConnector(ctx context.Context, conn *domain.Connector) error {
+	id := conn.ID
+
+	op, err := s.beginOperation(ctx, domain.OperationKindDelete, conn)
+	if err != nil {
+		return fmt.Errorf("failed to delete connector: %w", err)
+	}
+
 	if err := s.repo.Delete(ctx, id); err != nil {
-		logger.Warn().Err(err).Str("connector_id", id.String()).Msg("Failed to delete connector in DB")
+		logger.Ctx(ctx).Warn().Err(err).Str("connector_id", id.String()).Msg("Failed to delete connector in DB")
+		s.failOperation(ctx, op, err)
 		if errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("failed to delete connector: %w", ErrNotFound)
 		}
 		return fmt.Errorf("failed to delete connector: %w", err)
 	}
+	s.advanceOperation(ctx, op, domain.OperationStateRowDeleted)
 
-	secretName := fmt.Sprintf("connector-%s-%s", workspaceID, tenantID)
-	if err := s.secretsManager.DeleteToken(ctx, secretName); err != nil {
-		logger.Error().Err(err).Str("secret_name", secretName).Msg("Failed to delete token from Secrets Manager")
+	if err := s.secretsManager.DeleteToken(ctx, conn.SecretName); err != nil {
+		logger.Ctx(ctx).Error().Err(err).Str("secret_name", conn.SecretName).Msg("Failed to delete token from Secrets Manager")
+		if op != nil {
+			// The row is gone; the reconciler finishes removing the secret.
+			s.failOperation(ctx, op, err)
+			return nil
+		}
 		return fmt.Errorf("failed to delete token from Secrets Manager: %w", handleAWSError(err))
 	}
+	s.advanceOperation(ctx, op, domain.OperationStateCompleted)
 	return nil
 }
 
-func (s *Service) GetOAuthV2URL(ctx context.Context, redirectURI string) (string, error) {
-	logger.Info().Msg("Generating OAuth V2 state")
+// RestoreConnector undoes a delete while the connector's secret can still be
+// recovered from Secrets Manager.
+func (s *Service) RestoreConnector(ctx context.Context, id uuid.UUID) (_ *domain.Connector, err error) {
+	ctx, span := startSpan(ctx, "connector.Service.RestoreConnector", attrConnectorID.String(id.String()))
+	defer func() { endSpan(span, err) }()
+
+	logger.Ctx(ctx).Info().Str("connector_id", id.String()).Msg("Attempting to restore connector")
+
+	deleted, err := s.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		recordAudit(ctx, s.auditLog, domain.AuditActionConnectorRestore, &domain.Connector{ID: id}, nil, err)
+		return nil, fmt.Errorf("failed to restore connector: %w", err)
+	}
+
+	conn, err := s.restoreConnector(ctx, deleted)
+	var diff json.RawMessage
+	if err == nil {
+		diff = connectorDiff(deleted, conn)
+	}
+	recordAudit(ctx, s.auditLog, domain.AuditActionConnectorRestore, deleted, diff, err)
+	return conn, err
+}
+
+func (s *Service) restoreConnector(ctx context.Context, deleted *domain.Connector) (*domain.Connector, error) {
+	id := deleted.ID
+	if time.Since(*deleted.DeletedAt) >= domain.SecretRecoveryWindow {
+		return nil, fmt.Errorf("connector %s was deleted at %s: %w", id, deleted.DeletedAt.Format(time.RFC3339), ErrRestoreExpired)
+	}
+
+	if err := s.ensureTenantActive(ctx, deleted.TenantID); err != nil {
+		return nil, fmt.Errorf("failed to restore connector: %w", err)
+	}
+
+	// A delete whose secret removal failed is still pending. Left alone, the
+	// reconciler would delete the secret of the connector restored here.
+	if s.operations != nil {
+		if err := s.operations.CancelUnfinished(ctx, id, domain.OperationKindDelete); err != nil {
+			return nil, fmt.Errorf("failed to cancel pending delete: %w", err)
+		}
+	}
+
+	if err := s.secretsManager.RestoreToken(ctx, deleted.SecretName); err != nil {
+		logger.Ctx(ctx).Error().Err(err).Str("secret_name", deleted.SecretName).Msg("Failed to restore token in Secrets Manager")
+		s.rescheduleSecretDeletion(ctx, deleted.SecretName)
+		return nil, fmt.Errorf("failed to restore secret: %w", handleAWSError(err))
+	}
+
+	if err := s.repo.Restore(ctx, id); err != nil {
+		logger.Ctx(ctx).Error().Err(err).Str("connector_id", id.String()).Msg("Failed to restore connector in DB")
+		s.rescheduleSecretDeletion(ctx, deleted.SecretName)
+		if errors.Is(err, domain.ErrConflict) {
+			return nil, s.conflictError(ctx, deleted.WorkspaceID, deleted.TenantID, err)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to restore connector: %w", ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to restore connector: %w", err)
+	}
+
+	restored := *deleted
+	restored.DeletedAt = nil
+	restored.UpdatedAt = time.Now().UTC()
+	return &restored, nil
+}
+
+// rescheduleSecretDeletion puts the secret of a connector that stays deleted
+// back on its deletion schedule, so it does not outlive the row now that the
+// pending delete is cancelled.
+func (s *Service) rescheduleSecretDeletion(ctx context.Context, secretName string) {
+	if err := s.secretsManager.DeleteToken(ctx, secretName); err != nil {
+		logger.Ctx(ctx).Error().Err(err).Str("secret_name", secretName).Msg("Failed to reschedule secret deletion")
+	}
+}
+
+func (s *Service) GetOAuthV2URL(ctx context.Context, redirectURI string) (_ string, err error) {
+	ctx, span := startSpan(ctx, "connector.Service.GetOAuthV2URL")
+	defer func() { endSpan(span, err) }()
+
+	logger.Ctx(ctx).Info().Msg("Generating OAuth V2 state")
 	state, err := s.oauthManager.GenerateState(ctx)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to generate OAuth state")
+		logger.Ctx(ctx).Error().Err(err).Msg("Failed to generate OAuth state")
 		return "", fmt.Errorf("failed to generate state: %w", err)
 	}
 
 	url, err := s.slackClient.GetOAuthV2URL(state)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to get Slack OAuth V2 URL")
+		logger.Ctx(ctx).Error().Err(err).Msg("Failed to get Slack OAuth V2 URL")
 		return "", fmt.Errorf("failed to get Slack OAuth V2 URL: %w", err)
 	}
 
-	logger.Info().Msg("OAuth V2 URL generated successfully")
+	logger.Ctx(ctx).Info().Msg("OAuth V2 URL generated successfully")
 	return url, nil
 }
 
-func (s *Service) ExchangeOAuthCode(ctx context.Context, code string) (string, error) {
-	logger.Info().Msg("Exchanging OAuth code with Slack")
+func (s *Service) ExchangeOAuthCode(ctx context.Context, code string) (_ string, err error) {
+	ctx, span := startSpan(ctx, "connector.Service.ExchangeOAuthCode")
+	defer func() { endSpan(span, err) }()
+
+	logger.Ctx(ctx).Info().Msg("Exchanging OAuth code with Slack")
 	token, err := s.slackClient.ExchangeCode(ctx, code)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to exchange OAuth code with Slack")
+		logger.Ctx(ctx).Error().Err(err).Msg("Failed to exchange OAuth code with Slack")
 		return "", fmt.Errorf("failed to exchange OAuth code: %w", err)
 	}
-	logger.Info().Msg("Slack OAuth code exchanged successfully")
+	logger.Ctx(ctx).Info().Msg("Slack OAuth code exchanged successfully")
 	return token, nil
 }
@@ -0,0 +1,173 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+type CreateTenantInput struct {
+	ID   string
+	Name string
+}
+
+type TenantService struct {
+	tenants domain.TenantRepository
+}
+
+func NewTenantService(tenants domain.TenantRepository) *TenantService {
+	return &TenantService{tenants: tenants}
+}
+
+// EnsureTenantActive returns ErrNotFound when the tenant does not exist and
+// ErrTenantNotActive when it is suspended or being deleted.
+func EnsureTenantActive(ctx context.Context, tenants domain.TenantRepository, tenantID string) error {
+	tenant, err := tenants.GetByID(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("tenant %s: %w", tenantID, ErrNotFound)
+		}
+		return fmt.Errorf("failed to load tenant: %w", err)
+	}
+	if !tenant.IsActive() {
+		return fmt.Errorf("tenant %s is %s: %w", tenantID, tenant.Status, ErrTenantNotActive)
+	}
+	return nil
+}
+
+// activeTenants remembers which tenants are active for the length of a scan
+// over many connectors, such as an export or a rotation run.
+type activeTenants struct {
+	tenants domain.TenantRepository
+	active  map[string]bool
+}
+
+func newActiveTenants(tenants domain.TenantRepository) *activeTenants {
+	return &activeTenants{tenants: tenants, active: make(map[string]bool)}
+}
+
+// check reports whether tenantID is active. Every tenant is active without a
+// tenant repository; only failing to load the tenant is an error.
+func (a *activeTenants) check(ctx context.Context, tenantID string) (bool, error) {
+	if a.tenants == nil {
+		return true, nil
+	}
+	if active, ok := a.active[tenantID]; ok {
+		return active, nil
+	}
+	err := EnsureTenantActive(ctx, a.tenants, tenantID)
+	if err != nil && !errors.Is(err, ErrTenantNotActive) && !errors.Is(err, ErrNotFound) {
+		return false, err
+	}
+	a.active[tenantID] = err == nil
+	return err == nil, nil
+}
+
+func (ts *TenantService) CreateTenant(ctx context.Context, input CreateTenantInput) (*domain.Tenant, error) {
+	if len(input.ID) == 0 || len(input.Name) == 0 {
+		return nil, fmt.Errorf("invalid input: %w", ErrInvalidInput)
+	}
+
+	now := time.Now().UTC()
+	tenant := &domain.Tenant{
+		ID:        input.ID,
+		Name:      input.Name,
+		Status:    domain.TenantStatusActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	logger.Ctx(ctx).Info().Str("tenant_id", tenant.ID).Msg("Creating tenant")
+	if err := ts.tenants.Create(ctx, tenant); err != nil {
+		if errors.Is(err, domain.ErrConflict) {
+			return nil, fmt.Errorf("tenant %s: %w", tenant.ID, ErrAlreadyExists)
+		}
+		logger.Ctx(ctx).Error().Err(err).Str("tenant_id", tenant.ID).Msg("Failed to create tenant")
+		return nil, fmt.Errorf("failed to create tenant: %w", err)
+	}
+	return tenant, nil
+}
+
+func (ts *TenantService) GetTenant(ctx context.Context, id string) (*domain.Tenant, error) {
+	tenant, err := ts.tenants.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get tenant: %w", ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	return tenant, nil
+}
+
+func (ts *TenantService) ListTenants(ctx context.Context, limit int, afterID string) ([]domain.Tenant, string, error) {
+	tenants, next, err := ts.tenants.List(ctx, limit, afterID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list tenants: %w", err)
+	}
+	return tenants, next, nil
+}
+
+// SuspendTenant blocks all connectors of the tenant until it is resumed.
+func (ts *TenantService) SuspendTenant(ctx context.Context, id string) (*domain.Tenant, error) {
+	return ts.transition(ctx, id, domain.TenantStatusActive, domain.TenantStatusSuspended)
+}
+
+func (ts *TenantService) ResumeTenant(ctx context.Context, id string) (*domain.Tenant, error) {
+	return ts.transition(ctx, id, domain.TenantStatusSuspended, domain.TenantStatusActive)
+}
+
+// DeleteTenant marks the tenant for deletion. Its connectors and their secrets
+// are removed asynchronously by TenantDeletionJob, after which the tenant row
+// itself is deleted.
+func (ts *TenantService) DeleteTenant(ctx context.Context, id string) error {
+	tenant, err := ts.GetTenant(ctx, id)
+	if err != nil {
+		return err
+	}
+	if tenant.Status == domain.TenantStatusDeleting {
+		return nil
+	}
+
+	logger.Ctx(ctx).Info().Str("tenant_id", id).Msg("Marking tenant for deletion")
+	if err := ts.tenants.UpdateStatus(ctx, id, domain.TenantStatusDeleting); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to delete tenant: %w", ErrNotFound)
+		}
+		return fmt.Errorf("failed to delete tenant: %w", err)
+	}
+	return nil
+}
+
+func (ts *TenantService) transition(ctx context.Context, id string, from, to domain.TenantStatus) (*domain.Tenant, error) {
+	tenant, err := ts.GetTenant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tenant.Status == to {
+		return tenant, nil
+	}
+	if tenant.Status != from {
+		return nil, fmt.Errorf("tenant %s is %s: %w", id, tenant.Status, ErrTenantNotActive)
+	}
+
+	logger.Ctx(ctx).Info().
+		Str("tenant_id", id).
+		Str("from", string(from)).
+		Str("to", string(to)).
+		Msg("Changing tenant status")
+	if err := ts.tenants.UpdateStatus(ctx, id, to); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to update tenant: %w", ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	tenant.Status = to
+	tenant.UpdatedAt = time.Now().UTC()
+	return tenant, nil
+}
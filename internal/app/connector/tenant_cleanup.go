@@ -0,0 +1,97 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+// TenantDeletionJob removes the connectors and secrets of tenants marked for
+// deletion. Every step is idempotent, so a run interrupted half way is simply
+// picked up again on the next tick. This relies on DeleteToken succeeding for
+// a secret that an earlier run already scheduled for deletion.
+type TenantDeletionJob struct {
+	tenants        domain.TenantRepository
+	repo           domain.ConnectorRepository
+	secretsManager domain.SecretsManager
+	interval       time.Duration
+}
+
+func NewTenantDeletionJob(tenants domain.TenantRepository, repo domain.ConnectorRepository, sm domain.SecretsManager, interval time.Duration) *TenantDeletionJob {
+	return &TenantDeletionJob{
+		tenants:        tenants,
+		repo:           repo,
+		secretsManager: sm,
+		interval:       interval,
+	}
+}
+
+func (j *TenantDeletionJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			j.RunOnce(ctx)
+		case <-ctx.Done():
+			logger.Ctx(ctx).Info().Msg("Tenant deletion job stopped.")
+			return
+		}
+	}
+}
+
+func (j *TenantDeletionJob) RunOnce(ctx context.Context) {
+	const pageSize = 20
+
+	tenants, err := j.tenants.ListByStatus(ctx, domain.TenantStatusDeleting, pageSize)
+	if err != nil {
+		logger.Ctx(ctx).Error().Err(err).Msg("Error fetching tenants pending deletion")
+		return
+	}
+
+	for _, tenant := range tenants {
+		if err := j.purgeTenant(ctx, tenant.ID); err != nil {
+			logger.Ctx(ctx).Warn().
+				Err(err).
+				Str("tenant_id", tenant.ID).
+				Msg("Error deleting tenant, will retry")
+			continue
+		}
+		logger.Ctx(ctx).Info().Str("tenant_id", tenant.ID).Msg("Tenant deleted")
+	}
+}
+
+func (j *TenantDeletionJob) purgeTenant(ctx context.Context, tenantID string) error {
+	const pageSize = 100
+
+	for {
+		connectors, err := j.repo.ListByTenant(ctx, tenantID, pageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list connectors: %w", err)
+		}
+		if len(connectors) == 0 {
+			break
+		}
+
+		for _, connector := range connectors {
+			// Soft-deleted connectors already have their secret scheduled for deletion.
+			if connector.DeletedAt == nil {
+				if err := j.secretsManager.DeleteToken(ctx, connector.SecretName); err != nil {
+					return fmt.Errorf("failed to delete secret for connector %s: %w", connector.ID, err)
+				}
+			}
+			if err := j.repo.Purge(ctx, connector.ID); err != nil {
+				return fmt.Errorf("failed to delete connector %s: %w", connector.ID, err)
+			}
+		}
+	}
+
+	if err := j.tenants.Delete(ctx, tenantID); err != nil {
+		return fmt.Errorf("failed to delete tenant: %w", err)
+	}
+	return nil
+}
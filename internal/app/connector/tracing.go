@@ -0,0 +1,53 @@
+package connector
+
+import (
+	"context"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/requestctx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/connector-recruitment/internal/app/connector")
+
+// Span attributes identify connectors and tenants only; tokens and secret
+// values are never recorded.
+const (
+	attrConnectorID = attribute.Key("connector.id")
+	attrTenantID    = attribute.Key("tenant.id")
+	attrWorkspaceID = attribute.Key("workspace.id")
+)
+
+// startSpan starts a span and also puts the tenant and connector IDs among
+// attrs on the context, so logger.Ctx adds them to log lines.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	for _, attr := range attrs {
+		switch attr.Key {
+		case attrTenantID:
+			ctx = requestctx.WithTenantID(ctx, attr.Value.AsString())
+		case attrConnectorID:
+			ctx = requestctx.WithConnectorID(ctx, attr.Value.AsString())
+		}
+	}
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan marks the span failed when err is set, then ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func connectorAttributes(conn *domain.Connector) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attrConnectorID.String(conn.ID.String()),
+		attrWorkspaceID.String(conn.WorkspaceID),
+		attrTenantID.String(conn.TenantID),
+	}
+}
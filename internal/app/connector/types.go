@@ -1,8 +1,25 @@
 package connector
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
 type CreateInput struct {
 	WorkspaceID    string
 	TenantID       string
 	Token          string
 	DefaultChannel string
+	IdempotencyKey string
+}
+
+// hash fingerprints the fields that define a create request so a reused
+// idempotency key can be told apart from a genuine retry.
+func (in CreateInput) hash() string {
+	h := sha256.New()
+	for _, field := range []string{in.WorkspaceID, in.TenantID, in.Token, in.DefaultChannel} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
 }
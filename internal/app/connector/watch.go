@@ -0,0 +1,343 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+const (
+	watchBatchSize = 500
+	// watchGapTimeout is how long the watcher waits for a missing seq before
+	// moving past it. A gap is an outbox insert that has not committed yet or
+	// one that rolled back; outbox transactions are short, so a gap still
+	// open after this long is taken to be a rollback.
+	watchGapTimeout = 5 * time.Second
+
+	defaultWatchHeartbeat  = 15 * time.Second
+	defaultWatchBufferSize = 256
+)
+
+// WatchUpdate is one message of a watch stream: a connector event, or a
+// heartbeat when Event is nil. ResumeToken resumes the stream after it.
+type WatchUpdate struct {
+	Event       *domain.CloudEvent
+	ResumeToken string
+}
+
+type WatcherOption func(*ConnectorWatcher)
+
+// WithWatchNotifications wakes the watcher as soon as events are committed
+// instead of waiting for the next poll.
+func WithWatchNotifications(notifications <-chan struct{}) WatcherOption {
+	return func(w *ConnectorWatcher) {
+		w.notifications = notifications
+	}
+}
+
+// WithWatchHeartbeat sets how long a stream may be idle before a heartbeat.
+func WithWatchHeartbeat(interval time.Duration) WatcherOption {
+	return func(w *ConnectorWatcher) {
+		w.heartbeat = interval
+	}
+}
+
+// WithWatchBufferSize sets how many events may queue for a stream before it
+// is closed as too slow.
+func WithWatchBufferSize(size int) WatcherOption {
+	return func(w *ConnectorWatcher) {
+		w.bufferSize = size
+	}
+}
+
+// WithWatchGapTimeout overrides watchGapTimeout.
+func WithWatchGapTimeout(timeout time.Duration) WatcherOption {
+	return func(w *ConnectorWatcher) {
+		w.gapTimeout = timeout
+	}
+}
+
+// ConnectorWatcher streams connector events from the outbox to watchers. Each
+// replica runs one, reading the shared outbox in seq order and fanning events
+// out to the streams of the matching tenant, so any replica can serve any
+// watcher and resume tokens are valid across replicas.
+type ConnectorWatcher struct {
+	log           domain.EventLog
+	pollInterval  time.Duration
+	notifications <-chan struct{}
+	heartbeat     time.Duration
+	bufferSize    int
+	gapTimeout    time.Duration
+
+	mu       sync.Mutex
+	ready    bool
+	cursor   int64
+	gapSince time.Time
+	subs     map[*watchSubscription]struct{}
+}
+
+type watchSubscription struct {
+	tenantID string
+	events   chan domain.OutboxEntry
+	done     chan struct{}
+	// err says why the subscription was dropped; set before done is closed.
+	err error
+}
+
+func NewConnectorWatcher(log domain.EventLog, pollInterval time.Duration, opts ...WatcherOption) *ConnectorWatcher {
+	w := &ConnectorWatcher{
+		log:          log,
+		pollInterval: pollInterval,
+		heartbeat:    defaultWatchHeartbeat,
+		bufferSize:   defaultWatchBufferSize,
+		gapTimeout:   watchGapTimeout,
+		subs:         make(map[*watchSubscription]struct{}),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Start reads new events on every notification and poll until ctx is done,
+// then ends all open streams.
+func (w *ConnectorWatcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	w.RunOnce(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			w.RunOnce(ctx)
+		case <-w.notifications:
+			w.RunOnce(ctx)
+		case <-ctx.Done():
+			w.shutdown()
+			logger.Ctx(ctx).Info().Msg("Connector watcher stopped.")
+			return
+		}
+	}
+}
+
+// RunOnce hands the events written since the last run to the open streams.
+// The first run only records where the outbox ends.
+func (w *ConnectorWatcher) RunOnce(ctx context.Context) {
+	w.mu.Lock()
+	ready, cursor := w.ready, w.cursor
+	w.mu.Unlock()
+
+	if !ready {
+		latest, err := w.log.LatestSeq(ctx)
+		if err != nil {
+			logger.Ctx(ctx).Error().Err(err).Msg("Failed to read the end of the event log")
+			return
+		}
+		w.mu.Lock()
+		w.cursor, w.ready = latest, true
+		w.mu.Unlock()
+		return
+	}
+
+	for {
+		entries, err := w.log.ListAfter(ctx, cursor, watchBatchSize)
+		if err != nil {
+			logger.Ctx(ctx).Error().Err(err).Msg("Failed to read the event log")
+			return
+		}
+		var consumed int
+		consumed, cursor = w.dispatch(entries)
+		if consumed < len(entries) || len(entries) < watchBatchSize {
+			return
+		}
+	}
+}
+
+// dispatch hands entries to the streams in seq order and returns how many it
+// consumed along with the new cursor. It stops at a gap in seq until the gap
+// has been open for gapTimeout, so an insert that commits late is not
+// overtaken by later ones.
+func (w *ConnectorWatcher) dispatch(entries []domain.OutboxEntry) (int, int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	for i := range entries {
+		entry := entries[i]
+		if entry.Seq != w.cursor+1 {
+			if w.gapSince.IsZero() {
+				w.gapSince = now
+			}
+			if now.Sub(w.gapSince) < w.gapTimeout {
+				return i, w.cursor
+			}
+		}
+		w.gapSince = time.Time{}
+		w.cursor = entry.Seq
+
+		for sub := range w.subs {
+			if sub.tenantID != entry.Event.TenantID {
+				continue
+			}
+			select {
+			case sub.events <- entry:
+			default:
+				w.drop(sub, ErrWatchLagging)
+			}
+		}
+	}
+	return len(entries), w.cursor
+}
+
+// Watch sends the tenant's connector events to send until ctx is done, send
+// fails or the stream is dropped. With a resume token it first replays the
+// events after it; without one it starts with the next event. Idle streams get
+// a heartbeat whose token moves past other tenants' events, so it stays
+// resumable after the outbox is pruned.
+func (w *ConnectorWatcher) Watch(ctx context.Context, tenantID, resumeToken string, send func(WatchUpdate) error) error {
+	after, err := parseResumeToken(resumeToken)
+	if err != nil {
+		return err
+	}
+
+	sub, from, err := w.subscribe(tenantID)
+	if err != nil {
+		return err
+	}
+	defer w.unsubscribe(sub)
+
+	delivered := from
+	if resumeToken != "" {
+		if delivered, err = w.replay(ctx, tenantID, after, from, send); err != nil {
+			return err
+		}
+	}
+
+	heartbeat := time.NewTicker(w.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case entry := <-sub.events:
+			if entry.Seq <= delivered {
+				continue
+			}
+			event := entry.Event
+			if err := send(WatchUpdate{Event: &event, ResumeToken: formatResumeToken(entry.Seq)}); err != nil {
+				return err
+			}
+			delivered = entry.Seq
+			heartbeat.Reset(w.heartbeat)
+		case <-heartbeat.C:
+			if cursor := w.idleCursor(sub); cursor > delivered {
+				delivered = cursor
+			}
+			if err := send(WatchUpdate{ResumeToken: formatResumeToken(delivered)}); err != nil {
+				return err
+			}
+		case <-sub.done:
+			return sub.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// replay sends the tenant's events after the resume point up to from, where
+// the live stream takes over, and returns the seq it got to.
+func (w *ConnectorWatcher) replay(ctx context.Context, tenantID string, after, from int64, send func(WatchUpdate) error) (int64, error) {
+	oldest, err := w.log.OldestSeq(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the start of the event log: %w", err)
+	}
+	if oldest > after+1 {
+		return 0, ErrResumeTokenExpired
+	}
+
+	delivered := after
+	for delivered < from {
+		entries, err := w.log.ListTenantAfter(ctx, tenantID, delivered, from, watchBatchSize)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read the event log: %w", err)
+		}
+		for i := range entries {
+			event := entries[i].Event
+			if err := send(WatchUpdate{Event: &event, ResumeToken: formatResumeToken(entries[i].Seq)}); err != nil {
+				return 0, err
+			}
+			delivered = entries[i].Seq
+		}
+		if len(entries) < watchBatchSize {
+			delivered = from
+		}
+	}
+	return delivered, nil
+}
+
+func (w *ConnectorWatcher) subscribe(tenantID string) (*watchSubscription, int64, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.ready {
+		return nil, 0, ErrWatchUnavailable
+	}
+	sub := &watchSubscription{
+		tenantID: tenantID,
+		events:   make(chan domain.OutboxEntry, w.bufferSize),
+		done:     make(chan struct{}),
+	}
+	w.subs[sub] = struct{}{}
+	return sub, w.cursor, nil
+}
+
+func (w *ConnectorWatcher) unsubscribe(sub *watchSubscription) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.subs, sub)
+}
+
+// idleCursor returns the watcher's cursor when nothing is queued for sub, or
+// 0 when the queue still has to be drained.
+func (w *ConnectorWatcher) idleCursor(sub *watchSubscription) int64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(sub.events) > 0 {
+		return 0
+	}
+	return w.cursor
+}
+
+// drop must be called with mu held.
+func (w *ConnectorWatcher) drop(sub *watchSubscription, err error) {
+	delete(w.subs, sub)
+	sub.err = err
+	close(sub.done)
+}
+
+func (w *ConnectorWatcher) shutdown() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.ready = false
+	for sub := range w.subs {
+		w.drop(sub, ErrWatchUnavailable)
+	}
+}
+
+func parseResumeToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	seq, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, fmt.Errorf("%w: malformed resume token", ErrInvalidInput)
+	}
+	return seq, nil
+}
+
+func formatResumeToken(seq int64) string {
+	return strconv.FormatInt(seq, 10)
+}
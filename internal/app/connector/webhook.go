@@ -0,0 +1,216 @@
+package connector
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/webhook"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAttemptListLimit = 50
+	maxAttemptListLimit     = 200
+	minWebhookSecretLength  = 16
+)
+
+type CreateWebhookInput struct {
+	TenantID   string
+	URL        string
+	EventTypes []string
+	// Secret signs deliveries. One is generated when it is empty.
+	Secret string
+}
+
+type WebhookService struct {
+	subscriptions     domain.WebhookSubscriptionRepository
+	deliveries        domain.WebhookDeliveryRepository
+	tenants           domain.TenantRepository
+	allowInsecureURLs bool
+}
+
+// NewWebhookService manages webhook subscriptions. allowInsecureURLs permits
+// plain http endpoints and private addresses, which is only meant for local
+// development.
+func NewWebhookService(subscriptions domain.WebhookSubscriptionRepository, deliveries domain.WebhookDeliveryRepository,
+	tenants domain.TenantRepository, allowInsecureURLs bool) *WebhookService {
+	return &WebhookService{
+		subscriptions:     subscriptions,
+		deliveries:        deliveries,
+		tenants:           tenants,
+		allowInsecureURLs: allowInsecureURLs,
+	}
+}
+
+func (ws *WebhookService) CreateSubscription(ctx context.Context, input CreateWebhookInput) (*domain.WebhookSubscription, error) {
+	if len(input.TenantID) == 0 {
+		return nil, fmt.Errorf("tenant id is required: %w", ErrInvalidInput)
+	}
+	if err := ws.validateURL(input.URL); err != nil {
+		return nil, err
+	}
+	eventTypes, err := validateEventTypes(input.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := input.Secret
+	if secret == "" {
+		if secret, err = generateWebhookSecret(); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+	} else if len(secret) < minWebhookSecretLength {
+		return nil, fmt.Errorf("secret must be at least %d characters: %w", minWebhookSecretLength, ErrInvalidInput)
+	}
+
+	if err := EnsureTenantActive(ctx, ws.tenants, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	sub := &domain.WebhookSubscription{
+		ID:         uuid.New(),
+		TenantID:   input.TenantID,
+		URL:        input.URL,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		Status:     domain.WebhookStatusActive,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	logger.Ctx(ctx).Info().Str("tenant_id", sub.TenantID).Str("subscription_id", sub.ID.String()).Msg("Creating webhook subscription")
+	if err := ws.subscriptions.Create(ctx, sub); err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	return sub, nil
+}
+
+func (ws *WebhookService) GetSubscription(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	sub, err := ws.subscriptions.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("webhook subscription %s: %w", id, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+	return sub, nil
+}
+
+func (ws *WebhookService) ListSubscriptions(ctx context.Context, tenantID string) ([]domain.WebhookSubscription, error) {
+	if len(tenantID) == 0 {
+		return nil, fmt.Errorf("tenant id is required: %w", ErrInvalidInput)
+	}
+	subs, err := ws.subscriptions.ListByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+// DeleteSubscription removes the subscription together with its pending
+// deliveries and attempt history.
+func (ws *WebhookService) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	logger.Ctx(ctx).Info().Str("subscription_id", id.String()).Msg("Deleting webhook subscription")
+	if err := ws.subscriptions.Delete(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("webhook subscription %s: %w", id, ErrNotFound)
+		}
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// EnableSubscription reactivates a subscription and clears its failure count.
+// Deliveries still pending from before it was disabled are sent again.
+func (ws *WebhookService) EnableSubscription(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	if err := ws.subscriptions.Enable(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("webhook subscription %s: %w", id, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to enable webhook subscription: %w", err)
+	}
+	return ws.GetSubscription(ctx, id)
+}
+
+// ListDeliveryAttempts returns the most recent attempts for a subscription,
+// newest first.
+func (ws *WebhookService) ListDeliveryAttempts(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]domain.WebhookDeliveryAttempt, error) {
+	if limit <= 0 {
+		limit = defaultAttemptListLimit
+	}
+	if limit > maxAttemptListLimit {
+		limit = maxAttemptListLimit
+	}
+	if _, err := ws.GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	attempts, err := ws.deliveries.ListAttempts(ctx, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery attempts: %w", err)
+	}
+	return attempts, nil
+}
+
+func (ws *WebhookService) validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %q: %w", raw, ErrInvalidInput)
+	}
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !ws.allowInsecureURLs {
+			return fmt.Errorf("webhook url must use https: %w", ErrInvalidInput)
+		}
+	default:
+		return fmt.Errorf("unsupported webhook url scheme %q: %w", u.Scheme, ErrInvalidInput)
+	}
+	if u.User != nil {
+		return fmt.Errorf("webhook url must not contain credentials: %w", ErrInvalidInput)
+	}
+	// The sender refuses private addresses when it connects, which also
+	// covers hostnames; this only reports the obvious cases early.
+	if !ws.allowInsecureURLs && !webhook.PublicHost(u.Hostname()) {
+		return fmt.Errorf("webhook url must not point to a private address: %w", ErrInvalidInput)
+	}
+	return nil
+}
+
+func validateEventTypes(eventTypes []string) ([]string, error) {
+	known := make(map[string]bool)
+	for _, t := range domain.ConnectorEventTypes() {
+		known[string(t)] = true
+	}
+
+	seen := make(map[string]bool, len(eventTypes))
+	result := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		if !known[t] {
+			return nil, fmt.Errorf("unknown event type %q: %w", t, ErrInvalidInput)
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		result = append(result, t)
+	}
+	return result, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
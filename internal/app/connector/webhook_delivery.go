@@ -0,0 +1,298 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/webhook"
+	"github.com/google/uuid"
+)
+
+const (
+	webhookBatchSize = 50
+	// webhookLease must outlast a delivery attempt so that another worker does
+	// not send the same delivery while the first is still waiting on it.
+	webhookLease = 2 * time.Minute
+	// maxAttemptErrorLength keeps transport errors from bloating the history.
+	maxAttemptErrorLength = 500
+)
+
+// WebhookDispatcher is an EventPublisher that queues a delivery for every
+// active subscription of the event's tenant that wants the event type.
+type WebhookDispatcher struct {
+	subscriptions domain.WebhookSubscriptionRepository
+	deliveries    domain.WebhookDeliveryRepository
+}
+
+func NewWebhookDispatcher(subscriptions domain.WebhookSubscriptionRepository, deliveries domain.WebhookDeliveryRepository) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+	}
+}
+
+func (d *WebhookDispatcher) Publish(ctx context.Context, event *domain.CloudEvent) error {
+	if event.TenantID == "" {
+		return nil
+	}
+
+	subs, err := d.subscriptions.ListActiveByTenant(ctx, event.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	var payload []byte
+	now := time.Now().UTC()
+	deliveries := make([]domain.WebhookDelivery, 0, len(subs))
+	for i := range subs {
+		if !subs[i].Matches(event.Type) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				return fmt.Errorf("failed to encode event %s: %w", event.ID, err)
+			}
+		}
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subs[i].ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+	}
+
+	if err := d.deliveries.Enqueue(ctx, deliveries); err != nil {
+		return fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// FanoutPublisher hands every event to each publisher in turn. The relay
+// retries the whole event when any of them fails, so each publisher must
+// tolerate seeing an event more than once.
+type FanoutPublisher struct {
+	publishers []domain.EventPublisher
+}
+
+// NewFanoutPublisher skips nil publishers and returns nil when none are left.
+func NewFanoutPublisher(publishers ...domain.EventPublisher) domain.EventPublisher {
+	var active []domain.EventPublisher
+	for _, p := range publishers {
+		if p != nil {
+			active = append(active, p)
+		}
+	}
+	switch len(active) {
+	case 0:
+		return nil
+	case 1:
+		return active[0]
+	default:
+		return &FanoutPublisher{publishers: active}
+	}
+}
+
+func (f *FanoutPublisher) Publish(ctx context.Context, event *domain.CloudEvent) error {
+	for _, p := range f.publishers {
+		if err := p.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type WebhookDeliveryOption func(*WebhookDeliveryWorker)
+
+// WithWebhookRetryPolicy sets how many attempts a delivery gets and the
+// exponential backoff between them.
+func WithWebhookRetryPolicy(maxAttempts int, baseBackoff, maxBackoff time.Duration) WebhookDeliveryOption {
+	return func(w *WebhookDeliveryWorker) {
+		w.maxAttempts = maxAttempts
+		w.baseBackoff = baseBackoff
+		w.maxBackoff = maxBackoff
+	}
+}
+
+// WithWebhookDisableAfter disables a subscription after this many failed
+// attempts in a row, across all of its deliveries.
+func WithWebhookDisableAfter(failures int) WebhookDeliveryOption {
+	return func(w *WebhookDeliveryWorker) {
+		w.disableAfter = failures
+	}
+}
+
+// WebhookDeliveryWorker sends queued deliveries, retrying failures with
+// exponential backoff until they succeed or run out of attempts.
+type WebhookDeliveryWorker struct {
+	subscriptions domain.WebhookSubscriptionRepository
+	deliveries    domain.WebhookDeliveryRepository
+	sender        domain.WebhookSender
+	interval      time.Duration
+	maxAttempts   int
+	baseBackoff   time.Duration
+	maxBackoff    time.Duration
+	disableAfter  int
+}
+
+func NewWebhookDeliveryWorker(subscriptions domain.WebhookSubscriptionRepository, deliveries domain.WebhookDeliveryRepository,
+	sender domain.WebhookSender, interval time.Duration, opts ...WebhookDeliveryOption) *WebhookDeliveryWorker {
+	w := &WebhookDeliveryWorker{
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+		sender:        sender,
+		interval:      interval,
+		maxAttempts:   8,
+		baseBackoff:   30 * time.Second,
+		maxBackoff:    6 * time.Hour,
+		disableAfter:  20,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+func (w *WebhookDeliveryWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.RunOnce(ctx)
+		case <-ctx.Done():
+			logger.Ctx(ctx).Info().Msg("Webhook delivery worker stopped.")
+			return
+		}
+	}
+}
+
+func (w *WebhookDeliveryWorker) RunOnce(ctx context.Context) {
+	for {
+		deliveries, err := w.deliveries.ClaimDue(ctx, webhookBatchSize, webhookLease)
+		if err != nil {
+			logger.Ctx(ctx).Error().Err(err).Msg("Failed to claim webhook deliveries")
+			return
+		}
+
+		for i := range deliveries {
+			if ctx.Err() != nil {
+				return
+			}
+			w.deliver(ctx, &deliveries[i])
+		}
+
+		if len(deliveries) < webhookBatchSize {
+			return
+		}
+	}
+}
+
+func (w *WebhookDeliveryWorker) deliver(ctx context.Context, delivery *domain.WebhookDelivery) {
+	sub, err := w.subscriptions.GetByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			logger.Ctx(ctx).Error().Err(err).Str("delivery_id", delivery.ID.String()).Msg("Failed to load webhook subscription")
+		}
+		return
+	}
+	if sub.Status != domain.WebhookStatusActive {
+		return
+	}
+
+	start := time.Now()
+	headers := webhook.Headers(sub.Secret, delivery.ID.String(), delivery.EventType, start, delivery.Payload)
+	statusCode, sendErr := w.sender.Send(ctx, sub.URL, headers, delivery.Payload)
+
+	attempt := &domain.WebhookDeliveryAttempt{
+		ID:             uuid.New(),
+		DeliveryID:     delivery.ID,
+		SubscriptionID: sub.ID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		AttemptNumber:  delivery.Attempts + 1,
+		StatusCode:     statusCode,
+		Duration:       time.Since(start),
+		AttemptedAt:    start.UTC(),
+	}
+	if sendErr != nil {
+		attempt.Error = truncate(sendErr.Error(), maxAttemptErrorLength)
+	}
+
+	delivery.Attempts = attempt.AttemptNumber
+	switch {
+	case attempt.Succeeded():
+		delivery.Status = domain.WebhookDeliverySucceeded
+	case delivery.Attempts >= w.maxAttempts:
+		delivery.Status = domain.WebhookDeliveryFailed
+	default:
+		delivery.NextAttemptAt = time.Now().UTC().Add(w.backoff(delivery.Attempts))
+	}
+
+	log := logger.Ctx(ctx).Info()
+	if !attempt.Succeeded() {
+		log = logger.Ctx(ctx).Warn()
+	}
+	log.Str("delivery_id", delivery.ID.String()).
+		Str("subscription_id", sub.ID.String()).
+		Str("event_type", delivery.EventType).
+		Int("attempt", attempt.AttemptNumber).
+		Int("status_code", statusCode).
+		Str("error", attempt.Error).
+		Msg("Webhook delivery attempted")
+
+	if err := w.deliveries.RecordAttempt(ctx, delivery, attempt); err != nil {
+		// The lease runs out and the delivery is sent again.
+		logger.Ctx(ctx).Error().Err(err).Str("delivery_id", delivery.ID.String()).Msg("Failed to record webhook delivery attempt")
+		return
+	}
+
+	status, err := w.subscriptions.RecordDeliveryResult(ctx, sub.ID, attempt.Succeeded(), w.disableAfter)
+	if err != nil {
+		logger.Ctx(ctx).Error().Err(err).Str("subscription_id", sub.ID.String()).Msg("Failed to record webhook delivery result")
+		return
+	}
+	if status == domain.WebhookStatusDisabled {
+		logger.Ctx(ctx).Warn().
+			Str("subscription_id", sub.ID.String()).
+			Str("tenant_id", sub.TenantID).
+			Int("disable_after", w.disableAfter).
+			Msg("Webhook subscription disabled after repeated delivery failures")
+	}
+}
+
+// backoff doubles the wait for each failed attempt up to maxBackoff and
+// spreads retries over the upper half of that window.
+func (w *WebhookDeliveryWorker) backoff(attempts int) time.Duration {
+	wait := w.baseBackoff
+	for i := 1; i < attempts && wait < w.maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > w.maxBackoff {
+		wait = w.maxBackoff
+	}
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
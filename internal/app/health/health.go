@@ -0,0 +1,242 @@
+// Package health probes the service's dependencies in the background and
+// reports liveness and readiness from the latest results.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+// Dependency check names.
+const (
+	CheckPostgres       = "postgres"
+	CheckRedis          = "redis"
+	CheckSecretsManager = "secretsmanager"
+	CheckSlack          = "slack"
+)
+
+// Readiness states reported in Report.Status.
+const (
+	StatusReady    = "ready"
+	StatusNotReady = "not_ready"
+	StatusDraining = "draining"
+)
+
+// Check states reported in CheckResult.Status. A check is unknown until its
+// first probe completes.
+const (
+	CheckUp      = "up"
+	CheckDown    = "down"
+	CheckUnknown = "unknown"
+)
+
+// Pinger is implemented by clients that can cheaply test their connection.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Check probes one dependency. Critical checks gate readiness; a failing
+// non-critical check is reported but the service stays ready.
+type Check struct {
+	Name     string
+	Critical bool
+	Probe    func(ctx context.Context) error
+}
+
+// PingCheck builds a Check from a Pinger.
+func PingCheck(name string, critical bool, pinger Pinger) Check {
+	return Check{Name: name, Critical: critical, Probe: pinger.Ping}
+}
+
+type CheckResult struct {
+	Status    string    `json:"status"`
+	Critical  bool      `json:"critical"`
+	Error     string    `json:"error,omitempty"`
+	LatencyMS int64     `json:"latency_ms"`
+	CheckedAt time.Time `json:"checked_at"`
+	// Since is when the check last changed status.
+	Since time.Time `json:"since"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Ready reports whether the service should receive traffic.
+func (r Report) Ready() bool {
+	return r.Status == StatusReady
+}
+
+// Up reports whether every named check passed its last probe.
+func (r Report) Up(names ...string) bool {
+	for _, name := range names {
+		if r.Checks[name].Status != CheckUp {
+			return false
+		}
+	}
+	return true
+}
+
+type MonitorOption func(*Monitor)
+
+// WithTimeout bounds each probe. The default is 3 seconds.
+func WithTimeout(timeout time.Duration) MonitorOption {
+	return func(m *Monitor) {
+		m.timeout = timeout
+	}
+}
+
+// Monitor runs the checks every interval and keeps the latest results.
+type Monitor struct {
+	checks   []Check
+	interval time.Duration
+	timeout  time.Duration
+
+	mu        sync.Mutex
+	results   map[string]CheckResult
+	draining  bool
+	listeners []func(Report)
+}
+
+func NewMonitor(checks []Check, interval time.Duration, opts ...MonitorOption) *Monitor {
+	m := &Monitor{
+		checks:   checks,
+		interval: interval,
+		timeout:  3 * time.Second,
+		results:  make(map[string]CheckResult, len(checks)),
+	}
+	for _, check := range checks {
+		m.results[check.Name] = CheckResult{Status: CheckUnknown, Critical: check.Critical}
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Start probes immediately, then every interval until ctx is done.
+func (m *Monitor) Start(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	m.RunOnce(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			m.RunOnce(ctx)
+		case <-ctx.Done():
+			logger.Ctx(ctx).Info().Msg("Health monitor stopped.")
+			return
+		}
+	}
+}
+
+// RunOnce probes every dependency concurrently and notifies subscribers.
+func (m *Monitor) RunOnce(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, check := range m.checks {
+		wg.Add(1)
+		go func(check Check) {
+			defer wg.Done()
+			m.record(ctx, check, m.probe(ctx, check))
+		}(check)
+	}
+	wg.Wait()
+	m.notify()
+}
+
+func (m *Monitor) probe(ctx context.Context, check Check) CheckResult {
+	probeCtx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Probe(probeCtx)
+	result := CheckResult{
+		Status:    CheckUp,
+		Critical:  check.Critical,
+		LatencyMS: time.Since(start).Milliseconds(),
+		CheckedAt: start.UTC(),
+	}
+	if err != nil {
+		result.Status = CheckDown
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func (m *Monitor) record(ctx context.Context, check Check, result CheckResult) {
+	m.mu.Lock()
+	previous := m.results[check.Name]
+	result.Since = previous.Since
+	if result.Status != previous.Status {
+		result.Since = result.CheckedAt
+	}
+	m.results[check.Name] = result
+	m.mu.Unlock()
+
+	switch {
+	case result.Status == previous.Status:
+	case result.Status == CheckDown:
+		logger.Ctx(ctx).Warn().
+			Str("check", check.Name).
+			Bool("critical", check.Critical).
+			Str("error", result.Error).
+			Msg("Dependency health check failing")
+	case previous.Status == CheckDown:
+		logger.Ctx(ctx).Info().Str("check", check.Name).Msg("Dependency health check recovered")
+	}
+}
+
+// Report returns the latest results.
+func (m *Monitor) Report() Report {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.reportLocked()
+}
+
+func (m *Monitor) reportLocked() Report {
+	report := Report{Status: StatusReady, Checks: make(map[string]CheckResult, len(m.results))}
+	for name, result := range m.results {
+		report.Checks[name] = result
+		if result.Critical && result.Status != CheckUp {
+			report.Status = StatusNotReady
+		}
+	}
+	if m.draining {
+		report.Status = StatusDraining
+	}
+	return report
+}
+
+// Subscribe calls fn with the current report and again after every probe
+// round and on Drain.
+func (m *Monitor) Subscribe(fn func(Report)) {
+	m.mu.Lock()
+	m.listeners = append(m.listeners, fn)
+	report := m.reportLocked()
+	m.mu.Unlock()
+	fn(report)
+}
+
+// Drain marks the service not ready for good, so load balancers stop sending
+// traffic while in-flight requests finish during shutdown.
+func (m *Monitor) Drain() {
+	m.mu.Lock()
+	m.draining = true
+	m.mu.Unlock()
+	m.notify()
+}
+
+func (m *Monitor) notify() {
+	m.mu.Lock()
+	report := m.reportLocked()
+	listeners := append([]func(Report){}, m.listeners...)
+	m.mu.Unlock()
+	for _, fn := range listeners {
+		fn(report)
+	}
+}
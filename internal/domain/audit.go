@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionConnectorCreate  AuditAction = "connector.create"
+	AuditActionConnectorRead    AuditAction = "connector.read"
+	AuditActionConnectorDelete  AuditAction = "connector.delete"
+	AuditActionConnectorRestore AuditAction = "connector.restore"
+	// AuditActionConnectorExport records a connector's secret leaving the
+	// service in a backup.
+	AuditActionConnectorExport AuditAction = "connector.export"
+	// AuditActionConnectorRecover records a connector recreated from a backup.
+	AuditActionConnectorRecover AuditAction = "connector.recover"
+	AuditActionSecretRotate     AuditAction = "secret.rotate"
+	// AuditActionSecretRead records a connector's token read outside a
+	// ConnectorService call, such as by the CLI to send a message.
+	AuditActionSecretRead AuditAction = "secret.read"
+	// AuditActionCircuitBreakerSetMode records an operator overriding a
+	// circuit breaker through AdminService.
+	AuditActionCircuitBreakerSetMode AuditAction = "circuit_breaker.set_mode"
+)
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditEvent is one entry of the append-only audit log. Every event carries
+// the hash of the event before it, so editing or removing an entry breaks the
+// chain from that point on.
+type AuditEvent struct {
+	ID         int64
+	OccurredAt time.Time
+	Actor      string
+	TenantID   string
+	Action     AuditAction
+	TargetType string
+	TargetID   string
+	Outcome    AuditOutcome
+	RequestID  string
+	TraceID    string
+	Diff       json.RawMessage
+	PrevHash   string
+	Hash       string
+}
+
+// ComputeHash returns the chain hash of the event. OccurredAt is hashed at
+// microsecond precision, which is what Postgres stores.
+func (e *AuditEvent) ComputeHash() string {
+	h := sha256.New()
+	for _, field := range []string{
+		e.PrevHash,
+		strconv.FormatInt(e.ID, 10),
+		e.OccurredAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		e.Actor,
+		e.TenantID,
+		string(e.Action),
+		e.TargetType,
+		e.TargetID,
+		string(e.Outcome),
+		e.RequestID,
+		e.TraceID,
+		string(e.Diff),
+	} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AuditFilter narrows ListAuditEvents; zero fields match everything.
+type AuditFilter struct {
+	TenantID string
+	Actor    string
+	Action   AuditAction
+	TargetID string
+	Since    time.Time
+	Until    time.Time
+}
+
+// AuditChainError reports the first event at which the hash chain breaks.
+type AuditChainError struct {
+	EventID int64
+	Reason  string
+}
+
+func (e *AuditChainError) Error() string {
+	return fmt.Sprintf("audit chain broken at event %d: %s", e.EventID, e.Reason)
+}
+
+// VerifyAuditChain checks that events, ordered by ID, continue the chain whose
+// last event had ID prevID and hash prevHash.
+func VerifyAuditChain(events []AuditEvent, prevID int64, prevHash string) error {
+	for i := range events {
+		e := &events[i]
+		switch {
+		case e.ID != prevID+1:
+			return &AuditChainError{EventID: e.ID, Reason: fmt.Sprintf("expected event %d", prevID+1)}
+		case e.PrevHash != prevHash:
+			return &AuditChainError{EventID: e.ID, Reason: "previous hash does not match"}
+		case e.Hash != e.ComputeHash():
+			return &AuditChainError{EventID: e.ID, Reason: "event hash does not match its contents"}
+		}
+		prevID, prevHash = e.ID, e.Hash
+	}
+	return nil
+}
@@ -6,14 +6,24 @@ import (
 	"github.com/google/uuid"
 )
 
+type ConnectorStatus string
+
+const (
+	ConnectorStatusActive  ConnectorStatus = "active"
+	ConnectorStatusErrored ConnectorStatus = "errored"
+)
+
 type Connector struct {
-	ID               uuid.UUID `db:"id"`
-	WorkspaceID      string    `db:"workspace_id"`
-	TenantID         string    `db:"tenant_id"`
-	DefaultChannelID string    `db:"default_channel_id"`
-	CreatedAt        time.Time `db:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at"`
-	SecretVersion    string    `db:"secret_version"`
+	ID               uuid.UUID       `db:"id"`
+	WorkspaceID      string          `db:"workspace_id"`
+	TenantID         string          `db:"tenant_id"`
+	DefaultChannelID string          `db:"default_channel_id"`
+	CreatedAt        time.Time       `db:"created_at"`
+	UpdatedAt        time.Time       `db:"updated_at"`
+	SecretVersion    string          `db:"secret_version"`
+	SecretName       string          `db:"secret_name"`
+	Status           ConnectorStatus `db:"status"`
+	DeletedAt        *time.Time      `db:"deleted_at"`
 }
 
 func ParseUUID(id string) (uuid.UUID, error) {
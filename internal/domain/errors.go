@@ -0,0 +1,15 @@
+package domain
+
+import "errors"
+
+// ErrConflict is returned by repositories when a write violates a uniqueness
+// constraint.
+var ErrConflict = errors.New("conflicting record exists")
+
+// Errors a SlackClient reports for Slack API failures callers may want to
+// handle, without depending on the Slack SDK.
+var (
+	ErrSlackRateLimited     = errors.New("slack rate limit exceeded")
+	ErrSlackInvalidAuth     = errors.New("slack token is invalid or revoked")
+	ErrSlackChannelNotFound = errors.New("slack channel not found")
+)
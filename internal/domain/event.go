@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CloudEventsSpecVersion is the CloudEvents version the envelopes follow.
+const CloudEventsSpecVersion = "1.0"
+
+type ConnectorEventType string
+
+const (
+	ConnectorEventCreated  ConnectorEventType = "com.connector-recruitment.connector.created"
+	ConnectorEventUpdated  ConnectorEventType = "com.connector-recruitment.connector.updated"
+	ConnectorEventDisabled ConnectorEventType = "com.connector-recruitment.connector.disabled"
+	ConnectorEventRotated  ConnectorEventType = "com.connector-recruitment.connector.rotated"
+	ConnectorEventDeleted  ConnectorEventType = "com.connector-recruitment.connector.deleted"
+	ConnectorEventRestored ConnectorEventType = "com.connector-recruitment.connector.restored"
+)
+
+// CloudEvent is a structured-mode CloudEvents envelope. ID is unique per event
+// and stays the same across redeliveries, so consumers dedupe on it.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	TenantID        string          `json:"tenantid,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// ConnectorEventData is the payload of connector lifecycle events. It leaves
+// out the secret version, which can hold token material.
+type ConnectorEventData struct {
+	ID               string          `json:"id"`
+	WorkspaceID      string          `json:"workspace_id"`
+	TenantID         string          `json:"tenant_id"`
+	DefaultChannelID string          `json:"default_channel_id"`
+	Status           ConnectorStatus `json:"status"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	DeletedAt        *time.Time      `json:"deleted_at,omitempty"`
+}
+
+// OutboxEntry is a CloudEvent waiting in the outbox to be published. Seq
+// orders entries in the order they were written.
+type OutboxEntry struct {
+	Seq       int64
+	Event     CloudEvent
+	Attempts  int
+	CreatedAt time.Time
+}
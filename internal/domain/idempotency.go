@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyRecord remembers which connector a client-supplied idempotency
+// key produced, together with a hash of the request that used it.
+type IdempotencyRecord struct {
+	Key         string    `db:"key"`
+	RequestHash string    `db:"request_hash"`
+	ConnectorID uuid.UUID `db:"connector_id"`
+	CreatedAt   time.Time `db:"created_at"`
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OperationKind string
+
+const (
+	OperationKindCreate OperationKind = "create"
+	OperationKindDelete OperationKind = "delete"
+)
+
+// OperationState records how far a multi-step connector operation got. Creates
+// move started -> secret_stored -> completed, deletes move started ->
+// row_deleted -> completed. A create that had to be undone ends in compensated,
+// passing through compensating while its secret is still being removed. A
+// delete overtaken by a restore of its connector ends in cancelled.
+type OperationState string
+
+const (
+	OperationStateStarted      OperationState = "started"
+	OperationStateSecretStored OperationState = "secret_stored"
+	OperationStateRowDeleted   OperationState = "row_deleted"
+	OperationStateCompensating OperationState = "compensating"
+	OperationStateCompleted    OperationState = "completed"
+	OperationStateCompensated  OperationState = "compensated"
+	OperationStateCancelled    OperationState = "cancelled"
+)
+
+// ConnectorOperation is a persisted record of a create or delete that spans
+// Postgres and Secrets Manager. Connector holds the row a create will insert,
+// so an interrupted create can be rolled forward without the caller.
+type ConnectorOperation struct {
+	ID          uuid.UUID
+	Kind        OperationKind
+	State       OperationState
+	ConnectorID uuid.UUID
+	SecretName  string
+	Connector   *Connector
+	Attempts    int
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (op *ConnectorOperation) IsFinished() bool {
+	return op.State == OperationStateCompleted || op.State == OperationStateCompensated ||
+		op.State == OperationStateCancelled
+}
@@ -13,6 +13,106 @@ type ConnectorRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	ListConnectors(ctx context.Context, limit int, cursor *ListCursor) ([]Connector, *ListCursor, error)
 	UpdateConnector(ctx context.Context, id uuid.UUID, token string) error
+	ListByTenant(ctx context.Context, tenantID string, limit int) ([]Connector, error)
+	GetByWorkspaceAndTenant(ctx context.Context, workspaceID, tenantID string) (*Connector, error)
+	UpdateSecretName(ctx context.Context, id uuid.UUID, secretName string) error
+	UpdateStatus(ctx context.Context, id uuid.UUID, status ConnectorStatus) error
+	GetDeletedByID(ctx context.Context, id uuid.UUID) (*Connector, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]Connector, error)
+	Purge(ctx context.Context, id uuid.UUID) error
+	// ReferencesSecret reports whether any connector, soft-deleted ones
+	// included, uses the secret.
+	ReferencesSecret(ctx context.Context, secretName string) (bool, error)
+}
+
+type OperationLog interface {
+	Begin(ctx context.Context, op *ConnectorOperation) error
+	Advance(ctx context.Context, id uuid.UUID, state OperationState) error
+	RecordFailure(ctx context.Context, id uuid.UUID, reason string) error
+	ListUnfinished(ctx context.Context, updatedBefore time.Time, limit int) ([]ConnectorOperation, error)
+	// CancelUnfinished moves every unfinished operation of kind on the
+	// connector to cancelled, so the reconciler leaves them alone.
+	CancelUnfinished(ctx context.Context, connectorID uuid.UUID, kind OperationKind) error
+}
+
+// AuditLog appends events to the hash chain; Append assigns ID, PrevHash and
+// Hash. List returns events in ID order and the ID to resume after, if any.
+type AuditLog interface {
+	Append(ctx context.Context, event *AuditEvent) error
+	List(ctx context.Context, filter AuditFilter, limit int, afterID int64) ([]AuditEvent, int64, error)
+}
+
+// Transactor runs fn in a database transaction carried by the context it
+// passes on. Repositories called with that context join the transaction.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Outbox stores events in the same transaction as the change they describe
+// until a relay publishes them. Claim leases entries to one relay at a time.
+type Outbox interface {
+	Enqueue(ctx context.Context, event *CloudEvent) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]OutboxEntry, error)
+	MarkPublished(ctx context.Context, id string) error
+	RecordFailure(ctx context.Context, id string, reason string) error
+	PrunePublished(ctx context.Context, before time.Time) error
+}
+
+// EventLog reads outbox entries back in seq order for watchers. Entries stay
+// readable until the relay prunes them after publishing.
+type EventLog interface {
+	// LatestSeq returns the highest seq written so far, 0 when there is none.
+	LatestSeq(ctx context.Context) (int64, error)
+	// OldestSeq returns the lowest seq still stored, 0 when there is none.
+	OldestSeq(ctx context.Context) (int64, error)
+	ListAfter(ctx context.Context, afterSeq int64, limit int) ([]OutboxEntry, error)
+	ListTenantAfter(ctx context.Context, tenantID string, afterSeq, upToSeq int64, limit int) ([]OutboxEntry, error)
+}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event *CloudEvent) error
+}
+
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, sub *WebhookSubscription) error
+	GetByID(ctx context.Context, id uuid.UUID) (*WebhookSubscription, error)
+	ListByTenant(ctx context.Context, tenantID string) ([]WebhookSubscription, error)
+	ListActiveByTenant(ctx context.Context, tenantID string) ([]WebhookSubscription, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Enable(ctx context.Context, id uuid.UUID) error
+	// RecordDeliveryResult resets the failure count on success. On failure it
+	// increments it and disables the subscription once it reaches
+	// disableAfter, returning the resulting status.
+	RecordDeliveryResult(ctx context.Context, id uuid.UUID, success bool, disableAfter int) (WebhookStatus, error)
+}
+
+type WebhookDeliveryRepository interface {
+	// Enqueue ignores deliveries already queued for the same subscription and
+	// event, so an event relayed twice is delivered once.
+	Enqueue(ctx context.Context, deliveries []WebhookDelivery) error
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, delivery *WebhookDelivery, attempt *WebhookDeliveryAttempt) error
+	ListAttempts(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]WebhookDeliveryAttempt, error)
+}
+
+// WebhookSender makes the HTTP request for one delivery attempt.
+type WebhookSender interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
+
+type IdempotencyStore interface {
+	Get(ctx context.Context, key string) (*IdempotencyRecord, error)
+	Save(ctx context.Context, record *IdempotencyRecord) error
+}
+
+type TenantRepository interface {
+	Create(ctx context.Context, t *Tenant) error
+	GetByID(ctx context.Context, id string) (*Tenant, error)
+	List(ctx context.Context, limit int, afterID string) ([]Tenant, string, error)
+	ListByStatus(ctx context.Context, status TenantStatus, limit int) ([]Tenant, error)
+	UpdateStatus(ctx context.Context, id string, status TenantStatus) error
+	Delete(ctx context.Context, id string) error
 }
 
 type ListCursor struct {
@@ -24,6 +124,13 @@ type SecretsManager interface {
 	StoreToken(ctx context.Context, secretName, token string) error
 	GetToken(ctx context.Context, secretName string) (string, error)
 	DeleteToken(ctx context.Context, secretName string) error
+	RestoreToken(ctx context.Context, secretName string) error
+	ListSecrets(ctx context.Context, prefix string) ([]SecretSummary, error)
+}
+
+type SecretSummary struct {
+	Name      string
+	CreatedAt time.Time
 }
 
 type SlackClient interface {
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SecretNaming builds the Secrets Manager name for a connector's token. Names
+// are keyed by the connector UUID so they cannot collide, and are scoped by a
+// prefix and environment so several deployments can share one account.
+type SecretNaming struct {
+	Prefix      string
+	Environment string
+}
+
+func NewSecretNaming(prefix, environment string) SecretNaming {
+	return SecretNaming{
+		Prefix:      strings.Trim(prefix, "/"),
+		Environment: strings.Trim(environment, "/"),
+	}
+}
+
+// SecretRecoveryWindow is how long a deleted secret can still be restored.
+// Soft-deleted connectors are purged once it has passed.
+const SecretRecoveryWindow = 30 * 24 * time.Hour
+
+// LegacySecretPrefix is shared by every secret named with LegacySecretName.
+const LegacySecretPrefix = "connector-"
+
+func (n SecretNaming) Name(connectorID uuid.UUID) string {
+	return n.ListPrefix() + connectorID.String()
+}
+
+// ListPrefix is the common prefix of every name this strategy produces.
+func (n SecretNaming) ListPrefix() string {
+	return fmt.Sprintf("%s/%s/connectors/", n.Prefix, n.Environment)
+}
+
+// LegacySecretName is the workspace/tenant based name used before secret names
+// were stored on the connector row. It is only needed to migrate old secrets.
+func LegacySecretName(workspaceID, tenantID string) string {
+	return fmt.Sprintf("%s%s-%s", LegacySecretPrefix, workspaceID, tenantID)
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+type TenantStatus string
+
+const (
+	TenantStatusActive    TenantStatus = "active"
+	TenantStatusSuspended TenantStatus = "suspended"
+	TenantStatusDeleting  TenantStatus = "deleting"
+)
+
+type Tenant struct {
+	ID        string       `db:"id"`
+	Name      string       `db:"name"`
+	Status    TenantStatus `db:"status"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt time.Time    `db:"updated_at"`
+}
+
+func (t *Tenant) IsActive() bool {
+	return t.Status == TenantStatusActive
+}
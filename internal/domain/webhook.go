@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookStatus string
+
+const (
+	WebhookStatusActive WebhookStatus = "active"
+	// WebhookStatusDisabled subscriptions receive no deliveries until they are
+	// enabled again, either by hand or after sustained delivery failures.
+	WebhookStatusDisabled WebhookStatus = "disabled"
+)
+
+// WebhookSubscription sends a tenant's connector events to URL. An empty
+// EventTypes list subscribes to every event type.
+type WebhookSubscription struct {
+	ID                  uuid.UUID
+	TenantID            string
+	URL                 string
+	EventTypes          []string
+	Secret              string
+	Status              WebhookStatus
+	ConsecutiveFailures int
+	DisabledAt          *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (s *WebhookSubscription) Matches(eventType string) bool {
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed deliveries ran out of attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one event queued for one subscription. Payload is the
+// CloudEvent envelope sent as the request body.
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        string
+	EventType      string
+	Payload        json.RawMessage
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// WebhookDeliveryAttempt records the outcome of a single HTTP request.
+type WebhookDeliveryAttempt struct {
+	ID             uuid.UUID
+	DeliveryID     uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        string
+	EventType      string
+	AttemptNumber  int
+	StatusCode     int
+	Error          string
+	Duration       time.Duration
+	AttemptedAt    time.Time
+}
+
+func (a *WebhookDeliveryAttempt) Succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// ConnectorEventTypes lists every event type a webhook can subscribe to.
+func ConnectorEventTypes() []ConnectorEventType {
+	return []ConnectorEventType{
+		ConnectorEventCreated,
+		ConnectorEventUpdated,
+		ConnectorEventDisabled,
+		ConnectorEventRotated,
+		ConnectorEventDeleted,
+		ConnectorEventRestored,
+	}
+}
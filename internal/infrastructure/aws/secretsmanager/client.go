@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -21,12 +22,20 @@ type ManagerAPI interface {
 	PutSecretValue(ctx context.Context, params *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)
 	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
 	DeleteSecret(ctx context.Context, params *secretsmanager.DeleteSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DeleteSecretOutput, error)
+	ListSecrets(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)
+	RestoreSecret(ctx context.Context, params *secretsmanager.RestoreSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.RestoreSecretOutput, error)
 }
 
 type Client struct {
 	client ManagerAPI
 }
 
+// NewClientWithAPI wraps an existing Secrets Manager API client, such as a
+// stub in tests.
+func NewClientWithAPI(api ManagerAPI) *Client {
+	return &Client{client: api}
+}
+
 func WithRetryMaxAttempts(attempts int) ClientOption {
 	return func(o *secretsmanager.Options) {
 		o.Retryer = retry.NewStandard(func(so *retry.StandardOptions) {
@@ -43,7 +52,7 @@ func WithRetryMaxBackoff(duration time.Duration) ClientOption {
 	}
 }
 
-func NewClient(cfg aws.Config, opts ...ClientOption) domain.SecretsManager {
+func NewClient(cfg aws.Config, opts ...ClientOption) *Client {
 	hasRetryOptions := false
 	for _, opt := range opts {
 		optFunc := opt
@@ -69,6 +78,9 @@ func NewClient(cfg aws.Config, opts ...ClientOption) domain.SecretsManager {
 	}
 
 	options = append(options, opts...)
+	options = append(options, func(o *secretsmanager.Options) {
+		o.APIOptions = append(o.APIOptions, addTracingMiddleware)
+	})
 
 	return &Client{
 		client: secretsmanager.NewFromConfig(cfg, options...),
@@ -76,63 +88,145 @@ func NewClient(cfg aws.Config, opts ...ClientOption) domain.SecretsManager {
 }
 
 func (c *Client) StoreToken(ctx context.Context, secretName, token string) error {
-	logger.Info().Str("secret_name", secretName).Msg("Attempting to create secret")
+	logger.Ctx(ctx).Info().Str("secret_name", secretName).Msg("Attempting to create secret")
 	_, err := c.client.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
 		Name:         &secretName,
 		SecretString: &token,
 	})
 	if err != nil {
 		if isResourceExistsError(err) {
-			logger.Warn().Str("secret_name", secretName).Msg("Secret already exists; updating instead")
+			logger.Ctx(ctx).Warn().Str("secret_name", secretName).Msg("Secret already exists; updating instead")
 			_, err = c.client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
 				SecretId:     &secretName,
 				SecretString: &token,
 			})
 			if err != nil {
-				logger.Error().Err(err).Str("secret_name", secretName).Msg("Failed to update secret")
+				logger.Ctx(ctx).Error().Err(err).Str("secret_name", secretName).Msg("Failed to update secret")
 				return fmt.Errorf("update secret: %w", err)
 			}
-			logger.Info().Str("secret_name", secretName).Msg("Secret updated successfully")
+			logger.Ctx(ctx).Info().Str("secret_name", secretName).Msg("Secret updated successfully")
 			return nil
 		}
-		logger.Error().Err(err).Str("secret_name", secretName).Msg("Failed to create secret")
+		logger.Ctx(ctx).Error().Err(err).Str("secret_name", secretName).Msg("Failed to create secret")
 		return fmt.Errorf("create secret: %w", err)
 	}
 	return nil
 }
 
 func (c *Client) GetToken(ctx context.Context, secretName string) (string, error) {
-	logger.Info().Str("secret_name", secretName).Msg("Retrieving secret value")
+	logger.Ctx(ctx).Info().Str("secret_name", secretName).Msg("Retrieving secret value")
 	out, err := c.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
 		SecretId: &secretName,
 	})
 	if err != nil {
-		logger.Error().Err(err).Str("secret_name", secretName).Msg("Failed to get secret value")
+		logger.Ctx(ctx).Error().Err(err).Str("secret_name", secretName).Msg("Failed to get secret value")
 		return "", fmt.Errorf("get secret value: %w", err)
 	}
 	if out.SecretString == nil {
-		logger.Error().Str("secret_name", secretName).Msg("Secret string is nil")
+		logger.Ctx(ctx).Error().Str("secret_name", secretName).Msg("Secret string is nil")
 		return "", fmt.Errorf("secret string is nil")
 	}
 	return *out.SecretString, nil
 }
 
 func (c *Client) DeleteToken(ctx context.Context, secretName string) error {
-	logger.Info().Str("secret_name", secretName).Msg("Deleting secret")
+	logger.Ctx(ctx).Info().Str("secret_name", secretName).Msg("Deleting secret")
 	input := &secretsmanager.DeleteSecretInput{
 		SecretId:             &secretName,
-		RecoveryWindowInDays: aws.Int64(30),
+		RecoveryWindowInDays: aws.Int64(int64(domain.SecretRecoveryWindow / (24 * time.Hour))),
 	}
 	_, err := c.client.DeleteSecret(ctx, input)
 	if err != nil {
-		logger.Error().Err(err).Str("secret_name", secretName).Msg("Failed to delete secret")
+		if isResourceNotFoundError(err) {
+			logger.Ctx(ctx).Warn().Str("secret_name", secretName).Msg("Secret already deleted")
+			return nil
+		}
+		// Deleting a secret twice fails rather than succeeding, but callers
+		// retry deletes and need them to be idempotent.
+		if isScheduledForDeletionError(err) {
+			logger.Ctx(ctx).Warn().Str("secret_name", secretName).Msg("Secret already scheduled for deletion")
+			return nil
+		}
+		logger.Ctx(ctx).Error().Err(err).Str("secret_name", secretName).Msg("Failed to delete secret")
 		return fmt.Errorf("delete secret: %w", err)
 	}
-	logger.Info().Str("secret_name", secretName).Msg("Secret deletion initiated")
+	logger.Ctx(ctx).Info().Str("secret_name", secretName).Msg("Secret deletion initiated")
+	return nil
+}
+
+// RestoreToken cancels a scheduled deletion of the secret.
+func (c *Client) RestoreToken(ctx context.Context, secretName string) error {
+	logger.Ctx(ctx).Info().Str("secret_name", secretName).Msg("Restoring secret")
+	_, err := c.client.RestoreSecret(ctx, &secretsmanager.RestoreSecretInput{
+		SecretId: &secretName,
+	})
+	if err != nil {
+		logger.Ctx(ctx).Error().Err(err).Str("secret_name", secretName).Msg("Failed to restore secret")
+		return fmt.Errorf("restore secret: %w", err)
+	}
+	return nil
+}
+
+// Ping checks that Secrets Manager is reachable and accepts our credentials,
+// listing at most one secret.
+func (c *Client) Ping(ctx context.Context) error {
+	if _, err := c.client.ListSecrets(ctx, &secretsmanager.ListSecretsInput{MaxResults: aws.Int32(1)}); err != nil {
+		return fmt.Errorf("list secrets: %w", err)
+	}
 	return nil
 }
 
+// ListSecrets returns every secret whose name starts with prefix. Secrets that
+// are already scheduled for deletion are not included.
+func (c *Client) ListSecrets(ctx context.Context, prefix string) ([]domain.SecretSummary, error) {
+	logger.Ctx(ctx).Info().Str("prefix", prefix).Msg("Listing secrets")
+	input := &secretsmanager.ListSecretsInput{
+		Filters: []types.Filter{
+			{Key: types.FilterNameStringTypeName, Values: []string{prefix}},
+		},
+		MaxResults: aws.Int32(100),
+	}
+
+	var secrets []domain.SecretSummary
+	paginator := secretsmanager.NewListSecretsPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			logger.Ctx(ctx).Error().Err(err).Str("prefix", prefix).Msg("Failed to list secrets")
+			return nil, fmt.Errorf("list secrets: %w", err)
+		}
+		for _, entry := range page.SecretList {
+			// The name filter matches on prefix but is case-insensitive.
+			name := aws.ToString(entry.Name)
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			secrets = append(secrets, domain.SecretSummary{
+				Name:      name,
+				CreatedAt: aws.ToTime(entry.CreatedDate),
+			})
+		}
+	}
+	return secrets, nil
+}
+
 func isResourceExistsError(err error) bool {
 	var resourceExistsErr *types.ResourceExistsException
 	return errors.As(err, &resourceExistsErr)
 }
+
+func isResourceNotFoundError(err error) bool {
+	var notFoundErr *types.ResourceNotFoundException
+	return errors.As(err, &notFoundErr)
+}
+
+// isScheduledForDeletionError reports whether err is Secrets Manager refusing
+// an operation because the secret is already scheduled for deletion.
+func isScheduledForDeletionError(err error) bool {
+	var invalidRequestErr *types.InvalidRequestException
+	if !errors.As(err, &invalidRequestErr) {
+		return false
+	}
+	message := strings.ToLower(invalidRequestErr.ErrorMessage())
+	return strings.Contains(message, "scheduled for deletion") || strings.Contains(message, "marked for deletion")
+}
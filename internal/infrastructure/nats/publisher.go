@@ -0,0 +1,88 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const cloudEventsContentType = "application/cloudevents+json"
+
+// JetStreamPublisher publishes CloudEvents to a JetStream stream. The event ID
+// is sent as Nats-Msg-Id, so redeliveries within the stream's duplicate
+// window are dropped by the server.
+type JetStreamPublisher struct {
+	conn          *nats.Conn
+	js            jetstream.JetStream
+	subjectPrefix string
+}
+
+// NewJetStreamPublisher connects to url and makes sure a stream named stream
+// captures every subject under subjectPrefix.
+func NewJetStreamPublisher(ctx context.Context, url, stream, subjectPrefix string, dedupeWindow time.Duration) (*JetStreamPublisher, error) {
+	conn, err := nats.Connect(url, nats.Name("connector-recruitment"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open JetStream: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       stream,
+		Subjects:   []string{subjectPrefix + ".>"},
+		Duplicates: dedupeWindow,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to ensure stream %s: %w", stream, err)
+	}
+
+	return &JetStreamPublisher{
+		conn:          conn,
+		js:            js,
+		subjectPrefix: subjectPrefix,
+	}, nil
+}
+
+// Publish sends the event on <prefix>.<tenant>.<action>, e.g.
+// "connectors.acme.created", so consumers can subscribe per tenant or action.
+func (p *JetStreamPublisher) Publish(ctx context.Context, event *domain.CloudEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event %s: %w", event.ID, err)
+	}
+
+	msg := nats.NewMsg(p.Subject(event))
+	msg.Data = body
+	msg.Header.Set("Content-Type", cloudEventsContentType)
+	msg.Header.Set("ce-id", event.ID)
+	msg.Header.Set("ce-type", event.Type)
+
+	if _, err := p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(event.ID)); err != nil {
+		return fmt.Errorf("failed to publish event %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+func (p *JetStreamPublisher) Subject(event *domain.CloudEvent) string {
+	action := event.Type[strings.LastIndex(event.Type, ".")+1:]
+	tenant := event.TenantID
+	if tenant == "" {
+		tenant = "_"
+	}
+	return p.subjectPrefix + "." + tenant + "." + action
+}
+
+func (p *JetStreamPublisher) Close() {
+	p.conn.Close()
+}
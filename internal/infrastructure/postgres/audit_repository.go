@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+// auditChainLockID is the advisory lock key held while appending an event, so
+// every event links to the one committed before it.
+const auditChainLockID int64 = 0x636f6e6e617564 // "connaud"
+
+type AuditRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuditRepository(db *sqlx.DB) domain.AuditLog {
+	return &AuditRepository{db: db}
+}
+
+type auditRow struct {
+	ID         int64     `db:"id"`
+	OccurredAt time.Time `db:"occurred_at"`
+	Actor      string    `db:"actor"`
+	TenantID   string    `db:"tenant_id"`
+	Action     string    `db:"action"`
+	TargetType string    `db:"target_type"`
+	TargetID   string    `db:"target_id"`
+	Outcome    string    `db:"outcome"`
+	RequestID  string    `db:"request_id"`
+	TraceID    string    `db:"trace_id"`
+	Diff       []byte    `db:"diff"`
+	PrevHash   string    `db:"prev_hash"`
+	Hash       string    `db:"hash"`
+}
+
+const auditColumns = `id, occurred_at, actor, tenant_id, action, target_type, target_id,
+		outcome, request_id, trace_id, diff, prev_hash, hash`
+
+func (r *AuditRepository) Append(ctx context.Context, event *domain.AuditEvent) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Writers are serialised on an advisory lock rather than a table lock, so
+	// nothing else touching audit_events waits on them. It is released with
+	// the transaction.
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, auditChainLockID); err != nil {
+		return err
+	}
+
+	var last struct {
+		ID   int64  `db:"id"`
+		Hash string `db:"hash"`
+	}
+	err = tx.GetContext(ctx, &last, `SELECT id, hash FROM audit_events ORDER BY id DESC LIMIT 1`)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	event.ID = last.ID + 1
+	event.PrevHash = last.Hash
+	event.OccurredAt = event.OccurredAt.UTC().Truncate(time.Microsecond)
+	event.Hash = event.ComputeHash()
+
+	row := auditRow{
+		ID:         event.ID,
+		OccurredAt: event.OccurredAt,
+		Actor:      event.Actor,
+		TenantID:   event.TenantID,
+		Action:     string(event.Action),
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		Outcome:    string(event.Outcome),
+		RequestID:  event.RequestID,
+		TraceID:    event.TraceID,
+		Diff:       event.Diff,
+		PrevHash:   event.PrevHash,
+		Hash:       event.Hash,
+	}
+	query := `
+		INSERT INTO audit_events (` + auditColumns + `)
+		VALUES (:id, :occurred_at, :actor, :tenant_id, :action, :target_type, :target_id,
+		        :outcome, :request_id, :trace_id, :diff, :prev_hash, :hash)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, row); err != nil {
+		return translateError(err)
+	}
+	return tx.Commit()
+}
+
+func (r *AuditRepository) List(ctx context.Context, filter domain.AuditFilter, limit int, afterID int64) ([]domain.AuditEvent, int64, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	conditions := []string{"id > $1"}
+	args := []interface{}{afterID}
+	addCondition := func(clause string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(clause, len(args)))
+	}
+	if filter.TenantID != "" {
+		addCondition("tenant_id = $%d", filter.TenantID)
+	}
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.Action != "" {
+		addCondition("action = $%d", string(filter.Action))
+	}
+	if filter.TargetID != "" {
+		addCondition("target_id = $%d", filter.TargetID)
+	}
+	if !filter.Since.IsZero() {
+		addCondition("occurred_at >= $%d", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		addCondition("occurred_at < $%d", filter.Until)
+	}
+	args = append(args, limit+1)
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM audit_events
+		WHERE %s
+		ORDER BY id ASC
+		LIMIT $%d
+	`, auditColumns, strings.Join(conditions, " AND "), len(args))
+
+	var rows []auditRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, 0, err
+	}
+
+	var next int64
+	if len(rows) > limit {
+		next = rows[limit-1].ID
+		rows = rows[:limit]
+	}
+
+	events := make([]domain.AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, domain.AuditEvent{
+			ID:         row.ID,
+			OccurredAt: row.OccurredAt,
+			Actor:      row.Actor,
+			TenantID:   row.TenantID,
+			Action:     domain.AuditAction(row.Action),
+			TargetType: row.TargetType,
+			TargetID:   row.TargetID,
+			Outcome:    domain.AuditOutcome(row.Outcome),
+			RequestID:  row.RequestID,
+			TraceID:    row.TraceID,
+			Diff:       json.RawMessage(row.Diff),
+			PrevHash:   row.PrevHash,
+			Hash:       row.Hash,
+		})
+	}
+	return events, next, nil
+}
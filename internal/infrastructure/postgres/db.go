@@ -2,19 +2,47 @@ package postgres
 
 import (
 	"context"
-	"github.com/jmoiron/sqlx"
 	"time"
+
+	"github.com/XSAM/otelsql"
+	"github.com/jmoiron/sqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 )
 
-func InitDb(ctx context.Context, dsn string) (*sqlx.DB, error) {
-	db, err := sqlx.Open("postgres", dsn)
+// InitDb opens the database through otelsql, so every query is traced as a
+// child of the caller's span. Query text is recorded; arguments are not.
+type DBOption func(*sqlx.DB)
+
+// WithPool sizes the connection pool. The default is 25 open and idle
+// connections, each reused for at most 5 minutes.
+func WithPool(maxOpen, maxIdle int, maxLifetime time.Duration) DBOption {
+	return func(db *sqlx.DB) {
+		db.SetMaxOpenConns(maxOpen)
+		db.SetMaxIdleConns(maxIdle)
+		db.SetConnMaxLifetime(maxLifetime)
+	}
+}
+
+func InitDb(ctx context.Context, dsn string, opts ...DBOption) (*sqlx.DB, error) {
+	sqlDB, err := otelsql.Open("postgres", dsn,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitConnectorConnect: true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
 		return nil, err
 	}
+	db := sqlx.NewDb(sqlDB, "postgres")
 
 	db.SetMaxOpenConns(25)
 	db.SetMaxIdleConns(25)
 	db.SetConnMaxLifetime(5 * time.Minute)
+	for _, opt := range opts {
+		opt(db)
+	}
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
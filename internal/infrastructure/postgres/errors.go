@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/lib/pq"
+)
+
+const uniqueViolation = "23505"
+
+func translateError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return fmt.Errorf("%s: %w", pqErr.Constraint, domain.ErrConflict)
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type IdempotencyRepository struct {
+	db *sqlx.DB
+}
+
+func NewIdempotencyRepository(db *sqlx.DB) domain.IdempotencyStore {
+	return &IdempotencyRepository{db: db}
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, key string) (*domain.IdempotencyRecord, error) {
+	query := `
+        SELECT key, request_hash, connector_id, created_at
+        FROM idempotency_keys
+        WHERE key = $1
+    `
+	var record domain.IdempotencyRecord
+	if err := r.db.GetContext(ctx, &record, query, key); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r *IdempotencyRepository) Save(ctx context.Context, record *domain.IdempotencyRecord) error {
+	query := `
+        INSERT INTO idempotency_keys (key, request_hash, connector_id, created_at)
+        VALUES (:key, :request_hash, :connector_id, :created_at)
+    `
+	_, err := r.db.NamedExecContext(ctx, query, record)
+	return translateError(err)
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type OperationRepository struct {
+	db *sqlx.DB
+}
+
+func NewOperationRepository(db *sqlx.DB) domain.OperationLog {
+	return &OperationRepository{db: db}
+}
+
+type operationRow struct {
+	ID          uuid.UUID `db:"id"`
+	Kind        string    `db:"kind"`
+	State       string    `db:"state"`
+	ConnectorID uuid.UUID `db:"connector_id"`
+	SecretName  string    `db:"secret_name"`
+	Payload     []byte    `db:"payload"`
+	Attempts    int       `db:"attempts"`
+	LastError   string    `db:"last_error"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+func (r *OperationRepository) Begin(ctx context.Context, op *domain.ConnectorOperation) error {
+	var payload []byte
+	if op.Connector != nil {
+		var err error
+		if payload, err = json.Marshal(op.Connector); err != nil {
+			return fmt.Errorf("encode operation payload: %w", err)
+		}
+	}
+
+	row := operationRow{
+		ID:          op.ID,
+		Kind:        string(op.Kind),
+		State:       string(op.State),
+		ConnectorID: op.ConnectorID,
+		SecretName:  op.SecretName,
+		Payload:     payload,
+		CreatedAt:   op.CreatedAt,
+		UpdatedAt:   op.UpdatedAt,
+	}
+	query := `
+		INSERT INTO connector_operations
+		(id, kind, state, connector_id, secret_name, payload, created_at, updated_at)
+		VALUES (:id, :kind, :state, :connector_id, :secret_name, :payload, :created_at, :updated_at)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, row)
+	return translateError(err)
+}
+
+func (r *OperationRepository) Advance(ctx context.Context, id uuid.UUID, state domain.OperationState) error {
+	query := `
+		UPDATE connector_operations
+		SET state = $1, updated_at = $2
+		WHERE id = $3
+	`
+	return r.exec(ctx, query, string(state), time.Now().UTC(), id)
+}
+
+func (r *OperationRepository) RecordFailure(ctx context.Context, id uuid.UUID, reason string) error {
+	query := `
+		UPDATE connector_operations
+		SET attempts = attempts + 1, last_error = $1, updated_at = $2
+		WHERE id = $3
+	`
+	return r.exec(ctx, query, reason, time.Now().UTC(), id)
+}
+
+func (r *OperationRepository) ListUnfinished(ctx context.Context, updatedBefore time.Time, limit int) ([]domain.ConnectorOperation, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT id, kind, state, connector_id, secret_name, payload, attempts, last_error, created_at, updated_at
+		FROM connector_operations
+		WHERE state NOT IN ('completed', 'compensated', 'cancelled') AND updated_at < $1
+		ORDER BY updated_at ASC
+		LIMIT $2
+	`
+	var rows []operationRow
+	if err := r.db.SelectContext(ctx, &rows, query, updatedBefore, limit); err != nil {
+		return nil, err
+	}
+
+	ops := make([]domain.ConnectorOperation, 0, len(rows))
+	for _, row := range rows {
+		op := domain.ConnectorOperation{
+			ID:          row.ID,
+			Kind:        domain.OperationKind(row.Kind),
+			State:       domain.OperationState(row.State),
+			ConnectorID: row.ConnectorID,
+			SecretName:  row.SecretName,
+			Attempts:    row.Attempts,
+			LastError:   row.LastError,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+		}
+		if len(row.Payload) > 0 {
+			op.Connector = &domain.Connector{}
+			if err := json.Unmarshal(row.Payload, op.Connector); err != nil {
+				return nil, fmt.Errorf("decode payload of operation %s: %w", row.ID, err)
+			}
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func (r *OperationRepository) CancelUnfinished(ctx context.Context, connectorID uuid.UUID, kind domain.OperationKind) error {
+	query := `
+		UPDATE connector_operations
+		SET state = 'cancelled', updated_at = $1
+		WHERE connector_id = $2 AND kind = $3 AND state NOT IN ('completed', 'compensated', 'cancelled')
+	`
+	_, err := r.db.ExecContext(ctx, query, time.Now().UTC(), connectorID, string(kind))
+	return err
+}
+
+func (r *OperationRepository) exec(ctx context.Context, query string, args ...interface{}) error {
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
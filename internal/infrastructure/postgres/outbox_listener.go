@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/lib/pq"
+)
+
+// outboxChannel is the channel the event_outbox insert trigger notifies.
+const outboxChannel = "connector_events"
+
+// OutboxListener wakes watchers when events are committed to the outbox. It
+// holds its own connection outside the pool and reconnects on its own.
+type OutboxListener struct {
+	listener      *pq.Listener
+	notifications chan struct{}
+	done          chan struct{}
+}
+
+func NewOutboxListener(dsn string) (*OutboxListener, error) {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Warn().Err(err).Int("event", int(event)).Msg("Outbox listener connection event")
+		}
+	})
+	if err := listener.Listen(outboxChannel); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	l := &OutboxListener{
+		listener:      listener,
+		notifications: make(chan struct{}, 1),
+		done:          make(chan struct{}),
+	}
+	go l.forward()
+	return l, nil
+}
+
+// Notifications signals at least once after each batch of committed events.
+// Signals are coalesced, so one signal may stand for many events.
+func (l *OutboxListener) Notifications() <-chan struct{} {
+	return l.notifications
+}
+
+func (l *OutboxListener) Close() error {
+	close(l.done)
+	return l.listener.Close()
+}
+
+// forward also signals on the nil notification pq sends after reconnecting,
+// since events committed while disconnected were not announced.
+func (l *OutboxListener) forward() {
+	for {
+		select {
+		case <-l.listener.Notify:
+		case <-l.done:
+			return
+		}
+		select {
+		case l.notifications <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type OutboxRepository struct {
+	db *sqlx.DB
+}
+
+func NewOutboxRepository(db *sqlx.DB) domain.Outbox {
+	return &OutboxRepository{db: db}
+}
+
+// NewEventLog reads the outbox written by NewOutboxRepository.
+func NewEventLog(db *sqlx.DB) domain.EventLog {
+	return &OutboxRepository{db: db}
+}
+
+type outboxRow struct {
+	Seq       int64     `db:"seq"`
+	ID        string    `db:"id"`
+	Envelope  []byte    `db:"envelope"`
+	Attempts  int       `db:"attempts"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Enqueue joins the transaction carried by ctx so the event commits or rolls
+// back with the change it describes.
+func (r *OutboxRepository) Enqueue(ctx context.Context, event *domain.CloudEvent) error {
+	envelope, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode event %s: %w", event.ID, err)
+	}
+
+	query := `
+		INSERT INTO event_outbox (id, event_type, tenant_id, envelope, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err = executorFor(ctx, r.db).ExecContext(ctx, query, event.ID, event.Type, event.TenantID, envelope, time.Now().UTC())
+	return translateError(err)
+}
+
+// Claim leases up to limit unpublished entries, oldest first. Entries whose
+// lease ran out are handed out again, which gives at-least-once delivery when
+// a relay dies between publishing and marking an entry.
+func (r *OutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEntry, error) {
+	now := time.Now().UTC()
+	query := `
+		UPDATE event_outbox
+		SET locked_until = $1
+		WHERE seq IN (
+			SELECT seq FROM event_outbox
+			WHERE published_at IS NULL AND (locked_until IS NULL OR locked_until < $2)
+			ORDER BY seq ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING seq, id, envelope, attempts, created_at
+	`
+	var rows []outboxRow
+	if err := r.db.SelectContext(ctx, &rows, query, now.Add(lease), now, limit); err != nil {
+		return nil, err
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Seq < rows[j].Seq })
+	return toOutboxEntries(rows)
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id string) error {
+	query := `
+		UPDATE event_outbox
+		SET published_at = $1, locked_until = NULL
+		WHERE id = $2
+	`
+	_, err := r.db.ExecContext(ctx, query, time.Now().UTC(), id)
+	return err
+}
+
+func (r *OutboxRepository) RecordFailure(ctx context.Context, id string, reason string) error {
+	query := `
+		UPDATE event_outbox
+		SET attempts = attempts + 1, last_error = $1
+		WHERE id = $2
+	`
+	_, err := r.db.ExecContext(ctx, query, reason, id)
+	return err
+}
+
+func (r *OutboxRepository) PrunePublished(ctx context.Context, before time.Time) error {
+	query := `DELETE FROM event_outbox WHERE published_at IS NOT NULL AND published_at < $1`
+	_, err := r.db.ExecContext(ctx, query, before)
+	return err
+}
+
+func (r *OutboxRepository) LatestSeq(ctx context.Context) (int64, error) {
+	var seq int64
+	err := r.db.GetContext(ctx, &seq, `SELECT COALESCE(MAX(seq), 0) FROM event_outbox`)
+	return seq, err
+}
+
+func (r *OutboxRepository) OldestSeq(ctx context.Context) (int64, error) {
+	var seq int64
+	err := r.db.GetContext(ctx, &seq, `SELECT COALESCE(MIN(seq), 0) FROM event_outbox`)
+	return seq, err
+}
+
+// ListAfter returns entries of every tenant with seq above afterSeq. Entries
+// still being written by open transactions are not visible yet and show up as
+// gaps in seq.
+func (r *OutboxRepository) ListAfter(ctx context.Context, afterSeq int64, limit int) ([]domain.OutboxEntry, error) {
+	query := `
+		SELECT seq, id, envelope, attempts, created_at
+		FROM event_outbox
+		WHERE seq > $1
+		ORDER BY seq ASC
+		LIMIT $2
+	`
+	var rows []outboxRow
+	if err := r.db.SelectContext(ctx, &rows, query, afterSeq, limit); err != nil {
+		return nil, err
+	}
+	return toOutboxEntries(rows)
+}
+
+func (r *OutboxRepository) ListTenantAfter(ctx context.Context, tenantID string, afterSeq, upToSeq int64, limit int) ([]domain.OutboxEntry, error) {
+	query := `
+		SELECT seq, id, envelope, attempts, created_at
+		FROM event_outbox
+		WHERE tenant_id = $1 AND seq > $2 AND seq <= $3
+		ORDER BY seq ASC
+		LIMIT $4
+	`
+	var rows []outboxRow
+	if err := r.db.SelectContext(ctx, &rows, query, tenantID, afterSeq, upToSeq, limit); err != nil {
+		return nil, err
+	}
+	return toOutboxEntries(rows)
+}
+
+func toOutboxEntries(rows []outboxRow) ([]domain.OutboxEntry, error) {
+	entries := make([]domain.OutboxEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := domain.OutboxEntry{Seq: row.Seq, Attempts: row.Attempts, CreatedAt: row.CreatedAt}
+		if err := json.Unmarshal(row.Envelope, &entry.Event); err != nil {
+			return nil, fmt.Errorf("decode event %s: %w", row.ID, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
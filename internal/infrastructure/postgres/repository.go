@@ -12,6 +12,9 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+const connectorColumns = `id, workspace_id, tenant_id, default_channel_id, created_at, updated_at,
+        secret_version, secret_name, status, deleted_at`
+
 type ConnectorRepository struct {
 	db *sqlx.DB
 }
@@ -23,39 +26,114 @@ func NewConnectorRepository(db *sqlx.DB) domain.ConnectorRepository {
 func (r *ConnectorRepository) Create(ctx context.Context, c *domain.Connector) error {
 	query := `
         INSERT INTO connectors 
-        (id, workspace_id, tenant_id, default_channel_id, created_at, updated_at, secret_version)
-        VALUES (:id, :workspace_id, :tenant_id, :default_channel_id, :created_at, :updated_at, :secret_version)
+        (id, workspace_id, tenant_id, default_channel_id, created_at, updated_at, secret_version, secret_name, status)
+        VALUES (:id, :workspace_id, :tenant_id, :default_channel_id, :created_at, :updated_at, :secret_version, :secret_name, :status)
     `
-	_, err := r.db.NamedExecContext(ctx, query, c)
-	return err
+	_, err := r.conn(ctx).NamedExecContext(ctx, query, c)
+	return translateError(err)
 }
 
 func (r *ConnectorRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Connector, error) {
 	query := `
-        SELECT id, workspace_id, tenant_id, default_channel_id, created_at, updated_at, secret_version
+        SELECT ` + connectorColumns + `
         FROM connectors
-        WHERE id = $1
+        WHERE id = $1 AND deleted_at IS NULL
     `
 	var conn domain.Connector
-	if err := r.db.GetContext(ctx, &conn, query, id); err != nil {
+	if err := r.conn(ctx).GetContext(ctx, &conn, query, id); err != nil {
 		return nil, err
 	}
 	return &conn, nil
 }
 
+func (r *ConnectorRepository) GetByWorkspaceAndTenant(ctx context.Context, workspaceID, tenantID string) (*domain.Connector, error) {
+	query := `
+        SELECT ` + connectorColumns + `
+        FROM connectors
+        WHERE workspace_id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+    `
+	var conn domain.Connector
+	if err := r.conn(ctx).GetContext(ctx, &conn, query, workspaceID, tenantID); err != nil {
+		return nil, err
+	}
+	return &conn, nil
+}
+
+// Delete soft-deletes the connector. The row stays restorable until Purge
+// removes it.
 func (r *ConnectorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE connectors
+		SET deleted_at = $1, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+	return r.execAffecting(ctx, query, time.Now().UTC(), id)
+}
+
+func (r *ConnectorRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Connector, error) {
+	query := `
+        SELECT ` + connectorColumns + `
+        FROM connectors
+        WHERE id = $1 AND deleted_at IS NOT NULL
+    `
+	var conn domain.Connector
+	if err := r.conn(ctx).GetContext(ctx, &conn, query, id); err != nil {
+		return nil, err
+	}
+	return &conn, nil
+}
+
+func (r *ConnectorRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE connectors
+		SET deleted_at = NULL, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NOT NULL
+	`
+	return translateError(r.execAffecting(ctx, query, time.Now().UTC(), id))
+}
+
+func (r *ConnectorRepository) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]domain.Connector, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT ` + connectorColumns + `
+		FROM connectors
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1
+		ORDER BY deleted_at ASC
+		LIMIT $2
+	`
+	var connectors []domain.Connector
+	if err := r.conn(ctx).SelectContext(ctx, &connectors, query, before, limit); err != nil {
+		return nil, err
+	}
+	return connectors, nil
+}
+
+// Purge removes the row for good, whether or not it was soft-deleted first.
+func (r *ConnectorRepository) Purge(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM connectors WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := r.conn(ctx).ExecContext(ctx, query, id)
 	return err
 }
 
+func (r *ConnectorRepository) ReferencesSecret(ctx context.Context, secretName string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM connectors WHERE secret_name = $1)`
+	var exists bool
+	if err := r.conn(ctx).GetContext(ctx, &exists, query, secretName); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
 func (r *ConnectorRepository) ListConnectors(ctx context.Context, limit int, cursor *domain.ListCursor) ([]domain.Connector, *domain.ListCursor, error) {
 	if limit <= 0 {
 		limit = 50
 	}
 
 	var args []interface{}
-	var conditions []string
+	conditions := []string{"deleted_at IS NULL"}
 
 	if cursor != nil {
 		conditions = append(conditions, "(updated_at, id) > ($1, $2)")
@@ -63,17 +141,15 @@ func (r *ConnectorRepository) ListConnectors(ctx context.Context, limit int, cur
 	}
 
 	query := `
-		SELECT id, workspace_id, tenant_id, default_channel_id, created_at, updated_at, secret_version
+		SELECT ` + connectorColumns + `
 		FROM connectors
 	`
-	if len(conditions) > 0 {
-		query += " WHERE " + strings.Join(conditions, " AND ")
-	}
+	query += " WHERE " + strings.Join(conditions, " AND ")
 	query += " ORDER BY updated_at ASC, id ASC LIMIT $" + strconv.Itoa(len(args)+1)
 	args = append(args, limit+1)
 
 	var connectors []domain.Connector
-	if err := r.db.SelectContext(ctx, &connectors, query, args...); err != nil {
+	if err := r.conn(ctx).SelectContext(ctx, &connectors, query, args...); err != nil {
 		return nil, nil, err
 	}
 
@@ -96,7 +172,55 @@ func (r *ConnectorRepository) UpdateConnector(ctx context.Context, id uuid.UUID,
 		SET secret_version = $1, updated_at = $2
 		WHERE id = $3
 	`
-	result, err := r.db.ExecContext(ctx, query, token, time.Now().UTC(), id)
+	return r.execAffecting(ctx, query, token, time.Now().UTC(), id)
+}
+
+func (r *ConnectorRepository) UpdateSecretName(ctx context.Context, id uuid.UUID, secretName string) error {
+	query := `
+		UPDATE connectors
+		SET secret_name = $1, updated_at = $2
+		WHERE id = $3
+	`
+	return r.execAffecting(ctx, query, secretName, time.Now().UTC(), id)
+}
+
+func (r *ConnectorRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ConnectorStatus) error {
+	query := `
+		UPDATE connectors
+		SET status = $1, updated_at = $2
+		WHERE id = $3
+	`
+	return r.execAffecting(ctx, query, string(status), time.Now().UTC(), id)
+}
+
+// ListByTenant includes soft-deleted connectors so tenant deletion can purge
+// every row that references the tenant.
+func (r *ConnectorRepository) ListByTenant(ctx context.Context, tenantID string, limit int) ([]domain.Connector, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT ` + connectorColumns + `
+		FROM connectors
+		WHERE tenant_id = $1
+		ORDER BY id ASC
+		LIMIT $2
+	`
+	var connectors []domain.Connector
+	if err := r.conn(ctx).SelectContext(ctx, &connectors, query, tenantID, limit); err != nil {
+		return nil, err
+	}
+	return connectors, nil
+}
+
+// conn joins the transaction carried by ctx, if any.
+func (r *ConnectorRepository) conn(ctx context.Context) executor {
+	return executorFor(ctx, r.db)
+}
+
+func (r *ConnectorRepository) execAffecting(ctx context.Context, query string, args ...interface{}) error {
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
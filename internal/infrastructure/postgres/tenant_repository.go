@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type TenantRepository struct {
+	db *sqlx.DB
+}
+
+func NewTenantRepository(db *sqlx.DB) domain.TenantRepository {
+	return &TenantRepository{db: db}
+}
+
+func (r *TenantRepository) Create(ctx context.Context, t *domain.Tenant) error {
+	query := `
+		INSERT INTO tenants (id, name, status, created_at, updated_at)
+		VALUES (:id, :name, :status, :created_at, :updated_at)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, t)
+	return translateError(err)
+}
+
+func (r *TenantRepository) GetByID(ctx context.Context, id string) (*domain.Tenant, error) {
+	query := `
+		SELECT id, name, status, created_at, updated_at
+		FROM tenants
+		WHERE id = $1
+	`
+	var t domain.Tenant
+	if err := r.db.GetContext(ctx, &t, query, id); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *TenantRepository) List(ctx context.Context, limit int, afterID string) ([]domain.Tenant, string, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT id, name, status, created_at, updated_at
+		FROM tenants
+		WHERE id > $1
+		ORDER BY id ASC
+		LIMIT $2
+	`
+	var tenants []domain.Tenant
+	if err := r.db.SelectContext(ctx, &tenants, query, afterID, limit+1); err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(tenants) > limit {
+		next = tenants[limit-1].ID
+		tenants = tenants[:limit]
+	}
+	return tenants, next, nil
+}
+
+func (r *TenantRepository) ListByStatus(ctx context.Context, status domain.TenantStatus, limit int) ([]domain.Tenant, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT id, name, status, created_at, updated_at
+		FROM tenants
+		WHERE status = $1
+		ORDER BY updated_at ASC, id ASC
+		LIMIT $2
+	`
+	var tenants []domain.Tenant
+	if err := r.db.SelectContext(ctx, &tenants, query, status, limit); err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+func (r *TenantRepository) UpdateStatus(ctx context.Context, id string, status domain.TenantStatus) error {
+	query := `
+		UPDATE tenants
+		SET status = $1, updated_at = $2
+		WHERE id = $3
+	`
+	result, err := r.db.ExecContext(ctx, query, status, time.Now().UTC(), id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *TenantRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM tenants WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,352 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type WebhookSubscriptionRepository struct {
+	db *sqlx.DB
+}
+
+func NewWebhookSubscriptionRepository(db *sqlx.DB) domain.WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{db: db}
+}
+
+type webhookSubscriptionRow struct {
+	ID                  uuid.UUID      `db:"id"`
+	TenantID            string         `db:"tenant_id"`
+	URL                 string         `db:"url"`
+	EventTypes          pq.StringArray `db:"event_types"`
+	Secret              string         `db:"secret"`
+	Status              string         `db:"status"`
+	ConsecutiveFailures int            `db:"consecutive_failures"`
+	DisabledAt          *time.Time     `db:"disabled_at"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+}
+
+func (row webhookSubscriptionRow) toDomain() domain.WebhookSubscription {
+	return domain.WebhookSubscription{
+		ID:                  row.ID,
+		TenantID:            row.TenantID,
+		URL:                 row.URL,
+		EventTypes:          []string(row.EventTypes),
+		Secret:              row.Secret,
+		Status:              domain.WebhookStatus(row.Status),
+		ConsecutiveFailures: row.ConsecutiveFailures,
+		DisabledAt:          row.DisabledAt,
+		CreatedAt:           row.CreatedAt,
+		UpdatedAt:           row.UpdatedAt,
+	}
+}
+
+const webhookSubscriptionColumns = `id, tenant_id, url, event_types, secret, status,
+		consecutive_failures, disabled_at, created_at, updated_at`
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, sub *domain.WebhookSubscription) error {
+	eventTypes := sub.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	row := webhookSubscriptionRow{
+		ID:         sub.ID,
+		TenantID:   sub.TenantID,
+		URL:        sub.URL,
+		EventTypes: pq.StringArray(eventTypes),
+		Secret:     sub.Secret,
+		Status:     string(sub.Status),
+		CreatedAt:  sub.CreatedAt,
+		UpdatedAt:  sub.UpdatedAt,
+	}
+	query := `
+		INSERT INTO webhook_subscriptions (id, tenant_id, url, event_types, secret, status, created_at, updated_at)
+		VALUES (:id, :tenant_id, :url, :event_types, :secret, :status, :created_at, :updated_at)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, row)
+	return translateError(err)
+}
+
+func (r *WebhookSubscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	query := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions WHERE id = $1`
+	var row webhookSubscriptionRow
+	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
+		return nil, err
+	}
+	sub := row.toDomain()
+	return &sub, nil
+}
+
+func (r *WebhookSubscriptionRepository) ListByTenant(ctx context.Context, tenantID string) ([]domain.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE tenant_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+	return r.list(ctx, query, tenantID)
+}
+
+func (r *WebhookSubscriptionRepository) ListActiveByTenant(ctx context.Context, tenantID string) ([]domain.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE tenant_id = $1 AND status = 'active'
+		ORDER BY created_at ASC, id ASC
+	`
+	return r.list(ctx, query, tenantID)
+}
+
+func (r *WebhookSubscriptionRepository) list(ctx context.Context, query string, args ...interface{}) ([]domain.WebhookSubscription, error) {
+	var rows []webhookSubscriptionRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	subs := make([]domain.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		subs = append(subs, row.toDomain())
+	}
+	return subs, nil
+}
+
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) Enable(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET status = 'active', consecutive_failures = 0, disabled_at = NULL, updated_at = $1
+		WHERE id = $2
+	`
+	result, err := r.db.ExecContext(ctx, query, time.Now().UTC(), id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) RecordDeliveryResult(ctx context.Context, id uuid.UUID, success bool, disableAfter int) (domain.WebhookStatus, error) {
+	query := `
+		UPDATE webhook_subscriptions
+		SET consecutive_failures = CASE WHEN $1 THEN 0 ELSE consecutive_failures + 1 END,
+		    status = CASE WHEN NOT $1 AND consecutive_failures + 1 >= $2 THEN 'disabled' ELSE status END,
+		    disabled_at = CASE WHEN NOT $1 AND consecutive_failures + 1 >= $2 AND status = 'active' THEN $3 ELSE disabled_at END,
+		    updated_at = $3
+		WHERE id = $4
+		RETURNING status
+	`
+	var status string
+	if err := r.db.GetContext(ctx, &status, query, success, disableAfter, time.Now().UTC(), id); err != nil {
+		return "", err
+	}
+	return domain.WebhookStatus(status), nil
+}
+
+type WebhookDeliveryRepository struct {
+	db *sqlx.DB
+}
+
+func NewWebhookDeliveryRepository(db *sqlx.DB) domain.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{db: db}
+}
+
+type webhookDeliveryRow struct {
+	ID             uuid.UUID `db:"id"`
+	SubscriptionID uuid.UUID `db:"subscription_id"`
+	EventID        string    `db:"event_id"`
+	EventType      string    `db:"event_type"`
+	Payload        []byte    `db:"payload"`
+	Status         string    `db:"status"`
+	Attempts       int       `db:"attempts"`
+	NextAttemptAt  time.Time `db:"next_attempt_at"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+func (r *WebhookDeliveryRepository) Enqueue(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	rows := make([]webhookDeliveryRow, 0, len(deliveries))
+	for _, d := range deliveries {
+		rows = append(rows, webhookDeliveryRow{
+			ID:             d.ID,
+			SubscriptionID: d.SubscriptionID,
+			EventID:        d.EventID,
+			EventType:      d.EventType,
+			Payload:        d.Payload,
+			Status:         string(d.Status),
+			NextAttemptAt:  d.NextAttemptAt,
+			CreatedAt:      d.CreatedAt,
+			UpdatedAt:      d.UpdatedAt,
+		})
+	}
+	query := `
+		INSERT INTO webhook_deliveries
+		(id, subscription_id, event_id, event_type, payload, status, next_attempt_at, created_at, updated_at)
+		VALUES (:id, :subscription_id, :event_id, :event_type, :payload, :status, :next_attempt_at, :created_at, :updated_at)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING
+	`
+	_, err := r.db.NamedExecContext(ctx, query, rows)
+	return err
+}
+
+// ClaimDue leases pending deliveries that are due and belong to active
+// subscriptions by pushing their next attempt past the lease.
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookDelivery, error) {
+	now := time.Now().UTC()
+	query := `
+		UPDATE webhook_deliveries
+		SET next_attempt_at = $1
+		WHERE id IN (
+			SELECT d.id
+			FROM webhook_deliveries d
+			JOIN webhook_subscriptions s ON s.id = d.subscription_id
+			WHERE d.status = 'pending' AND d.next_attempt_at <= $2 AND s.status = 'active'
+			ORDER BY d.next_attempt_at ASC
+			LIMIT $3
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		RETURNING id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at
+	`
+	var rows []webhookDeliveryRow
+	if err := r.db.SelectContext(ctx, &rows, query, now.Add(lease), now, limit); err != nil {
+		return nil, err
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].CreatedAt.Before(rows[j].CreatedAt) })
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			ID:             row.ID,
+			SubscriptionID: row.SubscriptionID,
+			EventID:        row.EventID,
+			EventType:      row.EventType,
+			Payload:        json.RawMessage(row.Payload),
+			Status:         domain.WebhookDeliveryStatus(row.Status),
+			Attempts:       row.Attempts,
+			NextAttemptAt:  row.NextAttemptAt,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+		})
+	}
+	return deliveries, nil
+}
+
+type webhookAttemptRow struct {
+	ID             uuid.UUID `db:"id"`
+	DeliveryID     uuid.UUID `db:"delivery_id"`
+	SubscriptionID uuid.UUID `db:"subscription_id"`
+	EventID        string    `db:"event_id"`
+	EventType      string    `db:"event_type"`
+	AttemptNumber  int       `db:"attempt_number"`
+	StatusCode     int       `db:"status_code"`
+	Error          string    `db:"error"`
+	DurationMS     int64     `db:"duration_ms"`
+	AttemptedAt    time.Time `db:"attempted_at"`
+}
+
+// RecordAttempt stores the attempt and the delivery's new state together.
+func (r *WebhookDeliveryRepository) RecordAttempt(ctx context.Context, delivery *domain.WebhookDelivery, attempt *domain.WebhookDeliveryAttempt) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	row := webhookAttemptRow{
+		ID:             attempt.ID,
+		DeliveryID:     attempt.DeliveryID,
+		SubscriptionID: attempt.SubscriptionID,
+		EventID:        attempt.EventID,
+		EventType:      attempt.EventType,
+		AttemptNumber:  attempt.AttemptNumber,
+		StatusCode:     attempt.StatusCode,
+		Error:          attempt.Error,
+		DurationMS:     attempt.Duration.Milliseconds(),
+		AttemptedAt:    attempt.AttemptedAt,
+	}
+	query := `
+		INSERT INTO webhook_delivery_attempts
+		(id, delivery_id, subscription_id, event_id, event_type, attempt_number, status_code, error, duration_ms, attempted_at)
+		VALUES (:id, :delivery_id, :subscription_id, :event_id, :event_type, :attempt_number, :status_code, :error, :duration_ms, :attempted_at)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, row); err != nil {
+		return err
+	}
+
+	update := `
+		UPDATE webhook_deliveries
+		SET status = $1, attempts = $2, next_attempt_at = $3, updated_at = $4
+		WHERE id = $5
+	`
+	if _, err := tx.ExecContext(ctx, update, string(delivery.Status), delivery.Attempts,
+		delivery.NextAttemptAt, time.Now().UTC(), delivery.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *WebhookDeliveryRepository) ListAttempts(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]domain.WebhookDeliveryAttempt, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT id, delivery_id, subscription_id, event_id, event_type, attempt_number,
+		       status_code, error, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+		WHERE subscription_id = $1
+		ORDER BY attempted_at DESC
+		LIMIT $2
+	`
+	var rows []webhookAttemptRow
+	if err := r.db.SelectContext(ctx, &rows, query, subscriptionID, limit); err != nil {
+		return nil, err
+	}
+
+	attempts := make([]domain.WebhookDeliveryAttempt, 0, len(rows))
+	for _, row := range rows {
+		attempts = append(attempts, domain.WebhookDeliveryAttempt{
+			ID:             row.ID,
+			DeliveryID:     row.DeliveryID,
+			SubscriptionID: row.SubscriptionID,
+			EventID:        row.EventID,
+			EventType:      row.EventType,
+			AttemptNumber:  row.AttemptNumber,
+			StatusCode:     row.StatusCode,
+			Error:          row.Error,
+			Duration:       time.Duration(row.DurationMS) * time.Millisecond,
+			AttemptedAt:    row.AttemptedAt,
+		})
+	}
+	return attempts, nil
+}
@@ -2,15 +2,30 @@ package redis
 
 import (
 	"context"
-	"github.com/redis/go-redis/v9"
 	"time"
+
+	"github.com/redis/go-redis/extra/redisotel/v9"
+	"github.com/redis/go-redis/v9"
 )
 
-func IntClient(ctx context.Context, addr string) (*redis.Client, error) {
-	redisClient := redis.NewClient(&redis.Options{
+type ClientOption func(*redis.Options)
+
+// WithDB selects the logical database. The default is 0.
+func WithDB(db int) ClientOption {
+	return func(o *redis.Options) {
+		o.DB = db
+	}
+}
+
+func IntClient(ctx context.Context, addr string, opts ...ClientOption) (*redis.Client, error) {
+	options := &redis.Options{
 		Addr: addr,
 		DB:   0,
-	})
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	redisClient := redis.NewClient(options)
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -19,5 +34,12 @@ func IntClient(ctx context.Context, addr string) (*redis.Client, error) {
 		return nil, err
 	}
 
+	// Trace every command as a child of the caller's span. Arguments can hold
+	// OAuth state and idempotency keys, so only command names are recorded.
+	if err := redisotel.InstrumentTracing(redisClient, redisotel.WithDBStatement(false)); err != nil {
+		redisClient.Close()
+		return nil, err
+	}
+
 	return redisClient, nil
 }
@@ -0,0 +1,86 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// gcraScript implements the generic cell rate algorithm. The key holds the
+// bucket's theoretical arrival time (TAT) in microseconds on the Redis clock,
+// so replicas with skewed clocks still agree. A request is allowed once the
+// TAT is no more than burst-1 intervals ahead of now, and pushes it one
+// interval further. A pause moves the TAT so the next request is allowed
+// only when the pause ends.
+//
+// KEYS[1] is the bucket. ARGV[1] is the interval in microseconds, ARGV[2]
+// the burst and ARGV[3] the pause in microseconds, or 0 to take a request.
+// It returns 0 when the request was taken, or the microseconds to wait.
+var gcraScript = redis.NewScript(`
+local now = redis.call('TIME')
+now = tonumber(now[1]) * 1000000 + tonumber(now[2])
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local pause = tonumber(ARGV[3])
+
+local tat = tonumber(redis.call('GET', KEYS[1]))
+if not tat or tat < now then
+	tat = now
+end
+
+if pause > 0 then
+	local paused = now + pause + interval * (burst - 1)
+	if paused <= tat then
+		return 0
+	end
+	tat = paused
+else
+	local allowAt = tat - interval * (burst - 1)
+	if allowAt > now then
+		return allowAt - now
+	end
+	tat = tat + interval
+end
+
+redis.call('SET', KEYS[1], string.format('%d', tat), 'PX', math.ceil((tat - now) / 1000) + 1)
+return 0
+`)
+
+// RateLimiter is a rate limiter whose buckets live in Redis, so every replica
+// draws from the same allowance.
+type RateLimiter struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRateLimiter stores each bucket under prefix followed by its key.
+func NewRateLimiter(client *redis.Client, prefix string) *RateLimiter {
+	return &RateLimiter{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// Reserve takes a request from the bucket key, which holds up to burst
+// requests and refills one every interval. If none is free it takes nothing
+// and returns how long to wait before asking again.
+func (l *RateLimiter) Reserve(ctx context.Context, key string, interval time.Duration, burst int) (time.Duration, error) {
+	return l.run(ctx, key, interval, burst, 0)
+}
+
+// Pause lets no requests through the bucket key for d.
+func (l *RateLimiter) Pause(ctx context.Context, key string, interval time.Duration, burst int, d time.Duration) error {
+	_, err := l.run(ctx, key, interval, burst, d)
+	return err
+}
+
+func (l *RateLimiter) run(ctx context.Context, key string, interval time.Duration, burst int, pause time.Duration) (time.Duration, error) {
+	wait, err := gcraScript.Run(ctx, l.client, []string{l.prefix + key},
+		interval.Microseconds(), burst, pause.Microseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("rate limit %s: %w", key, err)
+	}
+	return time.Duration(wait) * time.Microsecond, nil
+}
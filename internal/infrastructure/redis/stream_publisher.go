@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// StreamPublisher appends CloudEvents to a Redis stream. Redis does not
+// dedupe entries, so consumers must skip event IDs they have already handled.
+type StreamPublisher struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+// NewStreamPublisher trims the stream to roughly maxLen entries; zero keeps
+// every entry.
+func NewStreamPublisher(client *redis.Client, stream string, maxLen int64) *StreamPublisher {
+	return &StreamPublisher{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+func (p *StreamPublisher) Publish(ctx context.Context, event *domain.CloudEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event %s: %w", event.ID, err)
+	}
+
+	args := &redis.XAddArgs{
+		Stream: p.stream,
+		Values: map[string]interface{}{
+			"id":    event.ID,
+			"type":  event.Type,
+			"event": body,
+		},
+	}
+	if p.maxLen > 0 {
+		args.MaxLen = p.maxLen
+		args.Approx = true
+	}
+
+	if err := p.client.XAdd(ctx, args).Err(); err != nil {
+		return fmt.Errorf("failed to publish event %s: %w", event.ID, err)
+	}
+	return nil
+}
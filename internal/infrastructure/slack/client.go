@@ -9,10 +9,15 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/observability"
 	"github.com/slack-go/slack"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/time/rate"
 )
 
@@ -26,8 +31,14 @@ type Client struct {
 	scopes       string
 	httpClient   *http.Client
 	limiter      *rate.Limiter
+	methods      map[string]Limit
+	local        *localLimiter
+	shared       Limiter
+	// sharedWarned is when the last shared limiter failure was logged.
+	sharedWarned atomic.Int64
 	retryMax     int
 	retryWait    time.Duration
+	metrics      *observability.Metrics
 }
 
 func WithRetry(max int, wait time.Duration) ClientOption {
@@ -43,6 +54,36 @@ func WithRateLimit(rps float64, burst int) ClientOption {
 	}
 }
 
+// WithMethodLimit overrides the request rate for one Slack API method. For
+// chat.postMessage the limit applies to each channel separately.
+func WithMethodLimit(method string, perMinute, burst int) ClientOption {
+	return func(c *Client) {
+		c.methods[method] = Limit{PerMinute: perMinute, Burst: burst}
+	}
+}
+
+// WithSharedRateLimit keeps the per-method limits in limiter, such as one
+// backed by Redis, so that replicas share them instead of each spending the
+// full allowance. While limiter fails, each replica limits itself locally.
+func WithSharedRateLimit(limiter Limiter) ClientOption {
+	return func(c *Client) {
+		c.shared = limiter
+	}
+}
+
+// SetRateLimit changes the request rate limit of a running client.
+func (c *Client) SetRateLimit(rps float64, burst int) {
+	c.limiter.SetLimit(rate.Limit(rps))
+	c.limiter.SetBurst(burst)
+}
+
+// WithMetrics records how long calls wait on the rate limiter.
+func WithMetrics(m *observability.Metrics) ClientOption {
+	return func(c *Client) {
+		c.metrics = m
+	}
+}
+
 func NewSlackClient(baseURL, clientID, clientSecret, redirectURL, scopes string, opts ...ClientOption) *Client {
 	c := &Client{
 		baseURL:      baseURL,
@@ -50,10 +91,15 @@ func NewSlackClient(baseURL, clientID, clientSecret, redirectURL, scopes string,
 		clientSecret: clientSecret,
 		redirectURL:  redirectURL,
 		scopes:       scopes,
-		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		httpClient:   newHTTPClient(),
 		retryMax:     3,
 		retryWait:    time.Second,
 		limiter:      rate.NewLimiter(rate.Limit(1), 1),
+		methods:      make(map[string]Limit, len(defaultMethodLimits)),
+		local:        newLocalLimiter(),
+	}
+	for method, limit := range defaultMethodLimits {
+		c.methods[method] = limit
 	}
 
 	for _, opt := range opts {
@@ -63,38 +109,150 @@ func NewSlackClient(baseURL, clientID, clientSecret, redirectURL, scopes string,
 	return c
 }
 
-func (c *Client) ResolveChannelID(ctx context.Context, token, channelName string) (string, error) {
+// newHTTPClient traces every Slack API request as a client span named after
+// the API method.
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: otelhttp.NewTransport(http.DefaultTransport,
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return "slack " + r.URL.Path
+			})),
+	}
+}
+
+// bucket is the per-method rate limit a call draws from.
+type bucket struct {
+	key   string
+	limit Limit
+}
+
+func (c *Client) waitForRateLimit(ctx context.Context, b *bucket) error {
+	start := time.Now()
+	defer func() {
+		c.metrics.ObserveRateLimitWait(observability.DependencySlack, time.Since(start))
+	}()
+
 	if err := c.limiter.Wait(ctx); err != nil {
-		return "", fmt.Errorf("rate limit wait: %w", err)
+		return err
 	}
+	if b == nil {
+		return nil
+	}
+	for {
+		wait, err := c.reserve(ctx, b)
+		if err != nil {
+			return err
+		}
+		if wait <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
 
-	api := slack.New(token,
+func (c *Client) reserve(ctx context.Context, b *bucket) (time.Duration, error) {
+	if c.shared != nil {
+		wait, err := c.shared.Reserve(ctx, b.key, b.limit.interval(), b.limit.Burst)
+		if err == nil {
+			return wait, nil
+		}
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		c.warnShared(ctx, err)
+	}
+	return c.local.Reserve(ctx, b.key, b.limit.interval(), b.limit.Burst)
+}
+
+func (c *Client) pause(ctx context.Context, b *bucket, d time.Duration) {
+	if c.shared != nil {
+		err := c.shared.Pause(ctx, b.key, b.limit.interval(), b.limit.Burst, d)
+		if err == nil {
+			return
+		}
+		c.warnShared(ctx, err)
+	}
+	_ = c.local.Pause(ctx, b.key, b.limit.interval(), b.limit.Burst, d)
+}
+
+// warnShared logs a shared limiter failure at most once a minute, since
+// every Slack call hits it while the limiter is down.
+func (c *Client) warnShared(ctx context.Context, err error) {
+	now := time.Now().UnixNano()
+	last := c.sharedWarned.Load()
+	if now-last < int64(time.Minute) || !c.sharedWarned.CompareAndSwap(last, now) {
+		return
+	}
+	logger.Ctx(ctx).Warn().Err(err).Msg("Shared Slack rate limiter unavailable; limiting locally")
+}
+
+// call runs fn under the global and per-method rate limits, retrying only
+// errors Slack says may go away. A 429 waits for Slack's Retry-After and
+// holds back every other call to the same bucket for as long; anything else
+// retryable backs off exponentially. channel is only used for methods
+// limited per channel.
+func (c *Client) call(ctx context.Context, method, token, channel string, fn func() error) error {
+	var b *bucket
+	if limit, ok := c.methods[method]; ok {
+		b = &bucket{key: bucketKey(token, method, channel), limit: limit}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(ctx, b); err != nil {
+			return fmt.Errorf("rate limit wait: %w", err)
+		}
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		slackErr := classify(method, err)
+		if !slackErr.Retryable() || attempt >= c.retryMax {
+			return slackErr
+		}
+		wait := time.Duration(float64(c.retryWait) * math.Pow(2, float64(attempt+1)))
+		if slackErr.RetryAfter > 0 {
+			wait = slackErr.RetryAfter
+			if b != nil {
+				c.pause(ctx, b, wait)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (c *Client) api(token string) *slack.Client {
+	return slack.New(token,
 		slack.OptionHTTPClient(c.httpClient),
 		slack.OptionAppLevelToken(token),
+		slack.OptionAPIURL(strings.TrimSuffix(c.baseURL, "/")+"/"),
 	)
+}
 
-	var channels []slack.Channel
-	var err error
-
-	for attempt := 0; attempt <= c.retryMax; attempt++ {
-		if attempt > 0 {
-			backoff := time.Duration(float64(c.retryWait) * math.Pow(2, float64(attempt)))
-			select {
-			case <-ctx.Done():
-				return "", ctx.Err()
-			case <-time.After(backoff):
-			}
-		}
+func (c *Client) ResolveChannelID(ctx context.Context, token, channelName string) (string, error) {
+	api := c.api(token)
 
+	var channels []slack.Channel
+	err := c.call(ctx, methodConversationsList, token, "", func() error {
+		var err error
 		channels, _, err = api.GetConversationsContext(ctx, &slack.GetConversationsParameters{
 			Limit:           1000,
 			ExcludeArchived: true,
 			Types:           []string{"public_channel", "private_channel"},
 		})
-		if err == nil {
-			break
-		}
-	}
+		return err
+	})
 	if err != nil {
 		return "", fmt.Errorf("get conversations: %w", err)
 	}
@@ -104,118 +262,128 @@ func (c *Client) ResolveChannelID(ctx context.Context, token, channelName string
 			return ch.ID, nil
 		}
 	}
-	return "", fmt.Errorf("channel %s not found", channelName)
+	return "", &Error{
+		Method: methodConversationsList,
+		Code:   "channel_not_found",
+		Err:    fmt.Errorf("channel %s not found", channelName),
+	}
 }
 
 func (c *Client) SendMessage(ctx context.Context, token, channelID, message string) error {
-	if err := c.limiter.Wait(ctx); err != nil {
-		return fmt.Errorf("rate limit wait: %w", err)
-	}
+	api := c.api(token)
 
-	api := slack.New(token,
-		slack.OptionHTTPClient(c.httpClient),
-		slack.OptionAppLevelToken(token),
-	)
-
-	var err error
-	for attempt := 0; attempt <= c.retryMax; attempt++ {
-		if attempt > 0 {
-			backoff := time.Duration(float64(c.retryWait) * math.Pow(2, float64(attempt)))
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(backoff):
-			}
-		}
-
-		_, _, err = api.PostMessageContext(ctx, channelID,
+	err := c.call(ctx, methodChatPostMessage, token, channelID, func() error {
+		_, _, err := api.PostMessageContext(ctx, channelID,
 			slack.MsgOptionText(message, false),
 			slack.MsgOptionDisableLinkUnfurl(),
 		)
-		if err == nil {
-			break
-		}
-	}
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("post message: %w", err)
 	}
 	return nil
 }
 
-func (c *Client) ExchangeCode(ctx context.Context, code string) (string, error) {
-	if err := c.limiter.Wait(ctx); err != nil {
-		return "", fmt.Errorf("rate limit wait: %w", err)
+// Ping calls api.test, which needs no token, to check that the Slack API is
+// reachable. It bypasses the rate limiter and retries.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api.test", nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("api.test: %w", err)
 	}
+	defer resp.Body.Close()
 
-	endpoint := "https://slack.com/api/oauth.v2.access"
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("api.test: status %d: %w", resp.StatusCode, err)
+	}
+	if !result.OK {
+		return fmt.Errorf("api.test: %s", result.Error)
+	}
+	return nil
+}
+
+func (c *Client) ExchangeCode(ctx context.Context, code string) (string, error) {
+	endpoint := strings.TrimSuffix(c.baseURL, "/") + "/oauth.v2.access"
 	data := url.Values{}
 	data.Set("code", code)
 	data.Set("client_id", c.clientID)
 	data.Set("client_secret", c.clientSecret)
 	data.Set("redirect_uri", c.redirectURL)
 
-	var result Result
-
-	var lastErr error
-	for attempt := 0; attempt <= c.retryMax; attempt++ {
-		if attempt > 0 {
-			backoff := time.Duration(float64(c.retryWait) * math.Pow(2, float64(attempt)))
-			select {
-			case <-ctx.Done():
-				return "", ctx.Err()
-			case <-time.After(backoff):
-			}
-		}
-
-		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(data.Encode()))
-		if err != nil {
-			lastErr = fmt.Errorf("create request: %w", err)
-			continue
-		}
-		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			lastErr = fmt.Errorf("http request: %w", err)
-			continue
-		}
-
-		var respErr error
-		func() {
-			defer resp.Body.Close()
-
-			if resp.StatusCode != http.StatusOK {
-				body, _ := io.ReadAll(resp.Body)
-				respErr = fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
-				return
-			}
+	var token string
+	err := c.call(ctx, methodOAuthV2Access, "", "", func() error {
+		var err error
+		token, err = c.exchangeCode(ctx, endpoint, data)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("exchange code: %w", err)
+	}
+	return token, nil
+}
 
-			if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				respErr = fmt.Errorf("decode response: %w", err)
-				return
-			}
-		}()
+func (c *Client) exchangeCode(ctx context.Context, endpoint string, data url.Values) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
-		if respErr != nil {
-			lastErr = respErr
-			continue
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return "", &Error{
+			Method:     methodOAuthV2Access,
+			Code:       "ratelimited",
+			RetryAfter: retryAfter(resp.Header.Get("Retry-After")),
 		}
-
-		if !result.Ok {
-			lastErr = fmt.Errorf("slack error: %s", result.Error)
-			continue
+	case resp.StatusCode != http.StatusOK:
+		body, _ := io.ReadAll(resp.Body)
+		return "", &Error{
+			Method: methodOAuthV2Access,
+			Code:   fmt.Sprintf("http_%d", resp.StatusCode),
+			Err:    fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body)),
 		}
+	}
 
-		if result.AccessToken != "" {
-			return result.AccessToken, nil
-		}
-		if result.AuthedUser.AccessToken != "" {
-			return result.AuthedUser.AccessToken, nil
-		}
-		lastErr = errors.New("no access token in response")
+	var result Result
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
+	}
+	if !result.Ok {
+		return "", &Error{Method: methodOAuthV2Access, Code: result.Error}
+	}
+	if result.AccessToken != "" {
+		return result.AccessToken, nil
 	}
+	if result.AuthedUser.AccessToken != "" {
+		return result.AuthedUser.AccessToken, nil
+	}
+	return "", errors.New("no access token in response")
+}
 
-	return "", fmt.Errorf("exchange code failed after %d attempts: %w", c.retryMax+1, lastErr)
+// retryAfter parses a Retry-After header given in seconds, falling back to
+// one second as slack-go does.
+func retryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds <= 0 {
+		return time.Second
+	}
+	return time.Duration(seconds) * time.Second
 }
 
 func (c *Client) GetOAuthV2URL(state string) (string, error) {
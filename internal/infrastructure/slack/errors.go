@@ -0,0 +1,113 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/slack-go/slack"
+)
+
+// retryableCodes are Slack API error codes that mean Slack, not the request,
+// is at fault, so the same request may succeed later.
+var retryableCodes = map[string]bool{
+	"internal_error":      true,
+	"fatal_error":         true,
+	"service_unavailable": true,
+	"request_timeout":     true,
+	"ratelimited":         true,
+}
+
+// invalidAuthCodes mean the token can no longer be used.
+var invalidAuthCodes = map[string]bool{
+	"invalid_auth":     true,
+	"not_authed":       true,
+	"token_revoked":    true,
+	"token_expired":    true,
+	"account_inactive": true,
+}
+
+// Error is a failed Slack API call. It matches domain.ErrSlackRateLimited,
+// domain.ErrSlackInvalidAuth and domain.ErrSlackChannelNotFound with
+// errors.Is.
+type Error struct {
+	Method string
+	// Code is Slack's error code, such as "channel_not_found", or the HTTP
+	// status for failures outside the API, such as "http_503".
+	Code string
+	// RetryAfter is the delay Slack asked for on a 429 response.
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("slack %s: %v", e.Method, e.Err)
+	}
+	return fmt.Sprintf("slack %s: %s", e.Method, e.Code)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	switch target {
+	case domain.ErrSlackRateLimited:
+		return e.Code == "ratelimited"
+	case domain.ErrSlackInvalidAuth:
+		return invalidAuthCodes[e.Code]
+	case domain.ErrSlackChannelNotFound:
+		return e.Code == "channel_not_found"
+	}
+	return false
+}
+
+// Retryable reports whether the same request may succeed later.
+func (e *Error) Retryable() bool {
+	if retryableCodes[e.Code] || strings.HasPrefix(e.Code, "http_5") {
+		return true
+	}
+	if e.Code != "" {
+		return false
+	}
+	// Anything else is a transport failure: the request may never have
+	// reached Slack.
+	var netErr net.Error
+	return errors.As(e.Err, &netErr) && !errors.Is(e.Err, context.Canceled)
+}
+
+// classify wraps an error from slack-go or the HTTP client as an *Error.
+func classify(method string, err error) *Error {
+	var slackErr *Error
+	if errors.As(err, &slackErr) {
+		return slackErr
+	}
+	var rateLimited *slack.RateLimitedError
+	var status slack.StatusCodeError
+	var apiErr slack.SlackErrorResponse
+	switch {
+	case errors.As(err, &rateLimited):
+		return &Error{Method: method, Code: "ratelimited", RetryAfter: rateLimited.RetryAfter, Err: err}
+	case errors.As(err, &status):
+		return &Error{Method: method, Code: fmt.Sprintf("http_%d", status.Code), Err: err}
+	case errors.As(err, &apiErr):
+		return &Error{Method: method, Code: apiErr.Err, Err: err}
+	default:
+		return &Error{Method: method, Err: err}
+	}
+}
+
+// IsClientError reports whether err is Slack rejecting the request itself,
+// such as a revoked token or an unknown channel, rather than Slack failing.
+func IsClientError(err error) bool {
+	var slackErr *Error
+	if errors.As(err, &slackErr) {
+		return slackErr.Code != "" && !slackErr.Retryable()
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package slack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Slack API methods the client calls.
+const (
+	methodConversationsList = "conversations.list"
+	methodChatPostMessage   = "chat.postMessage"
+	methodOAuthV2Access     = "oauth.v2.access"
+)
+
+// Limit is a request rate for one Slack API method.
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+func (l Limit) interval() time.Duration {
+	return time.Minute / time.Duration(l.PerMinute)
+}
+
+// defaultMethodLimits follow Slack's published tiers: conversations.list is
+// Tier 2 and oauth.v2.access Tier 4. chat.postMessage is limited per channel
+// to about one message a second, with short bursts allowed.
+var defaultMethodLimits = map[string]Limit{
+	methodConversationsList: {PerMinute: 20, Burst: 3},
+	methodOAuthV2Access:     {PerMinute: 100, Burst: 10},
+	methodChatPostMessage:   {PerMinute: 60, Burst: 3},
+}
+
+// perChannelMethods are limited separately for each channel, rather than
+// once per workspace.
+var perChannelMethods = map[string]bool{
+	methodChatPostMessage: true,
+}
+
+// Limiter hands out requests from named buckets that hold up to burst
+// requests and refill one every interval. Reserve takes a request from the
+// bucket if one is free; otherwise it takes nothing and returns how long to
+// wait before asking again. Pause empties the bucket for d, so every caller
+// waits out a Retry-After together.
+type Limiter interface {
+	Reserve(ctx context.Context, key string, interval time.Duration, burst int) (time.Duration, error)
+	Pause(ctx context.Context, key string, interval time.Duration, burst int, d time.Duration) error
+}
+
+// bucketKey names the bucket for a call: one per workspace and method, and
+// per channel for chat.postMessage. Slack limits apply per workspace, and a
+// token belongs to a single workspace, so a fingerprint of the token stands
+// in for the workspace without the limiter ever seeing the token itself.
+// Calls without a token, such as oauth.v2.access, share one bucket per app.
+func bucketKey(token, method, channel string) string {
+	workspace := "app"
+	if token != "" {
+		sum := sha256.Sum256([]byte(token))
+		workspace = hex.EncodeToString(sum[:8])
+	}
+	key := workspace + ":" + method
+	if perChannelMethods[method] {
+		key += ":" + channel
+	}
+	return key
+}
+
+// maxIdleLimiters bounds how many buckets the local limiter keeps before
+// idle ones are dropped.
+const maxIdleLimiters = 1024
+
+// localLimiter keeps its buckets in memory, so each replica has its own. It
+// is the default, and the fallback when a shared limiter is unavailable.
+type localLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*pausableLimiter
+}
+
+type pausableLimiter struct {
+	limiter *rate.Limiter
+
+	mu    sync.Mutex
+	until time.Time
+}
+
+func newLocalLimiter() *localLimiter {
+	return &localLimiter{limiters: make(map[string]*pausableLimiter)}
+}
+
+func (m *localLimiter) Reserve(_ context.Context, key string, interval time.Duration, burst int) (time.Duration, error) {
+	l := m.get(key, interval, burst)
+	now := time.Now()
+
+	l.mu.Lock()
+	until := l.until
+	l.mu.Unlock()
+	if until.After(now) {
+		return until.Sub(now), nil
+	}
+
+	r := l.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay, nil
+	}
+	return 0, nil
+}
+
+func (m *localLimiter) Pause(_ context.Context, key string, interval time.Duration, burst int, d time.Duration) error {
+	l := m.get(key, interval, burst)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.until) {
+		l.until = until
+	}
+	return nil
+}
+
+func (m *localLimiter) get(key string, interval time.Duration, burst int) *pausableLimiter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if l, ok := m.limiters[key]; ok {
+		return l
+	}
+	if len(m.limiters) >= maxIdleLimiters {
+		m.dropIdle()
+	}
+	l := &pausableLimiter{limiter: rate.NewLimiter(rate.Every(interval), burst)}
+	m.limiters[key] = l
+	return l
+}
+
+// dropIdle forgets buckets that have refilled completely and are not
+// paused, since a new one would behave the same.
+func (m *localLimiter) dropIdle() {
+	now := time.Now()
+	for key, l := range m.limiters {
+		l.mu.Lock()
+		idle := l.until.Before(now) && l.limiter.TokensAt(now) >= float64(l.limiter.Burst())
+		l.mu.Unlock()
+		if idle {
+			delete(m.limiters, key)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/connector-recruitment/pkg/webhook"
+)
+
+const userAgent = "connector-recruitment-webhooks/1.0"
+
+// Sender posts webhook bodies over HTTP. Redirects are not followed so a
+// subscription cannot be bounced to an address it was not registered with,
+// and connections to private addresses are refused once the endpoint's name
+// has been resolved, so a hostname cannot be pointed at internal services.
+type Sender struct {
+	httpClient            *http.Client
+	allowPrivateAddresses bool
+}
+
+type SenderOption func(*Sender)
+
+// WithPrivateAddresses lets deliveries reach loopback and private addresses,
+// which is only meant for local development.
+func WithPrivateAddresses() SenderOption {
+	return func(s *Sender) {
+		s.allowPrivateAddresses = true
+	}
+}
+
+func NewSender(timeout time.Duration, opts ...SenderOption) *Sender {
+	s := &Sender{}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	if !s.allowPrivateAddresses {
+		dialer.Control = refusePrivateAddress
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would make the dialer see the proxy's address, not the endpoint's.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	s.httpClient = &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return s
+}
+
+// refusePrivateAddress runs for every address the dialer connects to, after
+// name resolution, so it also catches DNS rebinding.
+func refusePrivateAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("webhook endpoint address %q: %w", address, err)
+	}
+	if !webhook.PublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%s: %w", addrPort.Addr(), webhook.ErrPrivateAddress)
+	}
+	return nil
+}
+
+// Send returns the response status code. A non-2xx status is not an error; the
+// caller decides whether it counts as a failed attempt.
+func (s *Sender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return 0, errors.New("request timed out")
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a bounded amount so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,485 @@
+// Package openapi builds an OpenAPI 3 document for a gRPC service from its
+// google.api.http annotations. Request and response schemas follow the JSON
+// the REST gateway produces (proto field names, int64 as strings), and
+// protoc-gen-validate rules become schema constraints.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const Version = "3.0.3"
+
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Tags       []Tag                `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Schema covers the subset of JSON Schema the generator emits.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *uint64            `json:"minLength,omitempty"`
+	MaxLength            *uint64            `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     bool               `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     bool               `json:"exclusiveMaximum,omitempty"`
+	MinItems             *uint64            `json:"minItems,omitempty"`
+	MaxItems             *uint64            `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+// statusSchema is the error body the gateway writes for non-OK responses.
+const statusSchema = "Status"
+
+var pathParam = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// Generate describes every annotated method of the given services. Methods
+// without a google.api.http rule are left out.
+func Generate(info Info, services ...protoreflect.ServiceDescriptor) (*Document, error) {
+	g := &generator{
+		doc: &Document{
+			OpenAPI: Version,
+			Info:    info,
+			Paths:   map[string]*PathItem{},
+			Components: Components{Schemas: map[string]*Schema{
+				statusSchema: {
+					Type: "object",
+					Properties: map[string]*Schema{
+						"code":    {Type: "integer", Format: "int32", Description: "gRPC status code."},
+						"message": {Type: "string"},
+						"details": {Type: "array", Items: &Schema{Type: "object"}},
+					},
+				},
+			}},
+		},
+	}
+
+	for _, svc := range services {
+		g.doc.Tags = append(g.doc.Tags, Tag{Name: string(svc.Name())})
+		methods := svc.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			if err := g.addMethod(svc, methods.Get(i)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return g.doc, nil
+}
+
+// Marshal renders the document as indented JSON with a trailing newline.
+func (d *Document) Marshal() ([]byte, error) {
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+type generator struct {
+	doc *Document
+}
+
+func (g *generator) addMethod(svc protoreflect.ServiceDescriptor, method protoreflect.MethodDescriptor) error {
+	rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return nil
+	}
+
+	bindings := append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...)
+	for i, binding := range bindings {
+		verb, template := httpPattern(binding)
+		if verb == "" {
+			return fmt.Errorf("%s: unsupported http rule", method.FullName())
+		}
+
+		operationID := fmt.Sprintf("%s_%s", svc.Name(), method.Name())
+		if i > 0 {
+			operationID = fmt.Sprintf("%s%d", operationID, i+1)
+		}
+		op, path, err := g.operation(operationID, string(svc.Name()), method, template, binding.GetBody())
+		if err != nil {
+			return err
+		}
+
+		item := g.doc.Paths[path]
+		if item == nil {
+			item = &PathItem{}
+			g.doc.Paths[path] = item
+		}
+		switch verb {
+		case http.MethodGet:
+			item.Get = op
+		case http.MethodPut:
+			item.Put = op
+		case http.MethodPost:
+			item.Post = op
+		case http.MethodDelete:
+			item.Delete = op
+		case http.MethodPatch:
+			item.Patch = op
+		}
+	}
+	return nil
+}
+
+func (g *generator) operation(id, tag string, method protoreflect.MethodDescriptor, template, body string) (*Operation, string, error) {
+	input := method.Input()
+	op := &Operation{
+		OperationID: id,
+		Tags:        []string{tag},
+		Responses: map[string]*Response{
+			"200": {
+				Description: "A successful response.",
+				Content:     jsonContent(g.messageRef(method.Output())),
+			},
+			"default": {
+				Description: "An error response.",
+				Content:     jsonContent(&Schema{Ref: schemaRef(statusSchema)}),
+			},
+		},
+	}
+
+	bound := map[string]bool{}
+	var pathErr error
+	path := pathParam.ReplaceAllStringFunc(template, func(m string) string {
+		name := pathParam.FindStringSubmatch(m)[1]
+		field := input.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			pathErr = fmt.Errorf("%s: path parameter %q is not a field of %s", method.FullName(), name, input.FullName())
+			return m
+		}
+		bound[name] = true
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   g.fieldSchema(field),
+		})
+		return "{" + name + "}"
+	})
+	if pathErr != nil {
+		return nil, "", pathErr
+	}
+
+	fields := input.Fields()
+	switch body {
+	case "":
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			if bound[string(field.Name())] || field.Kind() == protoreflect.MessageKind && !isScalarMessage(field) {
+				continue
+			}
+			op.Parameters = append(op.Parameters, Parameter{
+				Name:     string(field.Name()),
+				In:       "query",
+				Required: isRequired(field),
+				Schema:   g.fieldSchema(field),
+			})
+		}
+	case "*":
+		if len(bound) == 0 {
+			op.RequestBody = &RequestBody{Required: true, Content: jsonContent(g.messageRef(input))}
+		} else if schema := g.messageSchema(input, bound); len(schema.Properties) > 0 {
+			op.RequestBody = &RequestBody{Required: true, Content: jsonContent(schema)}
+		}
+	default:
+		field := fields.ByName(protoreflect.Name(body))
+		if field == nil {
+			return nil, "", fmt.Errorf("%s: body field %q is not a field of %s", method.FullName(), body, input.FullName())
+		}
+		op.RequestBody = &RequestBody{Required: true, Content: jsonContent(g.fieldSchema(field))}
+	}
+	return op, path, nil
+}
+
+// messageRef registers the message under components and returns a reference.
+func (g *generator) messageRef(msg protoreflect.MessageDescriptor) *Schema {
+	if schema := wellKnownSchema(msg); schema != nil {
+		return schema
+	}
+	name := schemaName(msg)
+	if _, ok := g.doc.Components.Schemas[name]; !ok {
+		// Reserve the name first so recursive messages terminate.
+		g.doc.Components.Schemas[name] = nil
+		g.doc.Components.Schemas[name] = g.messageSchema(msg, nil)
+	}
+	return &Schema{Ref: schemaRef(name)}
+}
+
+func (g *generator) messageSchema(msg protoreflect.MessageDescriptor, skip map[string]bool) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	fields := msg.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := string(field.Name())
+		if skip[name] {
+			continue
+		}
+		schema.Properties[name] = g.fieldSchema(field)
+		if isRequired(field) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+func (g *generator) fieldSchema(field protoreflect.FieldDescriptor) *Schema {
+	rules := fieldRules(field)
+
+	if field.IsMap() {
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: g.singularSchema(field.MapValue(), nil),
+		}
+	}
+	if field.IsList() {
+		schema := &Schema{Type: "array", Items: g.singularSchema(field, rules.GetRepeated().GetItems())}
+		if r := rules.GetRepeated(); r != nil {
+			schema.MinItems = r.MinItems
+			schema.MaxItems = r.MaxItems
+			schema.UniqueItems = r.GetUnique()
+		}
+		return schema
+	}
+	return g.singularSchema(field, rules)
+}
+
+func (g *generator) singularSchema(field protoreflect.FieldDescriptor, rules *validate.FieldRules) *Schema {
+	var schema *Schema
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		schema = &Schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		schema = &Schema{Type: "integer", Format: "int32"}
+		applyInt32Rules(schema, rules.GetInt32())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		schema = &Schema{Type: "integer", Format: "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson writes 64-bit integers as strings.
+		schema = &Schema{Type: "string", Format: "int64"}
+	case protoreflect.FloatKind:
+		schema = &Schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		schema = &Schema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		schema = &Schema{Type: "string"}
+		applyStringRules(schema, rules.GetString_())
+	case protoreflect.BytesKind:
+		schema = &Schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		schema = &Schema{Type: "string"}
+		values := field.Enum().Values()
+		for i := 0; i < values.Len(); i++ {
+			schema.Enum = append(schema.Enum, string(values.Get(i).Name()))
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return g.messageRef(field.Message())
+	default:
+		schema = &Schema{}
+	}
+	return schema
+}
+
+func applyStringRules(schema *Schema, rules *validate.StringRules) {
+	if rules == nil {
+		return
+	}
+	schema.MinLength = rules.MinLen
+	schema.MaxLength = rules.MaxLen
+	if rules.Len != nil {
+		schema.MinLength, schema.MaxLength = rules.Len, rules.Len
+	}
+	schema.Pattern = rules.GetPattern()
+	if schema.Pattern == "" && rules.Prefix != nil {
+		schema.Pattern = "^" + regexp.QuoteMeta(rules.GetPrefix())
+	}
+	if len(rules.GetIn()) > 0 {
+		schema.Enum = rules.GetIn()
+	}
+	if rules.Const != nil {
+		schema.Enum = []string{rules.GetConst()}
+	}
+	switch {
+	case rules.GetUuid():
+		schema.Format = "uuid"
+	case rules.GetEmail():
+		schema.Format = "email"
+	case rules.GetUri():
+		schema.Format = "uri"
+	case rules.GetUriRef():
+		schema.Format = "uri-reference"
+	case rules.GetHostname():
+		schema.Format = "hostname"
+	case rules.GetIpv4():
+		schema.Format = "ipv4"
+	case rules.GetIpv6():
+		schema.Format = "ipv6"
+	}
+}
+
+func applyInt32Rules(schema *Schema, rules *validate.Int32Rules) {
+	if rules == nil {
+		return
+	}
+	bound := func(v int32) *float64 { f := float64(v); return &f }
+	switch {
+	case rules.Gte != nil:
+		schema.Minimum = bound(rules.GetGte())
+	case rules.Gt != nil:
+		schema.Minimum, schema.ExclusiveMinimum = bound(rules.GetGt()), true
+	}
+	switch {
+	case rules.Lte != nil:
+		schema.Maximum = bound(rules.GetLte())
+	case rules.Lt != nil:
+		schema.Maximum, schema.ExclusiveMaximum = bound(rules.GetLt()), true
+	}
+}
+
+func fieldRules(field protoreflect.FieldDescriptor) *validate.FieldRules {
+	rules, _ := proto.GetExtension(field.Options(), validate.E_Rules).(*validate.FieldRules)
+	return rules
+}
+
+// isRequired reports fields the validator rejects when left empty.
+func isRequired(field protoreflect.FieldDescriptor) bool {
+	rules := fieldRules(field)
+	if rules.GetMessage().GetRequired() {
+		return true
+	}
+	s := rules.GetString_()
+	return s != nil && !s.GetIgnoreEmpty() && (s.GetMinLen() > 0 || s.GetLen() > 0 || s.GetPattern() != "" && !regexp.MustCompile(s.GetPattern()).MatchString(""))
+}
+
+func httpPattern(rule *annotations.HttpRule) (string, string) {
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, p.Get
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, p.Put
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, p.Post
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, p.Patch
+	default:
+		return "", ""
+	}
+}
+
+func wellKnownSchema(msg protoreflect.MessageDescriptor) *Schema {
+	switch msg.FullName() {
+	case "google.protobuf.Timestamp":
+		return &Schema{Type: "string", Format: "date-time"}
+	case "google.protobuf.Duration":
+		return &Schema{Type: "string", Description: `Seconds with up to nine fractional digits, followed by "s".`}
+	case "google.protobuf.Struct":
+		return &Schema{Type: "object"}
+	case "google.protobuf.Empty":
+		return &Schema{Type: "object"}
+	case "google.protobuf.StringValue":
+		return &Schema{Type: "string"}
+	case "google.protobuf.BoolValue":
+		return &Schema{Type: "boolean"}
+	case "google.protobuf.Int32Value":
+		return &Schema{Type: "integer", Format: "int32"}
+	case "google.protobuf.Int64Value":
+		return &Schema{Type: "string", Format: "int64"}
+	default:
+		return nil
+	}
+}
+
+// isScalarMessage reports message fields that the gateway accepts as a single
+// query parameter.
+func isScalarMessage(field protoreflect.FieldDescriptor) bool {
+	return wellKnownSchema(field.Message()) != nil && field.Message().FullName() != "google.protobuf.Struct"
+}
+
+func schemaName(msg protoreflect.MessageDescriptor) string {
+	pkg := string(msg.ParentFile().Package())
+	return strings.ReplaceAll(strings.TrimPrefix(string(msg.FullName()), pkg+"."), ".", "_")
+}
+
+func schemaRef(name string) string {
+	return "#/components/schemas/" + name
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
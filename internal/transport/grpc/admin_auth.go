@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/requestctx"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/connector-recruitment/proto/gen/connector/v1/connectorv1connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AdminActor is recorded in the audit log for calls authenticated with the
+// admin token.
+const AdminActor = "admin"
+
+const authorizationMetadataKey = "authorization"
+
+var adminServicePrefix = "/" + connectorv1.AdminService_ServiceDesc.ServiceName + "/"
+
+// adminProcedures are the ConnectorService methods that need the admin token
+// as well: export hands out every connector's secret, and restore writes
+// secrets the caller supplies.
+var adminProcedures = map[string]bool{
+	connectorv1connect.ConnectorServiceExportConnectorsProcedure:  true,
+	connectorv1connect.ConnectorServiceRestoreConnectorsProcedure: true,
+}
+
+func requiresAdmin(method string) bool {
+	return strings.HasPrefix(method, adminServicePrefix) || adminProcedures[method]
+}
+
+// authenticateAdmin checks the bearer token in authorization against token,
+// refusing every call when no token is configured. It returns ctx with
+// AdminActor as the caller.
+func authenticateAdmin(ctx context.Context, token, method, authorization string) (context.Context, error) {
+	if token == "" {
+		return nil, status.Error(codes.PermissionDenied, "admin access is disabled: no admin token is configured")
+	}
+	presented := strings.TrimPrefix(authorization, "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+		logger.Ctx(ctx).Warn().Str("method", method).Msg("Rejected admin call without a valid admin token")
+		return nil, status.Error(codes.Unauthenticated, "a valid admin token is required")
+	}
+	return requestctx.WithActor(ctx, AdminActor), nil
+}
+
+func adminAuthorization(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(authorizationMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// adminAuthInterceptor lets AdminService and the other admin methods through
+// only with the admin bearer token in the authorization metadata. Other
+// methods are not affected.
+func adminAuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !requiresAdmin(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticateAdmin(ctx, token, info.FullMethod, adminAuthorization(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// adminAuthStreamInterceptor is adminAuthInterceptor for streams.
+func adminAuthStreamInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !requiresAdmin(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticateAdmin(ss.Context(), token, info.FullMethod, adminAuthorization(ss.Context()))
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// connectAdminAuthInterceptor is adminAuthInterceptor for Connect, reading the
+// token from the Authorization header.
+type connectAdminAuthInterceptor struct {
+	token string
+}
+
+func (i connectAdminAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if !requiresAdmin(procedure) {
+			return next(ctx, req)
+		}
+		ctx, err := authenticateAdmin(ctx, i.token, procedure, req.Header().Get(authorizationMetadataKey))
+		if err != nil {
+			return nil, connectError(err)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (connectAdminAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i connectAdminAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		procedure := conn.Spec().Procedure
+		if !requiresAdmin(procedure) {
+			return next(ctx, conn)
+		}
+		ctx, err := authenticateAdmin(ctx, i.token, procedure, conn.RequestHeader().Get(authorizationMetadataKey))
+		if err != nil {
+			return connectError(err)
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/resilience"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AdminHandler struct {
+	connectorv1.UnimplementedAdminServiceServer
+	breakers *resilience.Registry
+	audit    *connector.AuditService
+}
+
+// NewAdminHandler records every breaker mode change in audit, which may be
+// nil. Callers are authenticated by the admin interceptors before reaching it.
+func NewAdminHandler(breakers *resilience.Registry, audit *connector.AuditService) *AdminHandler {
+	return &AdminHandler{breakers: breakers, audit: audit}
+}
+
+func (h *AdminHandler) ListCircuitBreakers(ctx context.Context, req *connectorv1.ListCircuitBreakersRequest) (*connectorv1.ListCircuitBreakersResponse, error) {
+	logger.Ctx(ctx).Info().Msg("Received ListCircuitBreakers gRPC request")
+
+	snapshots := h.breakers.Snapshots()
+	resp := &connectorv1.ListCircuitBreakersResponse{
+		CircuitBreakers: make([]*connectorv1.CircuitBreaker, 0, len(snapshots)),
+	}
+	for _, snapshot := range snapshots {
+		resp.CircuitBreakers = append(resp.CircuitBreakers, circuitBreakerToProto(snapshot))
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) SetCircuitBreakerMode(ctx context.Context, req *connectorv1.SetCircuitBreakerModeRequest) (*connectorv1.SetCircuitBreakerModeResponse, error) {
+	logger.Ctx(ctx).Info().
+		Str("breaker", req.Name).
+		Str("mode", req.Mode.String()).
+		Msg("Received SetCircuitBreakerMode gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("SetCircuitBreakerMode request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+	if req.Mode == connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_UNSPECIFIED {
+		return nil, connector.GRPCError(fmt.Errorf("mode is required: %w", connector.ErrInvalidInput))
+	}
+
+	mode := circuitBreakerModeFromProto(req.Mode)
+	snapshot, err := h.breakers.SetMode(req.Name, mode)
+	h.audit.RecordCircuitBreakerMode(ctx, req.Name, mode.String(), err)
+	if errors.Is(err, resilience.ErrUnknownBreaker) {
+		return nil, connector.GRPCError(fmt.Errorf("circuit breaker %q: %w", req.Name, connector.ErrNotFound))
+	}
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+	logger.Ctx(ctx).Warn().
+		Str("breaker", snapshot.Name).
+		Str("mode", snapshot.Mode.String()).
+		Str("state", snapshot.State.String()).
+		Msg("Circuit breaker mode changed by operator")
+
+	return &connectorv1.SetCircuitBreakerModeResponse{
+		CircuitBreaker: circuitBreakerToProto(snapshot),
+	}, nil
+}
+
+func circuitBreakerToProto(snapshot resilience.Snapshot) *connectorv1.CircuitBreaker {
+	return &connectorv1.CircuitBreaker{
+		Name:                snapshot.Name,
+		State:               circuitBreakerStateToProto(snapshot.State),
+		Mode:                circuitBreakerModeToProto(snapshot.Mode),
+		Requests:            int32(snapshot.Counts.Requests),
+		Failures:            int32(snapshot.Counts.Failures),
+		SlowCalls:           int32(snapshot.Counts.SlowCalls),
+		ConsecutiveFailures: int32(snapshot.Counts.ConsecutiveFailures),
+		Since:               timestamppb.New(snapshot.Since),
+	}
+}
+
+func circuitBreakerStateToProto(state resilience.State) connectorv1.CircuitBreakerState {
+	switch state {
+	case resilience.StateClosed:
+		return connectorv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED
+	case resilience.StateHalfOpen:
+		return connectorv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN
+	case resilience.StateOpen:
+		return connectorv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN
+	default:
+		return connectorv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED
+	}
+}
+
+func circuitBreakerModeToProto(mode resilience.Mode) connectorv1.CircuitBreakerMode {
+	switch mode {
+	case resilience.ModeAuto:
+		return connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_AUTO
+	case resilience.ModeForcedOpen:
+		return connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_FORCED_OPEN
+	case resilience.ModeForcedClosed:
+		return connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_FORCED_CLOSED
+	default:
+		return connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_UNSPECIFIED
+	}
+}
+
+func circuitBreakerModeFromProto(mode connectorv1.CircuitBreakerMode) resilience.Mode {
+	switch mode {
+	case connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_FORCED_OPEN:
+		return resilience.ModeForcedOpen
+	case connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_FORCED_CLOSED:
+		return resilience.ModeForcedClosed
+	default:
+		return resilience.ModeAuto
+	}
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditHandler struct {
+	connectorv1.UnimplementedAuditServiceServer
+	service *connector.AuditService
+}
+
+func NewAuditHandler(svc *connector.AuditService) *AuditHandler {
+	return &AuditHandler{service: svc}
+}
+
+func (h *AuditHandler) ListAuditEvents(ctx context.Context, req *connectorv1.ListAuditEventsRequest) (*connectorv1.ListAuditEventsResponse, error) {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.TenantId).Msg("Received ListAuditEvents gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("ListAuditEvents request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	afterID, err := decodeAuditPageToken(req.PageToken)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	filter := domain.AuditFilter{
+		TenantID: req.TenantId,
+		Actor:    req.Actor,
+		Action:   domain.AuditAction(req.Action),
+		TargetID: req.TargetId,
+	}
+	if req.StartTime != nil {
+		filter.Since = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		filter.Until = req.EndTime.AsTime()
+	}
+
+	events, next, err := h.service.ListAuditEvents(ctx, filter, int(req.PageSize), afterID)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	resp := &connectorv1.ListAuditEventsResponse{
+		Events: make([]*connectorv1.AuditEvent, 0, len(events)),
+	}
+	for i := range events {
+		resp.Events = append(resp.Events, auditEventToProto(&events[i]))
+	}
+	if next != 0 {
+		resp.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(next, 10)))
+	}
+	return resp, nil
+}
+
+func decodeAuditPageToken(token string) (int64, error) {
+	raw, err := decodePageToken(token)
+	if err != nil || raw == "" {
+		return 0, err
+	}
+	afterID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || afterID < 0 {
+		return 0, fmt.Errorf("invalid page token: %w", connector.ErrInvalidInput)
+	}
+	return afterID, nil
+}
+
+func auditEventToProto(event *domain.AuditEvent) *connectorv1.AuditEvent {
+	return &connectorv1.AuditEvent{
+		Id:         event.ID,
+		OccurredAt: timestamppb.New(event.OccurredAt),
+		Actor:      event.Actor,
+		TenantId:   event.TenantID,
+		Action:     string(event.Action),
+		TargetType: event.TargetType,
+		TargetId:   event.TargetID,
+		Outcome:    auditOutcomeToProto(event.Outcome),
+		RequestId:  event.RequestID,
+		TraceId:    event.TraceID,
+		Diff:       string(event.Diff),
+		PrevHash:   event.PrevHash,
+		Hash:       event.Hash,
+	}
+}
+
+func auditOutcomeToProto(outcome domain.AuditOutcome) connectorv1.AuditOutcome {
+	switch outcome {
+	case domain.AuditOutcomeSuccess:
+		return connectorv1.AuditOutcome_AUDIT_OUTCOME_SUCCESS
+	case domain.AuditOutcomeFailure:
+		return connectorv1.AuditOutcome_AUDIT_OUTCOME_FAILURE
+	default:
+		return connectorv1.AuditOutcome_AUDIT_OUTCOME_UNSPECIFIED
+	}
+}
@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/seal"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errMissingExportHeader    = errors.New("invalid RestoreConnectorsRequest: the first record must be the export header")
+	errMissingExportConnector = errors.New("invalid RestoreConnectorsRequest.Record: a connector record is required")
+)
+
+func (h *Handler) ExportConnectors(req *connectorv1.ExportConnectorsRequest, stream grpc.ServerStreamingServer[connectorv1.ExportRecord]) error {
+	return h.exportConnectors(stream.Context(), req, stream.Send)
+}
+
+// exportConnectors serves ExportConnectors for both the gRPC and the Connect
+// transports.
+func (h *Handler) exportConnectors(ctx context.Context, req *connectorv1.ExportConnectorsRequest, send func(*connectorv1.ExportRecord) error) error {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.TenantId).Msg("Received ExportConnectors gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("ExportConnectors request validation failed")
+		return connector.GRPCError(err)
+	}
+	var recipient *rsa.PublicKey
+	header := &connectorv1.ExportHeader{
+		FormatVersion: connector.ExportFormatVersion,
+		ExportedAt:    timestamppb.Now(),
+		TenantId:      req.TenantId,
+	}
+	if req.PublicKeyPem != "" {
+		key, err := seal.ParsePublicKeyPEM([]byte(req.PublicKeyPem))
+		if err != nil {
+			return connector.GRPCError(fmt.Errorf("%w: %v", connector.ErrInvalidInput, err))
+		}
+		recipient = key
+		header.KeyFingerprint = seal.Fingerprint(key)
+	}
+
+	if err := send(&connectorv1.ExportRecord{Record: &connectorv1.ExportRecord_Header{Header: header}}); err != nil {
+		return err
+	}
+	err := h.service.ExportConnectors(ctx, req.TenantId, recipient, func(item connector.ExportedConnector) error {
+		return send(exportedConnectorToProto(item))
+	})
+	if err == nil {
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		// Send errors already carry a status.
+		return err
+	}
+	logger.Ctx(ctx).Error().Err(err).Msg("ExportConnectors failed")
+	return connector.GRPCError(err)
+}
+
+func (h *Handler) RestoreConnectors(stream grpc.ClientStreamingServer[connectorv1.RestoreConnectorsRequest, connectorv1.RestoreConnectorsResponse]) error {
+	res, err := h.restoreConnectors(stream.Context(), stream.Recv)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
+
+// restoreConnectors serves RestoreConnectors for both the gRPC and the Connect
+// transports. recv returns io.EOF once the client has sent every record.
+func (h *Handler) restoreConnectors(ctx context.Context, recv func() (*connectorv1.RestoreConnectorsRequest, error)) (*connectorv1.RestoreConnectorsResponse, error) {
+	logger.Ctx(ctx).Info().Msg("Received RestoreConnectors gRPC request")
+
+	first, err := recv()
+	if err != nil {
+		return nil, restoreStreamError(ctx, err)
+	}
+	header := first.GetRecord().GetHeader()
+	if header == nil {
+		return nil, connector.GRPCError(fmt.Errorf("%w: %v", connector.ErrInvalidInput, errMissingExportHeader))
+	}
+
+	report, err := h.service.RestoreConnectors(ctx, int(header.FormatVersion), func() (connector.RestoreItem, error) {
+		req, err := recv()
+		if err != nil {
+			return connector.RestoreItem{}, err
+		}
+		return restoreItemFromProto(req), nil
+	})
+	if err != nil {
+		return nil, restoreStreamError(ctx, err)
+	}
+
+	res := &connectorv1.RestoreConnectorsResponse{
+		Results: make([]*connectorv1.ImportConnectorsResult, 0, len(report.Results)),
+		Summary: &connectorv1.ImportConnectorsSummary{
+			Total:         int32(report.Summary.Total),
+			Created:       int32(report.Summary.Created),
+			AlreadyExists: int32(report.Summary.AlreadyExists),
+			Duplicates:    int32(report.Summary.Duplicates),
+			Invalid:       int32(report.Summary.Invalid),
+			Failed:        int32(report.Summary.Failed),
+		},
+	}
+	for _, result := range report.Results {
+		res.Results = append(res.Results, importResultToProto(result))
+	}
+	return res, nil
+}
+
+func restoreStreamError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		// Receive errors already carry a status.
+		return err
+	}
+	return connector.GRPCError(err)
+}
+
+// restoreItemFromProto reads a connector written by any supported export
+// format version; version 1 is the only one so far.
+func restoreItemFromProto(req *connectorv1.RestoreConnectorsRequest) connector.RestoreItem {
+	exported := req.GetRecord().GetConnector()
+	if exported == nil {
+		return connector.RestoreItem{Invalid: errMissingExportConnector}
+	}
+	item := connector.RestoreItem{
+		Connector: domain.Connector{
+			WorkspaceID:      exported.WorkspaceId,
+			TenantID:         exported.TenantId,
+			DefaultChannelID: exported.DefaultChannelId,
+			CreatedAt:        exported.CreatedAt.AsTime().UTC(),
+			UpdatedAt:        exported.UpdatedAt.AsTime().UTC(),
+			Status:           connectorStatusFromProto(exported.Status),
+		},
+		Token: req.Token,
+	}
+	if err := exported.Validate(); err != nil {
+		item.Invalid = err
+		return item
+	}
+	item.Connector.ID = uuid.MustParse(exported.Id)
+	return item
+}
+
+func exportedConnectorToProto(item connector.ExportedConnector) *connectorv1.ExportRecord {
+	conn := item.Connector
+	exported := &connectorv1.ExportedConnector{
+		Id:               conn.ID.String(),
+		WorkspaceId:      conn.WorkspaceID,
+		TenantId:         conn.TenantID,
+		DefaultChannelId: conn.DefaultChannelID,
+		Status:           connectorStatusToProto(conn.Status),
+		CreatedAt:        timestamppb.New(conn.CreatedAt),
+		UpdatedAt:        timestamppb.New(conn.UpdatedAt),
+	}
+	if item.Secret != nil {
+		exported.Secret = &connectorv1.SealedSecret{
+			WrappedKey: item.Secret.WrappedKey,
+			Nonce:      item.Secret.Nonce,
+			Ciphertext: item.Secret.Ciphertext,
+		}
+	}
+	return &connectorv1.ExportRecord{Record: &connectorv1.ExportRecord_Connector{Connector: exported}}
+}
+
+func connectorStatusFromProto(s connectorv1.ConnectorStatus) domain.ConnectorStatus {
+	if s == connectorv1.ConnectorStatus_CONNECTOR_STATUS_ERRORED {
+		return domain.ConnectorStatusErrored
+	}
+	return domain.ConnectorStatusActive
+}
@@ -0,0 +1,261 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/connector-recruitment/pkg/observability"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/connector-recruitment/proto/gen/connector/v1/connectorv1connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewConnectHandler serves h over the Connect, gRPC and gRPC-Web protocols on
+// a plain net/http server, so browsers can call ConnectorService without a
+// proxy. Calls go through the same rate limit, request context, validation and
+// business logic as the native gRPC server. It returns the path to mount the
+// handler on.
+func NewConnectHandler(h *Handler) (string, http.Handler) {
+	path, handler := connectorv1connect.NewConnectorServiceHandler(&connectAdapter{handler: h},
+		connect.WithInterceptors(
+			connectMetricsInterceptor{metrics: h.metrics},
+			connectRateLimitInterceptor{metrics: h.metrics},
+			connectRequestContextInterceptor{},
+			connectAdminAuthInterceptor{token: h.adminToken},
+		),
+	)
+	return path, withoutWriteDeadline(handler)
+}
+
+// serverStreamingProcedures send for as long as the client listens, well past
+// the HTTP server's WriteTimeout.
+var serverStreamingProcedures = map[string]bool{
+	connectorv1connect.ConnectorServiceWatchConnectorsProcedure:  true,
+	connectorv1connect.ConnectorServiceExportConnectorsProcedure: true,
+}
+
+// withoutWriteDeadline lifts the server's write deadline for server-streaming
+// procedures, which would otherwise cut every stream off at WriteTimeout.
+// Unary calls keep it.
+func withoutWriteDeadline(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serverStreamingProcedures[r.URL.Path] {
+			_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// connectAdapter exposes Handler through the Connect handler interface.
+type connectAdapter struct {
+	handler *Handler
+}
+
+func (a *connectAdapter) CreateConnector(ctx context.Context, req *connect.Request[connectorv1.CreateConnectorRequest]) (*connect.Response[connectorv1.CreateConnectorResponse], error) {
+	return callUnary(ctx, req, a.handler.CreateConnector)
+}
+
+func (a *connectAdapter) GetConnector(ctx context.Context, req *connect.Request[connectorv1.GetConnectorRequest]) (*connect.Response[connectorv1.GetConnectorResponse], error) {
+	return callUnary(ctx, req, a.handler.GetConnector)
+}
+
+func (a *connectAdapter) DeleteConnector(ctx context.Context, req *connect.Request[connectorv1.DeleteConnectorRequest]) (*connect.Response[connectorv1.DeleteConnectorResponse], error) {
+	return callUnary(ctx, req, a.handler.DeleteConnector)
+}
+
+func (a *connectAdapter) RestoreConnector(ctx context.Context, req *connect.Request[connectorv1.RestoreConnectorRequest]) (*connect.Response[connectorv1.RestoreConnectorResponse], error) {
+	return callUnary(ctx, req, a.handler.RestoreConnector)
+}
+
+func (a *connectAdapter) GetOAuthV2URL(ctx context.Context, req *connect.Request[connectorv1.GetOAuthV2URLRequest]) (*connect.Response[connectorv1.GetOAuthV2URLResponse], error) {
+	return callUnary(ctx, req, a.handler.GetOAuthV2URL)
+}
+
+func (a *connectAdapter) ExchangeOAuthCode(ctx context.Context, req *connect.Request[connectorv1.ExchangeOAuthCodeRequest]) (*connect.Response[connectorv1.ExchangeOAuthCodeResponse], error) {
+	return callUnary(ctx, req, a.handler.ExchangeOAuthCode)
+}
+
+func (a *connectAdapter) WatchConnectors(ctx context.Context, req *connect.Request[connectorv1.WatchConnectorsRequest], stream *connect.ServerStream[connectorv1.WatchConnectorsResponse]) error {
+	if err := a.handler.watchConnectors(ctx, req.Msg, stream.Send); err != nil {
+		return connectError(err)
+	}
+	return nil
+}
+
+func (a *connectAdapter) ImportConnectors(ctx context.Context, stream *connect.ClientStream[connectorv1.ImportConnectorsRequest]) (*connect.Response[connectorv1.ImportConnectorsResponse], error) {
+	res, err := a.handler.importConnectors(ctx, receiveAll(stream))
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (a *connectAdapter) ExportConnectors(ctx context.Context, req *connect.Request[connectorv1.ExportConnectorsRequest], stream *connect.ServerStream[connectorv1.ExportRecord]) error {
+	if err := a.handler.exportConnectors(ctx, req.Msg, stream.Send); err != nil {
+		return connectError(err)
+	}
+	return nil
+}
+
+func (a *connectAdapter) RestoreConnectors(ctx context.Context, stream *connect.ClientStream[connectorv1.RestoreConnectorsRequest]) (*connect.Response[connectorv1.RestoreConnectorsResponse], error) {
+	res, err := a.handler.restoreConnectors(ctx, receiveAll(stream))
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// receiveAll adapts a Connect client stream to the recv function the handlers
+// share with gRPC, returning io.EOF once the client has sent everything.
+func receiveAll[Req any](stream *connect.ClientStream[Req]) func() (*Req, error) {
+	return func() (*Req, error) {
+		if stream.Receive() {
+			return stream.Msg(), nil
+		}
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+}
+
+func callUnary[Req, Res any](ctx context.Context, req *connect.Request[Req], call func(context.Context, *Req) (*Res, error)) (*connect.Response[Res], error) {
+	res, err := call(ctx, req.Msg)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// connectError converts the gRPC status returned by Handler, keeping its
+// details, so Connect clients see the same code and error details.
+func connectError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return connect.NewError(connect.CodeUnknown, err)
+	}
+
+	connectErr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, detail := range st.Proto().GetDetails() {
+		msg, unmarshalErr := detail.UnmarshalNew()
+		if unmarshalErr != nil {
+			continue
+		}
+		if errDetail, detailErr := connect.NewErrorDetail(msg); detailErr == nil {
+			connectErr.AddDetail(errDetail)
+		}
+	}
+	return connectErr
+}
+
+// connectMetricsInterceptor records Connect calls under the same metrics and
+// method names as the gRPC server.
+type connectMetricsInterceptor struct {
+	metrics *observability.Metrics
+}
+
+func (i connectMetricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		res, err := next(ctx, req)
+		i.metrics.ObserveRPC(req.Spec().Procedure, connectCode(err), time.Since(start))
+		return res, err
+	}
+}
+
+func (connectMetricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i connectMetricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		i.metrics.ObserveRPC(conn.Spec().Procedure, connectCode(err), time.Since(start))
+		return err
+	}
+}
+
+// connectCode maps a Connect error to the gRPC code it shares a number with.
+func connectCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return codes.Code(connect.CodeOf(err))
+}
+
+// connectRateLimitInterceptor shares the gRPC server's limiter, counting each
+// stream once when it opens.
+type connectRateLimitInterceptor struct {
+	metrics *observability.Metrics
+}
+
+func (i connectRateLimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := waitForRateLimit(ctx, i.metrics); err != nil {
+			return nil, connect.NewError(connect.CodeResourceExhausted, err)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (connectRateLimitInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i connectRateLimitInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := waitForRateLimit(ctx, i.metrics); err != nil {
+			return connect.NewError(connect.CodeResourceExhausted, err)
+		}
+		return next(ctx, conn)
+	}
+}
+
+// connectRequestContextInterceptor is requestContextInterceptor for Connect,
+// reading the caller and request ID from HTTP headers.
+type connectRequestContextInterceptor struct{}
+
+func (connectRequestContextInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, requestID := withRequestContext(ctx,
+			req.Header().Get(actorMetadataKey), req.Header().Get(requestIDMetadataKey))
+
+		res, err := next(ctx, req)
+		if err != nil {
+			// res holds a typed nil here, so only the error carries the ID.
+			setConnectErrorRequestID(err, requestID)
+			return nil, err
+		}
+		res.Header().Set(requestIDMetadataKey, requestID)
+		return res, nil
+	}
+}
+
+func (connectRequestContextInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (connectRequestContextInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, requestID := withRequestContext(ctx,
+			conn.RequestHeader().Get(actorMetadataKey), conn.RequestHeader().Get(requestIDMetadataKey))
+		conn.ResponseHeader().Set(requestIDMetadataKey, requestID)
+
+		err := next(ctx, conn)
+		setConnectErrorRequestID(err, requestID)
+		return err
+	}
+}
+
+func setConnectErrorRequestID(err error, requestID string) {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		connectErr.Meta().Set(requestIDMetadataKey, requestID)
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	"github.com/connector-recruitment/internal/app/connector"
+	appHealth "github.com/connector-recruitment/internal/app/health"
 	"github.com/connector-recruitment/internal/domain"
 	"github.com/connector-recruitment/pkg/logger"
+	"github.com/connector-recruitment/pkg/observability"
+	"github.com/connector-recruitment/pkg/resilience"
 	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -14,18 +17,70 @@ import (
 
 type Handler struct {
 	connectorv1.UnimplementedConnectorServiceServer
-	service *connector.Service
+	service    *connector.Service
+	watcher    *connector.ConnectorWatcher
+	importer   *connector.Importer
+	metrics    *observability.Metrics
+	health     *appHealth.Monitor
+	breakers   *resilience.Registry
+	adminToken string
 }
 
-func NewHandler(svc *connector.Service) *Handler {
-	return &Handler{service: svc}
+type HandlerOption func(*Handler)
+
+// WithWatcher serves WatchConnectors from watcher; without it the RPC returns
+// UNIMPLEMENTED.
+func WithWatcher(watcher *connector.ConnectorWatcher) HandlerOption {
+	return func(h *Handler) {
+		h.watcher = watcher
+	}
+}
+
+// WithImporter replaces the default importer behind ImportConnectors.
+func WithImporter(importer *connector.Importer) HandlerOption {
+	return func(h *Handler) {
+		h.importer = importer
+	}
+}
+
+// WithMetrics records every RPC and rate limiter wait, on both the gRPC
+// server and the Connect handler.
+func WithMetrics(metrics *observability.Metrics) HandlerOption {
+	return func(h *Handler) {
+		h.metrics = metrics
+	}
+}
+
+// WithCircuitBreakers serves AdminService to inspect and override breakers;
+// without it the service is not registered.
+func WithCircuitBreakers(breakers *resilience.Registry) HandlerOption {
+	return func(h *Handler) {
+		h.breakers = breakers
+	}
+}
+
+// WithAdminToken sets the bearer token callers of AdminService, and of
+// ExportConnectors and RestoreConnectors, must present. Without it those
+// methods refuse every call.
+func WithAdminToken(token string) HandlerOption {
+	return func(h *Handler) {
+		h.adminToken = token
+	}
+}
+
+func NewHandler(svc *connector.Service, opts ...HandlerOption) *Handler {
+	h := &Handler{service: svc, importer: connector.NewImporter(svc)}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 func (h *Handler) CreateConnector(ctx context.Context, req *connectorv1.CreateConnectorRequest) (*connectorv1.CreateConnectorResponse, error) {
-	logger.Info().Msg("Received CreateConnector gRPC request")
+	logger.Ctx(ctx).Info().Msg("Received CreateConnector gRPC request")
 
 	if err := req.Validate(); err != nil {
-		logger.Warn().Err(err).Msg("CreateConnector request validation failed")
+		logger.Ctx(ctx).Warn().Err(err).Msg("CreateConnector request validation failed")
 		return nil, connector.GRPCError(err)
 	}
 	input := connector.CreateInput{
@@ -33,6 +88,7 @@ func (h *Handler) CreateConnector(ctx context.Context, req *connectorv1.CreateCo
 		TenantID:       req.TenantId,
 		Token:          req.Token,
 		DefaultChannel: req.DefaultChannelName,
+		IdempotencyKey: req.IdempotencyKey,
 	}
 	conn, err := h.service.CreateConnector(ctx, input)
 	if err != nil {
@@ -45,10 +101,10 @@ func (h *Handler) CreateConnector(ctx context.Context, req *connectorv1.CreateCo
 }
 
 func (h *Handler) GetConnector(ctx context.Context, req *connectorv1.GetConnectorRequest) (*connectorv1.GetConnectorResponse, error) {
-	logger.Info().Str("connector_id", req.Id).Msg("Received GetConnector gRPC request")
+	logger.Ctx(ctx).Info().Str("connector_id", req.Id).Msg("Received GetConnector gRPC request")
 
 	if err := req.Validate(); err != nil {
-		logger.Warn().Err(err).Msg("GetConnector request validation failed")
+		logger.Ctx(ctx).Warn().Err(err).Msg("GetConnector request validation failed")
 		return nil, connector.GRPCError(err)
 	}
 
@@ -66,10 +122,10 @@ func (h *Handler) GetConnector(ctx context.Context, req *connectorv1.GetConnecto
 }
 
 func (h *Handler) DeleteConnector(ctx context.Context, req *connectorv1.DeleteConnectorRequest) (*connectorv1.DeleteConnectorResponse, error) {
-	logger.Info().Str("connector_id", req.Id).Msg("Received DeleteConnector gRPC request")
+	logger.Ctx(ctx).Info().Str("connector_id", req.Id).Msg("Received DeleteConnector gRPC request")
 
 	if err := req.Validate(); err != nil {
-		logger.Warn().Err(err).Msg("DeleteConnector request validation failed")
+		logger.Ctx(ctx).Warn().Err(err).Msg("DeleteConnector request validation failed")
 		return nil, connector.GRPCError(err)
 	}
 
@@ -86,11 +142,33 @@ func (h *Handler) DeleteConnector(ctx context.Context, req *connectorv1.DeleteCo
 	}, nil
 }
 
+func (h *Handler) RestoreConnector(ctx context.Context, req *connectorv1.RestoreConnectorRequest) (*connectorv1.RestoreConnectorResponse, error) {
+	logger.Ctx(ctx).Info().Str("connector_id", req.Id).Msg("Received RestoreConnector gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("RestoreConnector request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, connector.GRPCError(fmt.Errorf("invalid connector ID: %w", err))
+	}
+	conn, err := h.service.RestoreConnector(ctx, id)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.RestoreConnectorResponse{
+		Connector: domainToProto(conn),
+	}, nil
+}
+
 func (h *Handler) GetOAuthV2URL(ctx context.Context, req *connectorv1.GetOAuthV2URLRequest) (*connectorv1.GetOAuthV2URLResponse, error) {
-	logger.Info().Msg("Received GetOAuthV2URL gRPC request")
+	logger.Ctx(ctx).Info().Msg("Received GetOAuthV2URL gRPC request")
 
 	if err := req.Validate(); err != nil {
-		logger.Warn().Err(err).Msg("GetOAuthV2URL request validation failed")
+		logger.Ctx(ctx).Warn().Err(err).Msg("GetOAuthV2URL request validation failed")
 		return nil, connector.GRPCError(err)
 	}
 
@@ -105,10 +183,10 @@ func (h *Handler) GetOAuthV2URL(ctx context.Context, req *connectorv1.GetOAuthV2
 }
 
 func (h *Handler) ExchangeOAuthCode(ctx context.Context, req *connectorv1.ExchangeOAuthCodeRequest) (*connectorv1.ExchangeOAuthCodeResponse, error) {
-	logger.Info().Msg("Received ExchangeOAuthCode gRPC request")
+	logger.Ctx(ctx).Info().Msg("Received ExchangeOAuthCode gRPC request")
 
 	if err := req.Validate(); err != nil {
-		logger.Warn().Err(err).Msg("ExchangeOAuthCode request validation failed")
+		logger.Ctx(ctx).Warn().Err(err).Msg("ExchangeOAuthCode request validation failed")
 		return nil, connector.GRPCError(err)
 	}
 
@@ -131,5 +209,17 @@ func domainToProto(conn *domain.Connector) *connectorv1.Connector {
 		CreatedAt:        timestamppb.New(conn.CreatedAt),
 		UpdatedAt:        timestamppb.New(conn.UpdatedAt),
 		SecretVersion:    conn.SecretVersion,
+		Status:           connectorStatusToProto(conn.Status),
+	}
+}
+
+func connectorStatusToProto(status domain.ConnectorStatus) connectorv1.ConnectorStatus {
+	switch status {
+	case domain.ConnectorStatusActive:
+		return connectorv1.ConnectorStatus_CONNECTOR_STATUS_ACTIVE
+	case domain.ConnectorStatusErrored:
+		return connectorv1.ConnectorStatus_CONNECTOR_STATUS_ERRORED
+	default:
+		return connectorv1.ConnectorStatus_CONNECTOR_STATUS_UNSPECIFIED
 	}
 }
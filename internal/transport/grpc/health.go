@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	appHealth "github.com/connector-recruitment/internal/app/health"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// WithHealth drives the gRPC health service from monitor; without it the
+// server reports SERVING unconditionally.
+func WithHealth(monitor *appHealth.Monitor) HandlerOption {
+	return func(h *Handler) {
+		h.health = monitor
+	}
+}
+
+// serviceDependencies lists the checks each service cannot work without.
+// The overall status, for the empty service name, follows readiness.
+var serviceDependencies = map[string][]string{
+	connectorv1.ConnectorService_ServiceDesc.ServiceName: {
+		appHealth.CheckPostgres, appHealth.CheckRedis, appHealth.CheckSecretsManager,
+	},
+	connectorv1.TenantService_ServiceDesc.ServiceName:  {appHealth.CheckPostgres},
+	connectorv1.AuditService_ServiceDesc.ServiceName:   {appHealth.CheckPostgres},
+	connectorv1.WebhookService_ServiceDesc.ServiceName: {appHealth.CheckPostgres},
+}
+
+// reportHealth keeps the status of every service in line with the monitor.
+// Once it drains, every service stays NOT_SERVING.
+func reportHealth(server *health.Server, monitor *appHealth.Monitor) {
+	monitor.Subscribe(func(report appHealth.Report) {
+		if report.Status == appHealth.StatusDraining {
+			server.Shutdown()
+			return
+		}
+		server.SetServingStatus("", servingStatus(report.Ready()))
+		for service, checks := range serviceDependencies {
+			server.SetServingStatus(service, servingStatus(report.Up(checks...)))
+		}
+	})
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/pkg/logger"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var errMissingConnector = errors.New("invalid ImportConnectorsRequest.Connector: value is required")
+
+func (h *Handler) ImportConnectors(stream grpc.ClientStreamingServer[connectorv1.ImportConnectorsRequest, connectorv1.ImportConnectorsResponse]) error {
+	res, err := h.importConnectors(stream.Context(), stream.Recv)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
+
+// importConnectors serves ImportConnectors for both the gRPC and the Connect
+// transports. recv returns io.EOF once the client has sent every item.
+func (h *Handler) importConnectors(ctx context.Context, recv func() (*connectorv1.ImportConnectorsRequest, error)) (*connectorv1.ImportConnectorsResponse, error) {
+	logger.Ctx(ctx).Info().Msg("Received ImportConnectors gRPC request")
+
+	report, err := h.importer.Import(ctx, func() (connector.ImportItem, error) {
+		req, err := recv()
+		if err != nil {
+			return connector.ImportItem{}, err
+		}
+		return importItemFromProto(req), nil
+	})
+	res := importReportToProto(report)
+	if err != nil {
+		return nil, importError(ctx, err, res)
+	}
+	return res, nil
+}
+
+// importError converts an import stopped part way, attaching the report of
+// the items read before it stopped as an error detail.
+func importError(ctx context.Context, err error, partial *connectorv1.ImportConnectorsResponse) error {
+	var st *status.Status
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		st = status.FromContextError(ctxErr)
+	} else if received, ok := status.FromError(err); ok {
+		// Receive errors already carry a status.
+		st = received
+	} else {
+		st = status.Convert(connector.GRPCError(err))
+	}
+	if withReport, detailErr := st.WithDetails(partial); detailErr == nil {
+		st = withReport
+	}
+	return st.Err()
+}
+
+func importReportToProto(report *connector.ImportReport) *connectorv1.ImportConnectorsResponse {
+	res := &connectorv1.ImportConnectorsResponse{
+		Results: make([]*connectorv1.ImportConnectorsResult, 0, len(report.Results)),
+		Summary: &connectorv1.ImportConnectorsSummary{
+			Total:         int32(report.Summary.Total),
+			Created:       int32(report.Summary.Created),
+			AlreadyExists: int32(report.Summary.AlreadyExists),
+			Duplicates:    int32(report.Summary.Duplicates),
+			Invalid:       int32(report.Summary.Invalid),
+			Failed:        int32(report.Summary.Failed),
+			Truncated:     report.Summary.Truncated,
+		},
+	}
+	for _, result := range report.Results {
+		res.Results = append(res.Results, importResultToProto(result))
+	}
+	return res
+}
+
+func importItemFromProto(req *connectorv1.ImportConnectorsRequest) connector.ImportItem {
+	create := req.GetConnector()
+	if create == nil {
+		return connector.ImportItem{Invalid: errMissingConnector}
+	}
+	item := connector.ImportItem{
+		Input: connector.CreateInput{
+			WorkspaceID:    create.WorkspaceId,
+			TenantID:       create.TenantId,
+			Token:          create.Token,
+			DefaultChannel: create.DefaultChannelName,
+			IdempotencyKey: create.IdempotencyKey,
+		},
+	}
+	if err := create.Validate(); err != nil {
+		item.Invalid = err
+	}
+	return item
+}
+
+func importResultToProto(result connector.ImportResult) *connectorv1.ImportConnectorsResult {
+	res := &connectorv1.ImportConnectorsResult{
+		Index:       int32(result.Index),
+		WorkspaceId: result.WorkspaceID,
+		TenantId:    result.TenantID,
+		Status:      importStatusToProto(result.Status),
+	}
+	if result.ConnectorID != uuid.Nil {
+		res.ConnectorId = result.ConnectorID.String()
+	}
+	if result.Err != nil {
+		res.Error = result.Err.Error()
+		if result.Status == connector.ImportStatusFailed {
+			// Same message a single CreateConnector would have returned.
+			res.Error = status.Convert(connector.GRPCError(result.Err)).Message()
+		}
+	}
+	return res
+}
+
+func importStatusToProto(s connector.ImportStatus) connectorv1.ImportStatus {
+	switch s {
+	case connector.ImportStatusCreated:
+		return connectorv1.ImportStatus_IMPORT_STATUS_CREATED
+	case connector.ImportStatusAlreadyExists:
+		return connectorv1.ImportStatus_IMPORT_STATUS_ALREADY_EXISTS
+	case connector.ImportStatusDuplicate:
+		return connectorv1.ImportStatus_IMPORT_STATUS_DUPLICATE
+	case connector.ImportStatusInvalid:
+		return connectorv1.ImportStatus_IMPORT_STATUS_INVALID
+	case connector.ImportStatusFailed:
+		return connectorv1.ImportStatus_IMPORT_STATUS_FAILED
+	default:
+		return connectorv1.ImportStatus_IMPORT_STATUS_UNSPECIFIED
+	}
+}
@@ -3,46 +3,172 @@ package grpc
 import (
 	"context"
 	"net"
+	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
 	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/pkg/observability"
+	"github.com/connector-recruitment/pkg/requestctx"
 	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/google/uuid"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 )
 
 var limiter = rate.NewLimiter(rate.Limit(10), 10)
 
-func rateLimitingInterceptor(
+// SetRateLimit changes the limit shared by every gRPC and Connect request.
+// It is safe to call while the server is running.
+func SetRateLimit(rps float64, burst int) {
+	limiter.SetLimit(rate.Limit(rps))
+	limiter.SetBurst(burst)
+}
+
+// rateLimiterName labels the shared RPC limiter in the wait metrics.
+const rateLimiterName = "rpc"
+
+func waitForRateLimit(ctx context.Context, metrics *observability.Metrics) error {
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	metrics.ObserveRateLimitWait(rateLimiterName, time.Since(start))
+	return err
+}
+
+func rateLimitingInterceptor(metrics *observability.Metrics) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := waitForRateLimit(ctx, metrics); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitingStreamInterceptor counts each stream once, when it opens.
+func rateLimitingStreamInterceptor(metrics *observability.Metrics) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := waitForRateLimit(ss.Context(), metrics); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+const (
+	actorMetadataKey     = "x-actor"
+	requestIDMetadataKey = "x-request-id"
+)
+
+// requestContextInterceptor records the caller and a request ID on the context
+// for the audit log. The request ID is taken from the caller when supplied and
+// echoed back in the response headers.
+func requestContextInterceptor(
 	ctx context.Context,
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
-	if err := limiter.Wait(ctx); err != nil {
-		return nil, err
-	}
+	ctx, requestID := requestContextFromMetadata(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, requestID))
+
 	return handler(ctx, req)
 }
 
-func NewServer(svc *connector.Service, grpcPort string) (*grpc.Server, net.Listener, error) {
+// requestContextStreamInterceptor is requestContextInterceptor for streams.
+func requestContextStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, requestID := requestContextFromMetadata(ss.Context())
+	_ = ss.SetHeader(metadata.Pairs(requestIDMetadataKey, requestID))
+
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func requestContextFromMetadata(ctx context.Context) (context.Context, string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var actor, requestID string
+	if actors := md.Get(actorMetadataKey); len(actors) > 0 {
+		actor = actors[0]
+	}
+	if ids := md.Get(requestIDMetadataKey); len(ids) > 0 {
+		requestID = ids[0]
+	}
+	return withRequestContext(ctx, actor, requestID)
+}
+
+// withRequestContext stores the caller and request ID on ctx, generating the
+// request ID when the caller did not send one. Nothing authenticates the
+// x-actor header, so the actor is recorded as asserted by the caller.
+func withRequestContext(ctx context.Context, actor, requestID string) (context.Context, string) {
+	if actor != "" {
+		ctx = requestctx.WithActor(ctx, requestctx.AssertedActor(actor))
+	}
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	return requestctx.WithRequestID(ctx, requestID), requestID
+}
+
+func NewServer(svc *connector.Service, tenantSvc *connector.TenantService, auditSvc *connector.AuditService,
+	webhookSvc *connector.WebhookService, grpcPort string, opts ...HandlerOption) (*grpc.Server, net.Listener, error) {
+	handler := NewHandler(svc, opts...)
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
-			rateLimitingInterceptor,
+			handler.metrics.UnaryServerInterceptor(),
+			rateLimitingInterceptor(handler.metrics),
+			requestContextInterceptor,
+			adminAuthInterceptor(handler.adminToken),
+		),
+		grpc.ChainStreamInterceptor(
+			handler.metrics.StreamServerInterceptor(),
+			rateLimitingStreamInterceptor(handler.metrics),
+			requestContextStreamInterceptor,
+			adminAuthStreamInterceptor(handler.adminToken),
 		),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
 
-	handler := NewHandler(svc)
 	connectorv1.RegisterConnectorServiceServer(server, handler)
+	connectorv1.RegisterTenantServiceServer(server, NewTenantHandler(tenantSvc))
+	connectorv1.RegisterAuditServiceServer(server, NewAuditHandler(auditSvc))
+	connectorv1.RegisterWebhookServiceServer(server, NewWebhookHandler(webhookSvc))
+	if handler.breakers != nil {
+		connectorv1.RegisterAdminServiceServer(server, NewAdminHandler(handler.breakers, auditSvc))
+	}
 
 	healthServer := health.NewServer()
 	healthpb.RegisterHealthServer(server, healthServer)
-	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	if handler.health != nil {
+		reportHealth(healthServer, handler.health)
+	} else {
+		healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	}
 
 	reflection.Register(server)
 
@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TenantHandler struct {
+	connectorv1.UnimplementedTenantServiceServer
+	service *connector.TenantService
+}
+
+func NewTenantHandler(svc *connector.TenantService) *TenantHandler {
+	return &TenantHandler{service: svc}
+}
+
+func (h *TenantHandler) CreateTenant(ctx context.Context, req *connectorv1.CreateTenantRequest) (*connectorv1.CreateTenantResponse, error) {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.Id).Msg("Received CreateTenant gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("CreateTenant request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	tenant, err := h.service.CreateTenant(ctx, connector.CreateTenantInput{
+		ID:   req.Id,
+		Name: req.Name,
+	})
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.CreateTenantResponse{
+		Tenant: tenantToProto(tenant),
+	}, nil
+}
+
+func (h *TenantHandler) GetTenant(ctx context.Context, req *connectorv1.GetTenantRequest) (*connectorv1.GetTenantResponse, error) {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.Id).Msg("Received GetTenant gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("GetTenant request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	tenant, err := h.service.GetTenant(ctx, req.Id)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.GetTenantResponse{
+		Tenant: tenantToProto(tenant),
+	}, nil
+}
+
+func (h *TenantHandler) ListTenants(ctx context.Context, req *connectorv1.ListTenantsRequest) (*connectorv1.ListTenantsResponse, error) {
+	logger.Ctx(ctx).Info().Msg("Received ListTenants gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("ListTenants request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	afterID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	tenants, next, err := h.service.ListTenants(ctx, int(req.PageSize), afterID)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	resp := &connectorv1.ListTenantsResponse{
+		Tenants: make([]*connectorv1.Tenant, 0, len(tenants)),
+	}
+	for i := range tenants {
+		resp.Tenants = append(resp.Tenants, tenantToProto(&tenants[i]))
+	}
+	if next != "" {
+		resp.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(next))
+	}
+	return resp, nil
+}
+
+func (h *TenantHandler) SuspendTenant(ctx context.Context, req *connectorv1.SuspendTenantRequest) (*connectorv1.SuspendTenantResponse, error) {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.Id).Msg("Received SuspendTenant gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("SuspendTenant request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	tenant, err := h.service.SuspendTenant(ctx, req.Id)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.SuspendTenantResponse{
+		Tenant: tenantToProto(tenant),
+	}, nil
+}
+
+func (h *TenantHandler) ResumeTenant(ctx context.Context, req *connectorv1.ResumeTenantRequest) (*connectorv1.ResumeTenantResponse, error) {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.Id).Msg("Received ResumeTenant gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("ResumeTenant request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	tenant, err := h.service.ResumeTenant(ctx, req.Id)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.ResumeTenantResponse{
+		Tenant: tenantToProto(tenant),
+	}, nil
+}
+
+func (h *TenantHandler) DeleteTenant(ctx context.Context, req *connectorv1.DeleteTenantRequest) (*connectorv1.DeleteTenantResponse, error) {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.Id).Msg("Received DeleteTenant gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("DeleteTenant request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	if err := h.service.DeleteTenant(ctx, req.Id); err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.DeleteTenantResponse{
+		Message: "Tenant scheduled for deletion",
+	}, nil
+}
+
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid page token: %w", connector.ErrInvalidInput)
+	}
+	return string(raw), nil
+}
+
+func tenantToProto(tenant *domain.Tenant) *connectorv1.Tenant {
+	return &connectorv1.Tenant{
+		Id:        tenant.ID,
+		Name:      tenant.Name,
+		Status:    tenantStatusToProto(tenant.Status),
+		CreatedAt: timestamppb.New(tenant.CreatedAt),
+		UpdatedAt: timestamppb.New(tenant.UpdatedAt),
+	}
+}
+
+func tenantStatusToProto(status domain.TenantStatus) connectorv1.TenantStatus {
+	switch status {
+	case domain.TenantStatusActive:
+		return connectorv1.TenantStatus_TENANT_STATUS_ACTIVE
+	case domain.TenantStatusSuspended:
+		return connectorv1.TenantStatus_TENANT_STATUS_SUSPENDED
+	case domain.TenantStatusDeleting:
+		return connectorv1.TenantStatus_TENANT_STATUS_DELETING
+	default:
+		return connectorv1.TenantStatus_TENANT_STATUS_UNSPECIFIED
+	}
+}
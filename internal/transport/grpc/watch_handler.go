@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) WatchConnectors(req *connectorv1.WatchConnectorsRequest, stream grpc.ServerStreamingServer[connectorv1.WatchConnectorsResponse]) error {
+	return h.watchConnectors(stream.Context(), req, stream.Send)
+}
+
+// watchConnectors serves WatchConnectors for both the gRPC and the Connect
+// transports.
+func (h *Handler) watchConnectors(ctx context.Context, req *connectorv1.WatchConnectorsRequest, send func(*connectorv1.WatchConnectorsResponse) error) error {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.TenantId).Msg("Received WatchConnectors gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("WatchConnectors request validation failed")
+		return connector.GRPCError(err)
+	}
+	if h.watcher == nil {
+		return status.Error(codes.Unimplemented, "connector watch is not enabled")
+	}
+
+	err := h.watcher.Watch(ctx, req.TenantId, req.ResumeToken, func(update connector.WatchUpdate) error {
+		res, err := watchUpdateToProto(update)
+		if err != nil {
+			return err
+		}
+		return send(res)
+	})
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		// Send errors already carry a status.
+		return err
+	}
+	logger.Ctx(ctx).Warn().Err(err).Str("tenant_id", req.TenantId).Msg("WatchConnectors stream ended")
+	return connector.GRPCError(err)
+}
+
+func watchUpdateToProto(update connector.WatchUpdate) (*connectorv1.WatchConnectorsResponse, error) {
+	res := &connectorv1.WatchConnectorsResponse{ResumeToken: update.ResumeToken}
+	if update.Event == nil {
+		res.Payload = &connectorv1.WatchConnectorsResponse_Heartbeat{
+			Heartbeat: &connectorv1.WatchHeartbeat{Time: timestamppb.Now()},
+		}
+		return res, nil
+	}
+
+	var data domain.ConnectorEventData
+	if err := json.Unmarshal(update.Event.Data, &data); err != nil {
+		return nil, fmt.Errorf("decode event %s: %w", update.Event.ID, err)
+	}
+	event := &connectorv1.ConnectorEvent{
+		Id:   update.Event.ID,
+		Type: connectorEventTypeToProto(domain.ConnectorEventType(update.Event.Type)),
+		Time: timestamppb.New(update.Event.Time),
+		Connector: &connectorv1.Connector{
+			Id:               data.ID,
+			WorkspaceId:      data.WorkspaceID,
+			TenantId:         data.TenantID,
+			DefaultChannelId: data.DefaultChannelID,
+			CreatedAt:        timestamppb.New(data.CreatedAt),
+			UpdatedAt:        timestamppb.New(data.UpdatedAt),
+			Status:           connectorStatusToProto(data.Status),
+		},
+	}
+	if data.DeletedAt != nil {
+		event.DeletedAt = timestamppb.New(*data.DeletedAt)
+	}
+	res.Payload = &connectorv1.WatchConnectorsResponse_Event{Event: event}
+	return res, nil
+}
+
+func connectorEventTypeToProto(eventType domain.ConnectorEventType) connectorv1.ConnectorEventType {
+	switch eventType {
+	case domain.ConnectorEventCreated:
+		return connectorv1.ConnectorEventType_CONNECTOR_EVENT_TYPE_CREATED
+	case domain.ConnectorEventUpdated:
+		return connectorv1.ConnectorEventType_CONNECTOR_EVENT_TYPE_UPDATED
+	case domain.ConnectorEventDisabled:
+		return connectorv1.ConnectorEventType_CONNECTOR_EVENT_TYPE_DISABLED
+	case domain.ConnectorEventRotated:
+		return connectorv1.ConnectorEventType_CONNECTOR_EVENT_TYPE_ROTATED
+	case domain.ConnectorEventDeleted:
+		return connectorv1.ConnectorEventType_CONNECTOR_EVENT_TYPE_DELETED
+	case domain.ConnectorEventRestored:
+		return connectorv1.ConnectorEventType_CONNECTOR_EVENT_TYPE_RESTORED
+	default:
+		return connectorv1.ConnectorEventType_CONNECTOR_EVENT_TYPE_UNSPECIFIED
+	}
+}
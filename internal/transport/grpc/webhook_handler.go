@@ -0,0 +1,205 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/logger"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhookHandler struct {
+	connectorv1.UnimplementedWebhookServiceServer
+	service *connector.WebhookService
+}
+
+func NewWebhookHandler(svc *connector.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: svc}
+}
+
+func (h *WebhookHandler) CreateWebhookSubscription(ctx context.Context, req *connectorv1.CreateWebhookSubscriptionRequest) (*connectorv1.CreateWebhookSubscriptionResponse, error) {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.TenantId).Msg("Received CreateWebhookSubscription gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("CreateWebhookSubscription request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	sub, err := h.service.CreateSubscription(ctx, connector.CreateWebhookInput{
+		TenantID:   req.TenantId,
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+	})
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.CreateWebhookSubscriptionResponse{
+		Subscription: webhookSubscriptionToProto(sub),
+		Secret:       sub.Secret,
+	}, nil
+}
+
+func (h *WebhookHandler) GetWebhookSubscription(ctx context.Context, req *connectorv1.GetWebhookSubscriptionRequest) (*connectorv1.GetWebhookSubscriptionResponse, error) {
+	logger.Ctx(ctx).Info().Str("subscription_id", req.Id).Msg("Received GetWebhookSubscription gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("GetWebhookSubscription request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, connector.GRPCError(fmt.Errorf("invalid subscription ID: %w", err))
+	}
+	sub, err := h.service.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.GetWebhookSubscriptionResponse{
+		Subscription: webhookSubscriptionToProto(sub),
+	}, nil
+}
+
+func (h *WebhookHandler) ListWebhookSubscriptions(ctx context.Context, req *connectorv1.ListWebhookSubscriptionsRequest) (*connectorv1.ListWebhookSubscriptionsResponse, error) {
+	logger.Ctx(ctx).Info().Str("tenant_id", req.TenantId).Msg("Received ListWebhookSubscriptions gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("ListWebhookSubscriptions request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	subs, err := h.service.ListSubscriptions(ctx, req.TenantId)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	resp := &connectorv1.ListWebhookSubscriptionsResponse{
+		Subscriptions: make([]*connectorv1.WebhookSubscription, 0, len(subs)),
+	}
+	for i := range subs {
+		resp.Subscriptions = append(resp.Subscriptions, webhookSubscriptionToProto(&subs[i]))
+	}
+	return resp, nil
+}
+
+func (h *WebhookHandler) DeleteWebhookSubscription(ctx context.Context, req *connectorv1.DeleteWebhookSubscriptionRequest) (*connectorv1.DeleteWebhookSubscriptionResponse, error) {
+	logger.Ctx(ctx).Info().Str("subscription_id", req.Id).Msg("Received DeleteWebhookSubscription gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("DeleteWebhookSubscription request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, connector.GRPCError(fmt.Errorf("invalid subscription ID: %w", err))
+	}
+	if err := h.service.DeleteSubscription(ctx, id); err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.DeleteWebhookSubscriptionResponse{
+		Message: "Webhook subscription deleted successfully",
+	}, nil
+}
+
+func (h *WebhookHandler) EnableWebhookSubscription(ctx context.Context, req *connectorv1.EnableWebhookSubscriptionRequest) (*connectorv1.EnableWebhookSubscriptionResponse, error) {
+	logger.Ctx(ctx).Info().Str("subscription_id", req.Id).Msg("Received EnableWebhookSubscription gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("EnableWebhookSubscription request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, connector.GRPCError(fmt.Errorf("invalid subscription ID: %w", err))
+	}
+	sub, err := h.service.EnableSubscription(ctx, id)
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	return &connectorv1.EnableWebhookSubscriptionResponse{
+		Subscription: webhookSubscriptionToProto(sub),
+	}, nil
+}
+
+func (h *WebhookHandler) ListWebhookDeliveryAttempts(ctx context.Context, req *connectorv1.ListWebhookDeliveryAttemptsRequest) (*connectorv1.ListWebhookDeliveryAttemptsResponse, error) {
+	logger.Ctx(ctx).Info().Str("subscription_id", req.SubscriptionId).Msg("Received ListWebhookDeliveryAttempts gRPC request")
+
+	if err := req.Validate(); err != nil {
+		logger.Ctx(ctx).Warn().Err(err).Msg("ListWebhookDeliveryAttempts request validation failed")
+		return nil, connector.GRPCError(err)
+	}
+
+	id, err := uuid.Parse(req.SubscriptionId)
+	if err != nil {
+		return nil, connector.GRPCError(fmt.Errorf("invalid subscription ID: %w", err))
+	}
+	attempts, err := h.service.ListDeliveryAttempts(ctx, id, int(req.Limit))
+	if err != nil {
+		return nil, connector.GRPCError(err)
+	}
+
+	resp := &connectorv1.ListWebhookDeliveryAttemptsResponse{
+		Attempts: make([]*connectorv1.WebhookDeliveryAttempt, 0, len(attempts)),
+	}
+	for i := range attempts {
+		resp.Attempts = append(resp.Attempts, webhookAttemptToProto(&attempts[i]))
+	}
+	return resp, nil
+}
+
+// webhookSubscriptionToProto leaves out the secret, which is only returned
+// when the subscription is created.
+func webhookSubscriptionToProto(sub *domain.WebhookSubscription) *connectorv1.WebhookSubscription {
+	pb := &connectorv1.WebhookSubscription{
+		Id:                  sub.ID.String(),
+		TenantId:            sub.TenantID,
+		Url:                 sub.URL,
+		EventTypes:          sub.EventTypes,
+		Status:              webhookStatusToProto(sub.Status),
+		ConsecutiveFailures: int32(sub.ConsecutiveFailures),
+		CreatedAt:           timestamppb.New(sub.CreatedAt),
+		UpdatedAt:           timestamppb.New(sub.UpdatedAt),
+	}
+	if sub.DisabledAt != nil {
+		pb.DisabledAt = timestamppb.New(*sub.DisabledAt)
+	}
+	return pb
+}
+
+func webhookStatusToProto(status domain.WebhookStatus) connectorv1.WebhookStatus {
+	switch status {
+	case domain.WebhookStatusActive:
+		return connectorv1.WebhookStatus_WEBHOOK_STATUS_ACTIVE
+	case domain.WebhookStatusDisabled:
+		return connectorv1.WebhookStatus_WEBHOOK_STATUS_DISABLED
+	default:
+		return connectorv1.WebhookStatus_WEBHOOK_STATUS_UNSPECIFIED
+	}
+}
+
+func webhookAttemptToProto(attempt *domain.WebhookDeliveryAttempt) *connectorv1.WebhookDeliveryAttempt {
+	return &connectorv1.WebhookDeliveryAttempt{
+		Id:            attempt.ID.String(),
+		DeliveryId:    attempt.DeliveryID.String(),
+		EventId:       attempt.EventID,
+		EventType:     attempt.EventType,
+		AttemptNumber: int32(attempt.AttemptNumber),
+		StatusCode:    int32(attempt.StatusCode),
+		Error:         attempt.Error,
+		Duration:      durationpb.New(attempt.Duration),
+		AttemptedAt:   timestamppb.New(attempt.AttemptedAt),
+		Succeeded:     attempt.Succeeded(),
+	}
+}
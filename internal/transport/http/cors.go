@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rs/cors"
+)
+
+// Headers browsers need to send and read for the Connect, gRPC-Web and REST
+// protocols, plus the caller and request ID headers.
+var (
+	corsAllowedHeaders = []string{
+		"Authorization",
+		"Content-Type",
+		"Connect-Protocol-Version",
+		"Connect-Timeout-Ms",
+		"Connect-Accept-Encoding",
+		"Connect-Content-Encoding",
+		"Grpc-Timeout",
+		"X-Grpc-Web",
+		"X-User-Agent",
+		"X-Actor",
+		"X-Request-Id",
+	}
+	corsExposedHeaders = []string{
+		"Grpc-Status",
+		"Grpc-Message",
+		"Grpc-Status-Details-Bin",
+		"Connect-Content-Encoding",
+		"X-Request-Id",
+	}
+)
+
+// WithCORS lets browsers on allowedOrigins call handler. With no origins
+// configured handler is returned unchanged and cross-origin calls are refused
+// by the browser.
+func WithCORS(handler http.Handler, allowedOrigins []string, maxAge time.Duration) http.Handler {
+	if len(allowedOrigins) == 0 {
+		return handler
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins: allowedOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodDelete},
+		AllowedHeaders: corsAllowedHeaders,
+		ExposedHeaders: corsExposedHeaders,
+		MaxAge:         int(maxAge.Seconds()),
+	}).Handler(handler)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	_ "embed"
+	"html/template"
+	"net/http"
+
+	"github.com/connector-recruitment/internal/openapi"
+	"github.com/connector-recruitment/pkg/logger"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+)
+
+//go:generate go run ../../../go-server/cmd/openapi-gen -out openapi.json
+
+// OpenAPISpec is the OpenAPI document for the REST gateway, generated from
+// connector.proto.
+//
+//go:embed openapi.json
+var OpenAPISpec []byte
+
+// GenerateOpenAPISpec builds the document embedded as OpenAPISpec.
+func GenerateOpenAPISpec() ([]byte, error) {
+	doc, err := openapi.Generate(openapi.Info{
+		Title:       "Connector Service API",
+		Description: "REST/JSON gateway for connector.v1.ConnectorService.",
+		Version:     "v1",
+	}, connectorv1.File_connector_v1_connector_proto.Services().ByName("ConnectorService"))
+	if err != nil {
+		return nil, err
+	}
+	return doc.Marshal()
+}
+
+// ServeOpenAPI serves the embedded OpenAPI document.
+func ServeOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	if _, err := w.Write(OpenAPISpec); err != nil {
+		logger.Ctx(r.Context()).Warn().Err(err).Msg("Failed to write OpenAPI document")
+	}
+}
+
+var swaggerUITemplate = template.Must(template.New("swagger").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Connector Service API</title>
+  <link rel="stylesheet" href="{{.AssetsURL}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="{{.AssetsURL}}/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`))
+
+// SwaggerUIHandler renders a Swagger UI page for /openapi.json. The UI's
+// script and stylesheet are loaded from assetsURL, so it can point at a CDN or
+// at an internal mirror.
+func SwaggerUIHandler(assetsURL string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := swaggerUITemplate.Execute(w, struct{ AssetsURL string }{assetsURL}); err != nil {
+			logger.Ctx(r.Context()).Warn().Err(err).Msg("Failed to render Swagger UI")
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// GatewayPrefix is the path under which the REST gateway is mounted.
+const GatewayPrefix = "/v1/"
+
+// forwardedHeaders are passed to the gRPC server as metadata under the same
+// name, so REST callers identify themselves the same way gRPC callers do.
+var forwardedHeaders = map[string]bool{
+	"x-actor":      true,
+	"x-request-id": true,
+}
+
+// NewGateway serves ConnectorService as JSON over HTTP. Requests are forwarded
+// to the gRPC server at grpcAddr, so they go through the same interceptors,
+// request validation and error mapping as native gRPC calls; gRPC status codes
+// become the matching HTTP statuses. dialOpts are added to the connection's
+// defaults.
+func NewGateway(ctx context.Context, grpcAddr string, dialOpts ...grpc.DialOption) (http.Handler, func() error, error) {
+	opts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}, dialOpts...)
+	conn, err := grpc.NewClient(grpcAddr, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
+	if err := connectorv1.RegisterConnectorServiceHandlerClient(ctx, mux, connectorv1.NewConnectorServiceClient(conn)); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+	return mux, conn.Close, nil
+}
+
+func incomingHeaderMatcher(key string) (string, bool) {
+	if lower := strings.ToLower(key); forwardedHeaders[lower] {
+		return lower, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher returns the request ID under its own name rather than
+// the gateway's Grpc-Metadata- prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if forwardedHeaders[strings.ToLower(key)] {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -26,7 +26,7 @@ func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) OAuthCallback(w http.ResponseWriter, r *http.Request) {
-	logger.Info().Msg("Received /oauth/callback request")
+	logger.Ctx(r.Context()).Info().Msg("Received /oauth/callback request")
 
 	ctx := r.Context()
 	code := r.URL.Query().Get("code")
@@ -42,14 +42,14 @@ func (h *Handler) OAuthCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.oauthManager.ValidateState(ctx, state); err != nil {
-		logger.Warn().Err(err).Msg("Invalid OAuth state parameter")
+		logger.Ctx(r.Context()).Warn().Err(err).Msg("Invalid OAuth state parameter")
 		http.Error(w, "Invalid state parameter", http.StatusBadRequest)
 		return
 	}
 
 	token, err := h.svc.ExchangeOAuthCode(ctx, code)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to exchange code for token")
+		logger.Ctx(r.Context()).Error().Err(err).Msg("Failed to exchange code for token")
 		http.Error(w, "Failed to exchange code for token", http.StatusInternalServerError)
 		return
 	}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/connector-recruitment/internal/app/health"
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+// Livez reports that the process is up and serving HTTP. It deliberately
+// ignores dependencies: restarting the service would not fix them.
+func Livez(w http.ResponseWriter, r *http.Request) {
+	writeHealthJSON(w, r, http.StatusOK, map[string]string{"status": "alive"})
+}
+
+// ReadyzHandler reports the monitor's latest results, with 503 while a
+// critical dependency is down or the server is draining.
+func ReadyzHandler(monitor *health.Monitor) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := monitor.Report()
+		status := http.StatusOK
+		if !report.Ready() {
+			status = http.StatusServiceUnavailable
+		}
+		writeHealthJSON(w, r, status, report)
+	}
+}
+
+func writeHealthJSON(w http.ResponseWriter, r *http.Request, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Ctx(r.Context()).Warn().Err(err).Msg("Failed to write health response")
+	}
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/connector-recruitment/pkg/logger"
+)
+
+type logLevelBody struct {
+	Level string `json:"level"`
+}
+
+// LogLevelHandler reports the current log level on GET and changes it on PUT
+// with a body like {"level":"debug"}. The change lasts until the next restart.
+func LogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var body logLevelBody
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1024)).Decode(&body); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		previous := logger.Level()
+		if err := logger.SetLevel(body.Level); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		logger.Ctx(r.Context()).Warn().Str("previous", previous).Str("level", logger.Level()).Msg("Log level changed")
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(logLevelBody{Level: logger.Level()}); err != nil {
+		logger.Ctx(r.Context()).Warn().Err(err).Msg("Failed to write log level")
+	}
+}
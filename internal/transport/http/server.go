@@ -5,18 +5,62 @@ import (
 
 	"github.com/connector-recruitment/internal/app/config"
 	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/app/health"
 )
 
-func NewHTTPServer(svc *connector.Service, oauthManager *connector.OAuthStateManager, cfg *config.Config) *http.Server {
+type ServerOption func(*http.ServeMux)
+
+// WithGateway mounts the REST gateway under GatewayPrefix.
+func WithGateway(gateway http.Handler) ServerOption {
+	return func(mux *http.ServeMux) {
+		mux.Handle(GatewayPrefix, gateway)
+	}
+}
+
+// WithConnectHandler mounts a Connect/gRPC-Web handler on its service path.
+func WithConnectHandler(path string, handler http.Handler) ServerOption {
+	return func(mux *http.ServeMux) {
+		mux.Handle(path, handler)
+	}
+}
+
+// WithMetricsHandler serves Prometheus metrics on /metrics.
+func WithMetricsHandler(handler http.Handler) ServerOption {
+	return func(mux *http.ServeMux) {
+		mux.Handle("/metrics", handler)
+	}
+}
+
+// WithHealth serves /livez and /readyz, the latter from monitor.
+func WithHealth(monitor *health.Monitor) ServerOption {
+	return func(mux *http.ServeMux) {
+		mux.HandleFunc("/livez", Livez)
+		mux.HandleFunc("/readyz", ReadyzHandler(monitor))
+	}
+}
+
+// NewHTTPServer serves the OAuth callback, health check and OpenAPI document,
+// plus whatever the options mount. It accepts HTTP/1.1 and, over TLS, HTTP/2.
+func NewHTTPServer(svc *connector.Service, oauthManager *connector.OAuthStateManager, cfg *config.Config, opts ...ServerOption) *http.Server {
 	handler := NewHandler(svc, oauthManager)
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/oauth/callback", handler.OAuthCallback)
 	mux.HandleFunc("/health", handler.Health)
+	mux.HandleFunc("/openapi.json", ServeOpenAPI)
+	if cfg.SwaggerUIEnabled {
+		mux.HandleFunc("/docs", SwaggerUIHandler(cfg.SwaggerUIAssetsURL))
+	}
+	if cfg.Logging.LevelEndpointEnabled {
+		mux.HandleFunc("/debug/log-level", LogLevelHandler)
+	}
+	for _, opt := range opts {
+		opt(mux)
+	}
 
 	return &http.Server{
 		Addr:              ":8080",
-		Handler:           mux,
+		Handler:           WithCORS(mux, cfg.CORSAllowedOrigins, cfg.CORSMaxAge),
 		ReadHeaderTimeout: cfg.HTTPReadHeaderTimeout,
 		ReadTimeout:       cfg.HTTPReadTimeout,
 		WriteTimeout:      cfg.HTTPWriteTimeout,
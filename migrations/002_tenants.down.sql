@@ -0,0 +1,3 @@
+ALTER TABLE connectors DROP CONSTRAINT IF EXISTS fk_connectors_tenant;
+DROP INDEX IF EXISTS idx_connectors_tenant_id;
+DROP TABLE IF EXISTS tenants;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS tenants (
+                                       id TEXT PRIMARY KEY,
+                                       name TEXT NOT NULL,
+                                       status TEXT NOT NULL DEFAULT 'active',
+                                       created_at TIMESTAMPTZ NOT NULL,
+                                       updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenants_status ON tenants (status);
+
+-- Existing connectors predate tenants; give each referenced tenant a row.
+INSERT INTO tenants (id, name, status, created_at, updated_at)
+SELECT DISTINCT tenant_id, tenant_id, 'active', NOW(), NOW()
+FROM connectors
+ON CONFLICT (id) DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_connectors_tenant_id ON connectors (tenant_id);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_connectors_tenant') THEN
+        ALTER TABLE connectors
+            ADD CONSTRAINT fk_connectors_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id);
+    END IF;
+END $$;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS idempotency_keys;
+DROP INDEX IF EXISTS uq_connectors_workspace_tenant;
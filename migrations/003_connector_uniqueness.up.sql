@@ -0,0 +1,17 @@
+-- Connectors sharing a workspace and tenant would also share a secret name.
+-- Duplicates must be resolved by hand before this index can be built.
+-- Databases already past 007 have the partial index instead, and may hold
+-- soft-deleted duplicates this index would reject.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_indexes WHERE indexname = 'uq_connectors_workspace_tenant_live') THEN
+        CREATE UNIQUE INDEX IF NOT EXISTS uq_connectors_workspace_tenant ON connectors (workspace_id, tenant_id);
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+                                                key TEXT PRIMARY KEY,
+                                                request_hash TEXT NOT NULL,
+                                                connector_id UUID NOT NULL REFERENCES connectors (id) ON DELETE CASCADE,
+                                                created_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,3 @@
+-- Connectors already moved to UUID keyed secrets lose track of them; run
+-- migrate-secrets in reverse by hand first if any exist.
+ALTER TABLE connectors DROP COLUMN IF EXISTS secret_name;
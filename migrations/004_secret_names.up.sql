@@ -0,0 +1,9 @@
+ALTER TABLE connectors ADD COLUMN IF NOT EXISTS secret_name TEXT;
+
+-- Existing connectors keep their workspace/tenant based secret until the
+-- migrate-secrets CLI command moves them to the UUID keyed scheme.
+UPDATE connectors
+SET secret_name = 'connector-' || workspace_id || '-' || tenant_id
+WHERE secret_name IS NULL;
+
+ALTER TABLE connectors ALTER COLUMN secret_name SET NOT NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS connector_operations (
+                                                    id UUID PRIMARY KEY,
+                                                    kind TEXT NOT NULL,
+                                                    state TEXT NOT NULL,
+                                                    connector_id UUID NOT NULL,
+                                                    secret_name TEXT NOT NULL,
+                                                    payload JSONB,
+                                                    attempts INT NOT NULL DEFAULT 0,
+                                                    last_error TEXT NOT NULL DEFAULT '',
+                                                    created_at TIMESTAMPTZ NOT NULL,
+                                                    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_connector_operations_unfinished
+    ON connector_operations (updated_at)
+    WHERE state NOT IN ('completed', 'compensated');
@@ -0,0 +1,18 @@
+-- Soft deleted connectors would otherwise reappear as live ones, and deleting
+-- them would lose connectors that can still be restored. Purge or restore
+-- them first.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'connectors' AND column_name = 'deleted_at') THEN
+        IF EXISTS (SELECT 1 FROM connectors WHERE deleted_at IS NOT NULL) THEN
+            RAISE EXCEPTION 'connectors has soft deleted rows; restore or purge them before reverting 007_soft_delete';
+        END IF;
+    END IF;
+END $$;
+
+DROP INDEX IF EXISTS idx_connectors_deleted_at;
+DROP INDEX IF EXISTS uq_connectors_workspace_tenant_live;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_connectors_workspace_tenant ON connectors (workspace_id, tenant_id);
+
+ALTER TABLE connectors DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,11 @@
+ALTER TABLE connectors ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- A deleted connector no longer blocks a new one for the same workspace and tenant.
+DROP INDEX IF EXISTS uq_connectors_workspace_tenant;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_connectors_workspace_tenant_live
+    ON connectors (workspace_id, tenant_id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_connectors_deleted_at
+    ON connectors (deleted_at)
+    WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,36 @@
+-- diff is JSON rather than JSONB so the stored text, which is hashed, is kept byte for byte.
+CREATE TABLE IF NOT EXISTS audit_events (
+                                            id BIGINT PRIMARY KEY,
+                                            occurred_at TIMESTAMPTZ NOT NULL,
+                                            actor TEXT NOT NULL,
+                                            tenant_id TEXT NOT NULL DEFAULT '',
+                                            action TEXT NOT NULL,
+                                            target_type TEXT NOT NULL,
+                                            target_id TEXT NOT NULL DEFAULT '',
+                                            outcome TEXT NOT NULL,
+                                            request_id TEXT NOT NULL DEFAULT '',
+                                            trace_id TEXT NOT NULL DEFAULT '',
+                                            diff JSON,
+                                            prev_hash TEXT NOT NULL,
+                                            hash TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_tenant ON audit_events (tenant_id, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_target ON audit_events (target_id, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events (actor, id);
+
+CREATE OR REPLACE FUNCTION reject_audit_event_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();
+
+DROP TRIGGER IF EXISTS audit_events_no_truncate ON audit_events;
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_event_change();
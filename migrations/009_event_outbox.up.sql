@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS event_outbox (
+                                            seq BIGSERIAL PRIMARY KEY,
+                                            id UUID NOT NULL UNIQUE,
+                                            event_type TEXT NOT NULL,
+                                            envelope JSONB NOT NULL,
+                                            attempts INT NOT NULL DEFAULT 0,
+                                            last_error TEXT NOT NULL DEFAULT '',
+                                            locked_until TIMESTAMPTZ,
+                                            published_at TIMESTAMPTZ,
+                                            created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_pending
+    ON event_outbox (seq)
+    WHERE published_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_published
+    ON event_outbox (published_at)
+    WHERE published_at IS NOT NULL;
@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+                                                     id UUID PRIMARY KEY,
+                                                     tenant_id TEXT NOT NULL REFERENCES tenants (id) ON DELETE CASCADE,
+                                                     url TEXT NOT NULL,
+                                                     event_types TEXT[] NOT NULL DEFAULT '{}',
+                                                     secret TEXT NOT NULL,
+                                                     status TEXT NOT NULL DEFAULT 'active',
+                                                     consecutive_failures INT NOT NULL DEFAULT 0,
+                                                     disabled_at TIMESTAMPTZ,
+                                                     created_at TIMESTAMPTZ NOT NULL,
+                                                     updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_tenant ON webhook_subscriptions (tenant_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+                                                  id UUID PRIMARY KEY,
+                                                  subscription_id UUID NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+                                                  event_id TEXT NOT NULL,
+                                                  event_type TEXT NOT NULL,
+                                                  payload JSONB NOT NULL,
+                                                  status TEXT NOT NULL DEFAULT 'pending',
+                                                  attempts INT NOT NULL DEFAULT 0,
+                                                  next_attempt_at TIMESTAMPTZ NOT NULL,
+                                                  created_at TIMESTAMPTZ NOT NULL,
+                                                  updated_at TIMESTAMPTZ NOT NULL,
+                                                  UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+    ON webhook_deliveries (next_attempt_at)
+    WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+                                                         id UUID PRIMARY KEY,
+                                                         delivery_id UUID NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+                                                         subscription_id UUID NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+                                                         event_id TEXT NOT NULL,
+                                                         event_type TEXT NOT NULL,
+                                                         attempt_number INT NOT NULL,
+                                                         status_code INT NOT NULL DEFAULT 0,
+                                                         error TEXT NOT NULL DEFAULT '',
+                                                         duration_ms BIGINT NOT NULL DEFAULT 0,
+                                                         attempted_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempts_subscription
+    ON webhook_delivery_attempts (subscription_id, attempted_at DESC);
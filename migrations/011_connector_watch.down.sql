@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS event_outbox_notify ON event_outbox;
+DROP FUNCTION IF EXISTS notify_event_outbox();
+DROP INDEX IF EXISTS idx_event_outbox_tenant;
+ALTER TABLE event_outbox DROP COLUMN IF EXISTS tenant_id;
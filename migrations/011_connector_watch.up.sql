@@ -0,0 +1,20 @@
+-- Watchers read the outbox back per tenant, resuming after a seq.
+ALTER TABLE event_outbox ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT '';
+
+UPDATE event_outbox SET tenant_id = envelope->>'tenantid'
+WHERE tenant_id = '' AND envelope->>'tenantid' IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_tenant ON event_outbox (tenant_id, seq);
+
+-- NOTIFY is delivered on commit, so listeners only hear about committed events.
+CREATE OR REPLACE FUNCTION notify_event_outbox() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('connector_events', NEW.tenant_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS event_outbox_notify ON event_outbox;
+CREATE TRIGGER event_outbox_notify
+    AFTER INSERT ON event_outbox
+    FOR EACH ROW EXECUTE FUNCTION notify_event_outbox();
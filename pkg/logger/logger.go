@@ -1,11 +1,16 @@
 package logger
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/connector-recruitment/pkg/requestctx"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -62,14 +67,114 @@ func newConsoleWriter() zerolog.ConsoleWriter {
 	return cw
 }
 
+// Output formats.
+const (
+	FormatConsole = "console"
+	FormatJSON    = "json"
+)
+
+// Options configures the logger; see Configure.
+type Options struct {
+	// Level is a zerolog level name such as "debug" or "info".
+	Level string
+	// Format is FormatConsole or FormatJSON.
+	Format string
+	// RedactFields are field names scrubbed from every event on top of
+	// DefaultRedactFields.
+	RedactFields []string
+}
+
+var (
+	format    = FormatConsole
+	redaction = newRedactor(nil)
+	// exporter receives every event as well, once ExportTo is called.
+	exporter zerolog.LevelWriter
+)
+
 // baseLogger is the main logger you’ll use throughout your app.
-var baseLogger = zerolog.New(newConsoleWriter()).
-	With().
-	Timestamp().
-	Logger()
+var baseLogger = newLogger()
+
+func newLogger() zerolog.Logger {
+	var out io.Writer = os.Stdout
+	if format != FormatJSON {
+		out = newConsoleWriter()
+	}
+	writers := []io.Writer{out}
+	if exporter != nil {
+		writers = append(writers, exporter)
+	}
+	return zerolog.New(&redactingWriter{next: zerolog.MultiLevelWriter(writers...), redactor: redaction}).
+		With().
+		Timestamp().
+		Logger()
+}
+
+// Configure sets the output format, level and redacted fields. Call it once at
+// startup, before logging from other goroutines; the level can be changed
+// at any time with SetLevel.
+func Configure(opts Options) error {
+	switch opts.Format {
+	case FormatConsole, FormatJSON, "":
+	default:
+		return fmt.Errorf("unsupported log format %q (expected console or json)", opts.Format)
+	}
+	level := opts.Level
+	if level == "" {
+		level = zerolog.InfoLevel.String()
+	}
+	if err := SetLevel(level); err != nil {
+		return err
+	}
+	format = opts.Format
+	if format == "" {
+		format = FormatConsole
+	}
+	redaction = newRedactor(opts.RedactFields)
+	baseLogger = newLogger()
+	return nil
+}
+
+// SetLevel changes the minimum level logged, effective immediately.
+func SetLevel(level string) error {
+	parsed, err := zerolog.ParseLevel(strings.ToLower(level))
+	if err != nil || parsed == zerolog.NoLevel {
+		return fmt.Errorf("unsupported log level %q", level)
+	}
+	zerolog.SetGlobalLevel(parsed)
+	return nil
+}
+
+// Level returns the name of the current minimum level.
+func Level() string {
+	return zerolog.GlobalLevel().String()
+}
+
+// Ctx returns the logger annotated with the correlation fields ctx carries:
+// trace and span ID, request ID, tenant ID and connector ID.
+func Ctx(ctx context.Context) *zerolog.Logger {
+	c := baseLogger.With()
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		c = c.Str("trace_id", spanCtx.TraceID().String()).Str("span_id", spanCtx.SpanID().String())
+	}
+	if requestID := requestctx.RequestID(ctx); requestID != "" {
+		c = c.Str("request_id", requestID)
+	}
+	if tenantID := requestctx.TenantID(ctx); tenantID != "" {
+		c = c.Str("tenant_id", tenantID)
+	}
+	if connectorID := requestctx.ConnectorID(ctx); connectorID != "" {
+		c = c.Str("connector_id", connectorID)
+	}
+	l := c.Logger()
+	return &l
+}
 
 // For convenience, you can wrap zerolog methods:
 
+func Debug() *zerolog.Event {
+	return baseLogger.Debug()
+}
+
 func Info() *zerolog.Event {
 	return baseLogger.Info()
 }
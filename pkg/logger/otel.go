@@ -0,0 +1,111 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/rs/zerolog"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ExportTo sends every log event to provider as well as to the console, so
+// logs reach the OpenTelemetry collector alongside traces and metrics.
+func ExportTo(provider otellog.LoggerProvider) {
+	exporter = &otelWriter{
+		logger: provider.Logger("github.com/connector-recruitment/pkg/logger"),
+	}
+	baseLogger = newLogger()
+}
+
+// otelWriter turns the JSON events zerolog writes into OpenTelemetry log
+// records: the message becomes the body and the other fields attributes.
+type otelWriter struct {
+	logger otellog.Logger
+}
+
+func (w *otelWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *otelWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(p, &fields); err != nil {
+		// The console still has the event; a malformed one is not worth failing the write.
+		return len(p), nil
+	}
+
+	var record otellog.Record
+	record.SetTimestamp(time.Now())
+	record.SetSeverity(severity(level))
+	record.SetSeverityText(level.String())
+	for key, value := range fields {
+		switch key {
+		case zerolog.MessageFieldName:
+			if msg, ok := value.(string); ok {
+				record.SetBody(otellog.StringValue(msg))
+			}
+		case zerolog.LevelFieldName, zerolog.TimestampFieldName, "trace_id", "span_id":
+		default:
+			record.AddAttributes(otellog.KeyValue{Key: key, Value: logValue(value)})
+		}
+	}
+	w.logger.Emit(spanContext(fields), record)
+	return len(p), nil
+}
+
+// spanContext rebuilds the span an event was logged under from the IDs
+// Ctx added, so the record is linked to its trace.
+func spanContext(fields map[string]interface{}) context.Context {
+	traceIDHex, _ := fields["trace_id"].(string)
+	spanIDHex, _ := fields["span_id"].(string)
+	traceID, err := trace.TraceIDFromHex(traceIDHex)
+	if err != nil {
+		return context.Background()
+	}
+	spanID, err := trace.SpanIDFromHex(spanIDHex)
+	if err != nil {
+		return context.Background()
+	}
+	return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+}
+
+func severity(level zerolog.Level) otellog.Severity {
+	switch level {
+	case zerolog.TraceLevel:
+		return otellog.SeverityTrace
+	case zerolog.DebugLevel:
+		return otellog.SeverityDebug
+	case zerolog.InfoLevel:
+		return otellog.SeverityInfo
+	case zerolog.WarnLevel:
+		return otellog.SeverityWarn
+	case zerolog.ErrorLevel:
+		return otellog.SeverityError
+	case zerolog.FatalLevel, zerolog.PanicLevel:
+		return otellog.SeverityFatal
+	default:
+		return otellog.SeverityUndefined
+	}
+}
+
+// logValue converts a decoded JSON field; objects and arrays are kept as JSON.
+func logValue(value interface{}) otellog.Value {
+	switch v := value.(type) {
+	case string:
+		return otellog.StringValue(v)
+	case float64:
+		return otellog.Float64Value(v)
+	case bool:
+		return otellog.BoolValue(v)
+	case nil:
+		return otellog.Value{}
+	default:
+		raw, _ := json.Marshal(v)
+		return otellog.StringValue(string(raw))
+	}
+}
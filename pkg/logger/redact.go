@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Redacted replaces every scrubbed value.
+const Redacted = "[REDACTED]"
+
+// DefaultRedactFields are always scrubbed, whatever Options.RedactFields adds.
+// Rotation writes the new token to a connector's secret_version, so both that
+// column and the struct field it is read into are scrubbed.
+var DefaultRedactFields = []string{
+	"token",
+	"access_token",
+	"refresh_token",
+	"client_secret",
+	"secret",
+	"password",
+	"authorization",
+	"secret_version",
+	"secretversion",
+}
+
+// slackToken matches bot, user, app and refresh tokens wherever they appear,
+// including inside messages and error strings.
+var slackToken = regexp.MustCompile(`xox[a-z]-[A-Za-z0-9-]+`)
+
+type redactor struct {
+	fields map[string]struct{}
+	// quoted holds `"name"` for each field, to skip decoding events that
+	// cannot contain one.
+	quoted [][]byte
+}
+
+func newRedactor(extra []string) *redactor {
+	r := &redactor{fields: make(map[string]struct{})}
+	for _, name := range append(append([]string{}, DefaultRedactFields...), extra...) {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := r.fields[name]; ok {
+			continue
+		}
+		r.fields[name] = struct{}{}
+		r.quoted = append(r.quoted, []byte(`"`+name+`"`))
+	}
+	return r
+}
+
+// redact returns the event with sensitive fields and Slack tokens scrubbed.
+// Field names match case-insensitively at any depth.
+func (r *redactor) redact(p []byte) []byte {
+	lower := bytes.ToLower(p)
+	hasField := false
+	for _, name := range r.quoted {
+		if bytes.Contains(lower, name) {
+			hasField = true
+			break
+		}
+	}
+	if !hasField {
+		return slackToken.ReplaceAll(p, []byte(Redacted))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(p))
+	dec.UseNumber()
+	var event map[string]interface{}
+	if err := dec.Decode(&event); err != nil {
+		return slackToken.ReplaceAll(p, []byte(Redacted))
+	}
+	r.scrub(event)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(event); err != nil {
+		return slackToken.ReplaceAll(p, []byte(Redacted))
+	}
+	return slackToken.ReplaceAll(buf.Bytes(), []byte(Redacted))
+}
+
+func (r *redactor) scrub(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if _, ok := r.fields[strings.ToLower(key)]; ok {
+				v[key] = Redacted
+				continue
+			}
+			r.scrub(field)
+		}
+	case []interface{}:
+		for _, item := range v {
+			r.scrub(item)
+		}
+	}
+}
+
+// redactingWriter scrubs each event before handing it to next, so neither
+// the console nor the exporter ever sees a secret.
+type redactingWriter struct {
+	next     zerolog.LevelWriter
+	redactor *redactor
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *redactingWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if _, err := w.next.WriteLevel(level, w.redactor.redact(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
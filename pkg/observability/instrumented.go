@@ -0,0 +1,223 @@
+package observability
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+)
+
+// InstrumentSlackClient records every Slack API call made through sc.
+func InstrumentSlackClient(sc domain.SlackClient, m *Metrics) domain.SlackClient {
+	if m == nil {
+		return sc
+	}
+	return &instrumentedSlackClient{next: sc, metrics: m}
+}
+
+type instrumentedSlackClient struct {
+	next    domain.SlackClient
+	metrics *Metrics
+}
+
+func (c *instrumentedSlackClient) ResolveChannelID(ctx context.Context, token, channelName string) (string, error) {
+	start := time.Now()
+	id, err := c.next.ResolveChannelID(ctx, token, channelName)
+	c.metrics.ObserveDependency(DependencySlack, "resolve_channel_id", start, err)
+	return id, err
+}
+
+func (c *instrumentedSlackClient) SendMessage(ctx context.Context, token, channelID, message string) error {
+	start := time.Now()
+	err := c.next.SendMessage(ctx, token, channelID, message)
+	c.metrics.ObserveDependency(DependencySlack, "send_message", start, err)
+	return err
+}
+
+func (c *instrumentedSlackClient) ExchangeCode(ctx context.Context, code string) (string, error) {
+	start := time.Now()
+	token, err := c.next.ExchangeCode(ctx, code)
+	c.metrics.ObserveDependency(DependencySlack, "exchange_code", start, err)
+	return token, err
+}
+
+// GetOAuthV2URL only builds a URL and is not recorded.
+func (c *instrumentedSlackClient) GetOAuthV2URL(state string) (string, error) {
+	return c.next.GetOAuthV2URL(state)
+}
+
+// InstrumentSecretsManager records every Secrets Manager call made through sm.
+func InstrumentSecretsManager(sm domain.SecretsManager, m *Metrics) domain.SecretsManager {
+	if m == nil {
+		return sm
+	}
+	return &instrumentedSecretsManager{next: sm, metrics: m}
+}
+
+type instrumentedSecretsManager struct {
+	next    domain.SecretsManager
+	metrics *Metrics
+}
+
+func (s *instrumentedSecretsManager) StoreToken(ctx context.Context, secretName, token string) error {
+	start := time.Now()
+	err := s.next.StoreToken(ctx, secretName, token)
+	s.metrics.ObserveDependency(DependencySecretsManager, "store_token", start, err)
+	return err
+}
+
+func (s *instrumentedSecretsManager) GetToken(ctx context.Context, secretName string) (string, error) {
+	start := time.Now()
+	token, err := s.next.GetToken(ctx, secretName)
+	s.metrics.ObserveDependency(DependencySecretsManager, "get_token", start, err)
+	return token, err
+}
+
+func (s *instrumentedSecretsManager) DeleteToken(ctx context.Context, secretName string) error {
+	start := time.Now()
+	err := s.next.DeleteToken(ctx, secretName)
+	s.metrics.ObserveDependency(DependencySecretsManager, "delete_token", start, err)
+	return err
+}
+
+func (s *instrumentedSecretsManager) RestoreToken(ctx context.Context, secretName string) error {
+	start := time.Now()
+	err := s.next.RestoreToken(ctx, secretName)
+	s.metrics.ObserveDependency(DependencySecretsManager, "restore_token", start, err)
+	return err
+}
+
+func (s *instrumentedSecretsManager) ListSecrets(ctx context.Context, prefix string) ([]domain.SecretSummary, error) {
+	start := time.Now()
+	secrets, err := s.next.ListSecrets(ctx, prefix)
+	s.metrics.ObserveDependency(DependencySecretsManager, "list_secrets", start, err)
+	return secrets, err
+}
+
+// InstrumentConnectorRepository records every query made through repo. Wrap
+// the Postgres repository itself, not a decorator around it, so the
+// durations are those of the database.
+func InstrumentConnectorRepository(repo domain.ConnectorRepository, m *Metrics) domain.ConnectorRepository {
+	if m == nil {
+		return repo
+	}
+	return &instrumentedConnectorRepository{next: repo, metrics: m}
+}
+
+type instrumentedConnectorRepository struct {
+	next    domain.ConnectorRepository
+	metrics *Metrics
+}
+
+func (r *instrumentedConnectorRepository) observe(operation string, start time.Time, err error) {
+	r.metrics.ObserveDependency(DependencyPostgres, operation, start, err)
+}
+
+func (r *instrumentedConnectorRepository) Create(ctx context.Context, c *domain.Connector) error {
+	start := time.Now()
+	err := r.next.Create(ctx, c)
+	r.observe("connector_create", start, err)
+	return err
+}
+
+func (r *instrumentedConnectorRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Connector, error) {
+	start := time.Now()
+	conn, err := r.next.GetByID(ctx, id)
+	r.observe("connector_get_by_id", start, ignoreNoRows(err))
+	return conn, err
+}
+
+func (r *instrumentedConnectorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	err := r.next.Delete(ctx, id)
+	r.observe("connector_delete", start, ignoreNoRows(err))
+	return err
+}
+
+func (r *instrumentedConnectorRepository) ListConnectors(ctx context.Context, limit int, cursor *domain.ListCursor) ([]domain.Connector, *domain.ListCursor, error) {
+	start := time.Now()
+	connectors, next, err := r.next.ListConnectors(ctx, limit, cursor)
+	r.observe("connector_list", start, err)
+	return connectors, next, err
+}
+
+func (r *instrumentedConnectorRepository) UpdateConnector(ctx context.Context, id uuid.UUID, token string) error {
+	start := time.Now()
+	err := r.next.UpdateConnector(ctx, id, token)
+	r.observe("connector_update_secret_version", start, ignoreNoRows(err))
+	return err
+}
+
+func (r *instrumentedConnectorRepository) ListByTenant(ctx context.Context, tenantID string, limit int) ([]domain.Connector, error) {
+	start := time.Now()
+	connectors, err := r.next.ListByTenant(ctx, tenantID, limit)
+	r.observe("connector_list_by_tenant", start, err)
+	return connectors, err
+}
+
+func (r *instrumentedConnectorRepository) GetByWorkspaceAndTenant(ctx context.Context, workspaceID, tenantID string) (*domain.Connector, error) {
+	start := time.Now()
+	conn, err := r.next.GetByWorkspaceAndTenant(ctx, workspaceID, tenantID)
+	r.observe("connector_get_by_workspace", start, ignoreNoRows(err))
+	return conn, err
+}
+
+func (r *instrumentedConnectorRepository) UpdateSecretName(ctx context.Context, id uuid.UUID, secretName string) error {
+	start := time.Now()
+	err := r.next.UpdateSecretName(ctx, id, secretName)
+	r.observe("connector_update_secret_name", start, ignoreNoRows(err))
+	return err
+}
+
+func (r *instrumentedConnectorRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ConnectorStatus) error {
+	start := time.Now()
+	err := r.next.UpdateStatus(ctx, id, status)
+	r.observe("connector_update_status", start, ignoreNoRows(err))
+	return err
+}
+
+func (r *instrumentedConnectorRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Connector, error) {
+	start := time.Now()
+	conn, err := r.next.GetDeletedByID(ctx, id)
+	r.observe("connector_get_deleted", start, ignoreNoRows(err))
+	return conn, err
+}
+
+func (r *instrumentedConnectorRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	err := r.next.Restore(ctx, id)
+	r.observe("connector_restore", start, ignoreNoRows(err))
+	return err
+}
+
+func (r *instrumentedConnectorRepository) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]domain.Connector, error) {
+	start := time.Now()
+	connectors, err := r.next.ListDeletedBefore(ctx, before, limit)
+	r.observe("connector_list_deleted", start, err)
+	return connectors, err
+}
+
+func (r *instrumentedConnectorRepository) ReferencesSecret(ctx context.Context, secretName string) (bool, error) {
+	start := time.Now()
+	exists, err := r.next.ReferencesSecret(ctx, secretName)
+	r.observe("connector_references_secret", start, err)
+	return exists, err
+}
+
+func (r *instrumentedConnectorRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	err := r.next.Purge(ctx, id)
+	r.observe("connector_purge", start, err)
+	return err
+}
+
+// ignoreNoRows does not count a lookup that found nothing as a failed query.
+func ignoreNoRows(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,261 @@
+package observability
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const metricsNamespace = "connector"
+
+// Dependency names used as the dependency label.
+const (
+	DependencySlack          = "slack"
+	DependencySecretsManager = "secrets_manager"
+	DependencyPostgres       = "postgres"
+	DependencyRedis          = "redis"
+)
+
+const (
+	outcomeSuccess = "success"
+	outcomeError   = "error"
+)
+
+// Metrics holds the service's Prometheus metrics: rate, errors and duration
+// for RPCs and for every outside dependency, plus circuit breaker state,
+// rate limiter waits and rotation outcomes. All methods are safe to call on a
+// nil *Metrics, which records nothing, so callers need not check whether
+// metrics are enabled.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcRequests        *prometheus.CounterVec
+	rpcDuration        *prometheus.HistogramVec
+	dependencyRequests *prometheus.CounterVec
+	dependencyDuration *prometheus.HistogramVec
+	breakerState       *prometheus.GaugeVec
+	workspaceBreakers  *prometheus.GaugeVec
+	rateLimitWait      *prometheus.HistogramVec
+	rotations          *prometheus.CounterVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_requests_total",
+			Help:      "RPCs handled, by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "Time to handle an RPC; for streams, how long the stream was open.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		dependencyRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "dependency_requests_total",
+			Help:      "Calls to outside dependencies, by dependency, operation and outcome.",
+		}, []string{"dependency", "operation", "outcome"}),
+		dependencyDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "dependency_duration_seconds",
+			Help:      "Time spent in calls to outside dependencies.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"dependency", "operation"}),
+		breakerState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "circuit_breaker_state",
+			Help:      "1 for the state each circuit breaker is in, 0 for the others.",
+		}, []string{"breaker", "state"}),
+		workspaceBreakers: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "circuit_breaker_workspaces",
+			Help:      "Per-workspace circuit breakers in each state.",
+		}, []string{"state"}),
+		rateLimitWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "rate_limiter_wait_seconds",
+			Help:      "Time spent waiting on a rate limiter.",
+			Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, []string{"limiter"}),
+		rotations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "secret_rotations_total",
+			Help:      "Connector secret rotations, by outcome.",
+		}, []string{"outcome"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcRequests, m.rpcDuration,
+		m.dependencyRequests, m.dependencyDuration,
+		m.breakerState, m.workspaceBreakers, m.rateLimitWait, m.rotations,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	if m == nil {
+		return http.NotFoundHandler()
+	}
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Gatherer exposes the registry, for tests and for pushing metrics elsewhere.
+func (m *Metrics) Gatherer() prometheus.Gatherer {
+	if m == nil {
+		return prometheus.NewRegistry()
+	}
+	return m.registry
+}
+
+// ObserveRPC records one finished RPC. method is the full gRPC method name.
+func (m *Metrics) ObserveRPC(method string, code codes.Code, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.rpcRequests.WithLabelValues(method, code.String()).Inc()
+	m.rpcDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// ObserveDependency records one call to an outside dependency that started at
+// start and returned err.
+func (m *Metrics) ObserveDependency(dependency, operation string, start time.Time, err error) {
+	if m == nil {
+		return
+	}
+	outcome := outcomeSuccess
+	if err != nil {
+		outcome = outcomeError
+	}
+	m.dependencyRequests.WithLabelValues(dependency, operation, outcome).Inc()
+	m.dependencyDuration.WithLabelValues(dependency, operation).Observe(time.Since(start).Seconds())
+}
+
+// SetCircuitBreakerState marks state as the current state of the breaker,
+// out of all the states it can be in.
+func (m *Metrics) SetCircuitBreakerState(breaker, state string, states ...string) {
+	if m == nil {
+		return
+	}
+	for _, s := range states {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		m.breakerState.WithLabelValues(breaker, s).Set(value)
+	}
+}
+
+// AddWorkspaceCircuitBreakers adds delta to the number of per-workspace
+// breakers in state. There can be one per workspace, too many to export each.
+func (m *Metrics) AddWorkspaceCircuitBreakers(state string, delta float64) {
+	if m == nil {
+		return
+	}
+	m.workspaceBreakers.WithLabelValues(state).Add(delta)
+}
+
+func (m *Metrics) ObserveRateLimitWait(limiter string, wait time.Duration) {
+	if m == nil {
+		return
+	}
+	m.rateLimitWait.WithLabelValues(limiter).Observe(wait.Seconds())
+}
+
+// RecordRotation counts one connector secret rotation.
+func (m *Metrics) RecordRotation(err error) {
+	if m == nil {
+		return
+	}
+	outcome := outcomeSuccess
+	if err != nil {
+		outcome = outcomeError
+	}
+	m.rotations.WithLabelValues(outcome).Inc()
+}
+
+// RegisterDB exports the connection pool statistics of db under the given
+// name.
+func (m *Metrics) RegisterDB(name string, db *sql.DB) {
+	if m == nil {
+		return
+	}
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// InstrumentRedis records every command and pipeline the client sends.
+func (m *Metrics) InstrumentRedis(client *redis.Client) {
+	if m == nil {
+		return
+	}
+	client.AddHook(redisMetricsHook{metrics: m})
+}
+
+type redisMetricsHook struct {
+	metrics *Metrics
+}
+
+func (h redisMetricsHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h redisMetricsHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmd)
+		h.metrics.ObserveDependency(DependencyRedis, cmd.Name(), start, redisError(err))
+		return err
+	}
+}
+
+func (h redisMetricsHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmds)
+		h.metrics.ObserveDependency(DependencyRedis, "pipeline", start, redisError(err))
+		return err
+	}
+}
+
+// redisError does not count a missing key as a failed call.
+func redisError(err error) error {
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	return err
+}
+
+// UnaryServerInterceptor records every unary RPC.
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		m.ObserveRPC(info.FullMethod, status.Code(err), time.Since(start))
+		return res, err
+	}
+}
+
+// StreamServerInterceptor records every streaming RPC once it ends.
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.ObserveRPC(info.FullMethod, status.Code(err), time.Since(start))
+		return err
+	}
+}
@@ -0,0 +1,294 @@
+package observability
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/pkg/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	logglobal "go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/propagation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"google.golang.org/grpc/credentials"
+)
+
+// collectorProbeTimeout bounds the startup check that the collector is reachable.
+const collectorProbeTimeout = 2 * time.Second
+
+// ErrCollectorUnreachable is returned by InitTelemetry when telemetry is
+// required but the OTLP collector cannot be reached.
+var ErrCollectorUnreachable = errors.New("OpenTelemetry collector unreachable")
+
+// Telemetry owns the tracer, meter and logger providers installed as the
+// OpenTelemetry globals.
+type Telemetry struct {
+	shutdown []func(context.Context) error
+}
+
+// InitTelemetry installs the tracer, meter and logger providers described by
+// cfg. A signal whose exporter is none keeps the no-op global provider. When
+// the collector cannot be reached the OTLP signals are disabled with a
+// warning, unless cfg.Required is set.
+func InitTelemetry(ctx context.Context, cfg config.TelemetryConfig) (*Telemetry, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	t := &Telemetry{}
+	if cfg.Disabled {
+		return t, nil
+	}
+
+	if cfg.UsesOTLP() {
+		if err := probeCollector(ctx, cfg.Endpoint); err != nil {
+			if cfg.Required {
+				return nil, fmt.Errorf("%w at %s: %v", ErrCollectorUnreachable, cfg.Endpoint, err)
+			}
+			logger.Warn().Err(err).Str("endpoint", cfg.Endpoint).
+				Msg("OpenTelemetry collector unreachable; OTLP export disabled")
+			cfg = withoutOTLP(cfg)
+		}
+	}
+
+	res, err := newResource(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	var creds credentials.TransportCredentials
+	if cfg.UsesOTLP() && !cfg.Insecure {
+		if creds, err = transportCredentials(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := t.initTracing(ctx, cfg, res, creds); err != nil {
+		return nil, errors.Join(err, t.Shutdown(ctx))
+	}
+	if err := t.initMetrics(ctx, cfg, res, creds); err != nil {
+		return nil, errors.Join(err, t.Shutdown(ctx))
+	}
+	if err := t.initLogs(ctx, cfg, res, creds); err != nil {
+		return nil, errors.Join(err, t.Shutdown(ctx))
+	}
+	return t, nil
+}
+
+// Shutdown flushes and stops every provider, waiting at most five seconds.
+func (t *Telemetry) Shutdown(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	var errs []error
+	for _, shutdown := range t.shutdown {
+		errs = append(errs, shutdown(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+func (t *Telemetry) initTracing(ctx context.Context, cfg config.TelemetryConfig, res *resource.Resource, creds credentials.TransportCredentials) error {
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch cfg.TracesExporter {
+	case config.TelemetryExporterOTLP:
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(cfg.Endpoint),
+			otlptracegrpc.WithHeaders(cfg.Headers),
+			otlptracegrpc.WithTimeout(cfg.Timeout),
+		}
+		if creds == nil {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		} else {
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(creds))
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case config.TelemetryExporterConsole:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stderr), stdouttrace.WithPrettyPrint())
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sampler(cfg.Sampler, cfg.SamplerArg)),
+	)
+	otel.SetTracerProvider(tp)
+	t.shutdown = append(t.shutdown, tp.Shutdown)
+	return nil
+}
+
+func (t *Telemetry) initMetrics(ctx context.Context, cfg config.TelemetryConfig, res *resource.Resource, creds credentials.TransportCredentials) error {
+	var (
+		exporter sdkmetric.Exporter
+		err      error
+	)
+	switch cfg.MetricsExporter {
+	case config.TelemetryExporterOTLP:
+		opts := []otlpmetricgrpc.Option{
+			otlpmetricgrpc.WithEndpoint(cfg.Endpoint),
+			otlpmetricgrpc.WithHeaders(cfg.Headers),
+			otlpmetricgrpc.WithTimeout(cfg.Timeout),
+		}
+		if creds == nil {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		} else {
+			opts = append(opts, otlpmetricgrpc.WithTLSCredentials(creds))
+		}
+		exporter, err = otlpmetricgrpc.New(ctx, opts...)
+	case config.TelemetryExporterConsole:
+		exporter, err = stdoutmetric.New(stdoutmetric.WithWriter(os.Stderr), stdoutmetric.WithPrettyPrint())
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create metric exporter: %w", err)
+	}
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter,
+			sdkmetric.WithInterval(cfg.MetricExportInterval))),
+		sdkmetric.WithResource(res),
+	)
+	otel.SetMeterProvider(mp)
+	t.shutdown = append(t.shutdown, mp.Shutdown)
+	return nil
+}
+
+func (t *Telemetry) initLogs(ctx context.Context, cfg config.TelemetryConfig, res *resource.Resource, creds credentials.TransportCredentials) error {
+	var (
+		exporter sdklog.Exporter
+		err      error
+	)
+	switch cfg.LogsExporter {
+	case config.TelemetryExporterOTLP:
+		opts := []otlploggrpc.Option{
+			otlploggrpc.WithEndpoint(cfg.Endpoint),
+			otlploggrpc.WithHeaders(cfg.Headers),
+			otlploggrpc.WithTimeout(cfg.Timeout),
+		}
+		if creds == nil {
+			opts = append(opts, otlploggrpc.WithInsecure())
+		} else {
+			opts = append(opts, otlploggrpc.WithTLSCredentials(creds))
+		}
+		exporter, err = otlploggrpc.New(ctx, opts...)
+	case config.TelemetryExporterConsole:
+		exporter, err = stdoutlog.New(stdoutlog.WithWriter(os.Stderr))
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create log exporter: %w", err)
+	}
+
+	lp := sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+		sdklog.WithResource(res),
+	)
+	logglobal.SetLoggerProvider(lp)
+	logger.ExportTo(lp)
+	t.shutdown = append(t.shutdown, lp.Shutdown)
+	return nil
+}
+
+func newResource(ctx context.Context, cfg config.TelemetryConfig) (*resource.Resource, error) {
+	attrs := []attribute.KeyValue{semconv.ServiceName(cfg.ServiceName)}
+	for k, v := range cfg.ResourceAttributes {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+	res, err := resource.New(ctx, resource.WithAttributes(attrs...), resource.WithTelemetrySDK())
+	if err != nil {
+		return nil, fmt.Errorf("failed to build telemetry resource: %w", err)
+	}
+	return res, nil
+}
+
+// sampler maps an OTEL_TRACES_SAMPLER value, already validated by
+// LoadTelemetryConfig, to its sampler.
+func sampler(name string, ratio float64) sdktrace.Sampler {
+	switch name {
+	case "always_on":
+		return sdktrace.AlwaysSample()
+	case "always_off":
+		return sdktrace.NeverSample()
+	case "traceidratio":
+		return sdktrace.TraceIDRatioBased(ratio)
+	case "parentbased_always_off":
+		return sdktrace.ParentBased(sdktrace.NeverSample())
+	case "parentbased_traceidratio":
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))
+	default:
+		return sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+}
+
+// transportCredentials builds the TLS settings for the collector connection:
+// the system roots or OTEL_EXPORTER_OTLP_CERTIFICATE, plus a client
+// certificate for mutual TLS when one is configured.
+func transportCredentials(cfg config.TelemetryConfig) (credentials.TransportCredentials, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.Certificate != "" {
+		pem, err := os.ReadFile(cfg.Certificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OTLP certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.Certificate)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if cfg.ClientCertificate != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertificate, cfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load OTLP client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// probeCollector checks that something accepts connections at endpoint. The
+// exporters connect lazily, so without this an absent collector would only
+// show up as failed exports.
+func probeCollector(ctx context.Context, endpoint string) error {
+	dialer := net.Dialer{Timeout: collectorProbeTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func withoutOTLP(cfg config.TelemetryConfig) config.TelemetryConfig {
+	for _, exporter := range []*string{&cfg.TracesExporter, &cfg.MetricsExporter, &cfg.LogsExporter} {
+		if *exporter == config.TelemetryExporterOTLP {
+			*exporter = config.TelemetryExporterNone
+		}
+	}
+	return cfg
+}
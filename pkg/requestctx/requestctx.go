@@ -0,0 +1,79 @@
+// Package requestctx carries caller identity and request correlation IDs
+// through a context.
+package requestctx
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type contextKey int
+
+const (
+	actorKey contextKey = iota
+	requestIDKey
+	tenantIDKey
+	connectorIDKey
+)
+
+// UnknownActor is reported for calls that did not identify their caller.
+const UnknownActor = "unknown"
+
+// AssertedActorPrefix marks an actor the caller named itself, without any
+// credential to back it, so the audit log does not present it as verified.
+const AssertedActorPrefix = "asserted:"
+
+// AssertedActor returns the actor recorded for a caller that named itself.
+func AssertedActor(name string) string {
+	return AssertedActorPrefix + name
+}
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+func Actor(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey).(string); ok && actor != "" {
+		return actor
+	}
+	return UnknownActor
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// WithTenantID records the tenant a request acts on, for log correlation.
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey, tenantID)
+}
+
+func TenantID(ctx context.Context) string {
+	tenantID, _ := ctx.Value(tenantIDKey).(string)
+	return tenantID
+}
+
+// WithConnectorID records the connector a request acts on, for log correlation.
+func WithConnectorID(ctx context.Context, connectorID string) context.Context {
+	return context.WithValue(ctx, connectorIDKey, connectorID)
+}
+
+func ConnectorID(ctx context.Context) string {
+	connectorID, _ := ctx.Value(connectorIDKey).(string)
+	return connectorID
+}
+
+// TraceID returns the ID of the active OpenTelemetry trace, or "" outside one.
+func TraceID(ctx context.Context) string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.HasTraceID() {
+		return ""
+	}
+	return spanCtx.TraceID().String()
+}
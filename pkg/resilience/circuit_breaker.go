@@ -2,30 +2,474 @@ package resilience
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
 	"github.com/connector-recruitment/internal/app/config"
-	"github.com/sony/gobreaker"
+	"github.com/connector-recruitment/pkg/observability"
 )
 
+// State is where the breaker's state machine is. A closed breaker lets calls
+// through, an open one rejects them and a half-open one lets a few through to
+// probe whether the dependency recovered.
+type State int
+
+const (
+	StateClosed State = iota
+	StateHalfOpen
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Mode says whether the state machine is in charge or an operator has forced
+// the breaker open or closed.
+type Mode int
+
+const (
+	ModeAuto Mode = iota
+	ModeForcedOpen
+	ModeForcedClosed
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeAuto:
+		return "auto"
+	case ModeForcedOpen:
+		return "forced_open"
+	case ModeForcedClosed:
+		return "forced_closed"
+	default:
+		return "unknown"
+	}
+}
+
+var breakerStates = []string{
+	StateClosed.String(),
+	StateHalfOpen.String(),
+	StateOpen.String(),
+}
+
+var (
+	// ErrOpen is returned without calling the dependency while the breaker
+	// is open.
+	ErrOpen = errors.New("circuit breaker is open")
+	// ErrTooManyRequests is returned while a half-open breaker already has
+	// as many probes in flight as it allows.
+	ErrTooManyRequests = errors.New("circuit breaker is half-open and already probing")
+)
+
+// Policy decides when the breaker trips. The ratio rules only apply once the
+// current window has seen MinRequests calls; a zero ratio turns its rule off.
+type Policy struct {
+	// MaxConsecutiveFailures trips the breaker when exceeded.
+	MaxConsecutiveFailures int
+	FailureRatio           float64
+	// SlowCallDuration marks slower calls as slow, whatever their outcome.
+	SlowCallDuration time.Duration
+	SlowCallRatio    float64
+	MinRequests      int
+	// Interval is how often a closed breaker clears its counts; zero never
+	// clears them.
+	Interval time.Duration
+	// Timeout is how long the breaker stays open before probing.
+	Timeout time.Duration
+	// HalfOpenRequests is how many probes may run at once, and how many must
+	// succeed in a row to close the breaker again.
+	HalfOpenRequests int
+}
+
+// PolicyFromConfig reads the CIRCUIT_BREAKER_* settings. Unset values fall
+// back to 5 consecutive failures, 10 minimum requests and 5 probes.
+func PolicyFromConfig(cfg *config.Config) Policy {
+	policy := Policy{
+		MaxConsecutiveFailures: cfg.CircuitBreakerMaxFailures,
+		FailureRatio:           cfg.CircuitBreakerFailureRatio,
+		SlowCallDuration:       cfg.CircuitBreakerSlowCallDuration,
+		SlowCallRatio:          cfg.CircuitBreakerSlowCallRatio,
+		MinRequests:            cfg.CircuitBreakerMinRequests,
+		Interval:               cfg.CircuitBreakerInterval,
+		Timeout:                cfg.CircuitBreakerTimeout,
+		HalfOpenRequests:       cfg.CircuitBreakerHalfOpenRequests,
+	}
+	if policy.MaxConsecutiveFailures <= 0 {
+		policy.MaxConsecutiveFailures = 5
+	}
+	if policy.MinRequests <= 0 {
+		policy.MinRequests = 10
+	}
+	if policy.HalfOpenRequests <= 0 {
+		policy.HalfOpenRequests = 5
+	}
+	return policy
+}
+
+// Counts are the outcomes seen in the current window. Client errors count as
+// successes: the dependency answered.
+type Counts struct {
+	Requests             int
+	Failures             int
+	SlowCalls            int
+	ConsecutiveFailures  int
+	ConsecutiveSuccesses int
+}
+
+// Snapshot is a point-in-time view of a breaker for the admin API.
+type Snapshot struct {
+	Name   string
+	State  State
+	Mode   Mode
+	Counts Counts
+	// Since is when the breaker entered its current state.
+	Since time.Time
+}
+
 type CircuitBreaker struct {
-	cb *gobreaker.CircuitBreaker
+	name          string
+	isClientError []func(error) bool
+	onStateChange func(name string, from, to State)
+	// onRelease is called once the registry has dropped the breaker.
+	onRelease func(state State)
+
+	mu         sync.Mutex
+	policy     Policy
+	state      State
+	mode       Mode
+	counts     Counts
+	generation uint64
+	since      time.Time
+	// expiry is when a closed breaker clears its counts or an open one
+	// starts probing; zero means never.
+	expiry   time.Time
+	inFlight int
+	// released is set once the registry has dropped the breaker; calls
+	// already holding it still work but no longer report transitions.
+	released bool
+}
+
+type Option func(*CircuitBreaker)
+
+// WithMetrics exports the breaker's state as a gauge, updated on every
+// transition. Per-workspace breakers are only counted by state.
+func WithMetrics(m *observability.Metrics) Option {
+	return func(c *CircuitBreaker) {
+		if isWorkspaceBreaker(c.name) {
+			m.AddWorkspaceCircuitBreakers(StateClosed.String(), 1)
+			c.onStateChange = func(_ string, from, to State) {
+				m.AddWorkspaceCircuitBreakers(from.String(), -1)
+				m.AddWorkspaceCircuitBreakers(to.String(), 1)
+			}
+			c.onRelease = func(state State) {
+				m.AddWorkspaceCircuitBreakers(state.String(), -1)
+			}
+			return
+		}
+		m.SetCircuitBreakerState(c.name, StateClosed.String(), breakerStates...)
+		c.onStateChange = func(name string, from, to State) {
+			m.SetCircuitBreakerState(name, to.String(), breakerStates...)
+		}
+	}
+}
+
+// WithClientErrors stops errors matching isClientError from counting as
+// failures, since they are the caller's fault rather than the dependency's.
+func WithClientErrors(isClientError func(error) bool) Option {
+	return func(c *CircuitBreaker) {
+		c.isClientError = append(c.isClientError, isClientError)
+	}
 }
 
-func New(name string, cfg *config.Config) *CircuitBreaker {
-	settings := gobreaker.Settings{
-		Name:        name,
-		MaxRequests: 5,
-		Interval:    cfg.CircuitBreakerInterval,
-		Timeout:     cfg.CircuitBreakerTimeout,
-		ReadyToTrip: func(counts gobreaker.Counts) bool {
-			return counts.ConsecutiveFailures > 5
-		},
+// WithPolicy replaces the policy read from the config.
+func WithPolicy(policy Policy) Option {
+	return func(c *CircuitBreaker) {
+		c.policy = policy
 	}
-	return &CircuitBreaker{
-		cb: gobreaker.NewCircuitBreaker(settings),
+}
+
+func New(name string, cfg *config.Config, opts ...Option) *CircuitBreaker {
+	c := &CircuitBreaker{
+		name:   name,
+		policy: PolicyFromConfig(cfg),
+		since:  time.Now(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.toNewGeneration(time.Now())
+	return c
+}
+
+func (c *CircuitBreaker) Name() string {
+	return c.name
 }
 
+// SetMaxFailures changes how many consecutive failures open the breaker.
+// Zero or less restores the default of 5.
+func (c *CircuitBreaker) SetMaxFailures(n int) {
+	if n <= 0 {
+		n = 5
+	}
+	c.mu.Lock()
+	c.policy.MaxConsecutiveFailures = n
+	c.mu.Unlock()
+}
+
+// Execute runs fn unless the breaker is open. A context that is already done
+// returns its error without calling fn, and a call cancelled by its caller is
+// not counted, as it says nothing about the dependency.
 func (c *CircuitBreaker) Execute(ctx context.Context, fn func() (interface{}, error)) (interface{}, error) {
-	return c.cb.Execute(fn)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	generation, counted, err := c.beforeRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	result, err := fn()
+	if counted {
+		c.afterRequest(generation, c.outcome(ctx, err, time.Since(start)))
+	}
+	return result, err
+}
+
+// Do is Execute for calls that only return an error.
+func (c *CircuitBreaker) Do(ctx context.Context, fn func() error) error {
+	_, err := c.Execute(ctx, func() (interface{}, error) {
+		return nil, fn()
+	})
+	return err
+}
+
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota
+	outcomeFailure
+	outcomeSlow
+	outcomeIgnored
+)
+
+func (c *CircuitBreaker) outcome(ctx context.Context, err error, elapsed time.Duration) outcome {
+	if err != nil && errors.Is(err, context.Canceled) && ctx.Err() != nil {
+		return outcomeIgnored
+	}
+	if err != nil && !c.clientError(err) {
+		return outcomeFailure
+	}
+	c.mu.Lock()
+	slow := c.policy.SlowCallDuration > 0 && elapsed >= c.policy.SlowCallDuration
+	c.mu.Unlock()
+	if slow {
+		return outcomeSlow
+	}
+	return outcomeSuccess
+}
+
+func (c *CircuitBreaker) clientError(err error) bool {
+	for _, isClientError := range c.isClientError {
+		if isClientError(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// beforeRequest reports whether the call may run and whether its outcome
+// counts; calls through a forced-closed breaker do not.
+func (c *CircuitBreaker) beforeRequest() (uint64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.mode {
+	case ModeForcedOpen:
+		return 0, false, ErrOpen
+	case ModeForcedClosed:
+		return 0, false, nil
+	}
+
+	now := time.Now()
+	state := c.currentState(now)
+	switch {
+	case state == StateOpen:
+		return 0, false, ErrOpen
+	case state == StateHalfOpen && c.inFlight >= c.policy.HalfOpenRequests:
+		return 0, false, ErrTooManyRequests
+	}
+	if state == StateHalfOpen {
+		c.inFlight++
+	}
+	c.counts.Requests++
+	return c.generation, true, nil
+}
+
+func (c *CircuitBreaker) afterRequest(generation uint64, result outcome) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	state := c.currentState(now)
+	if generation != c.generation || c.mode != ModeAuto {
+		return
+	}
+	if state == StateHalfOpen {
+		c.inFlight--
+	}
+
+	switch result {
+	case outcomeIgnored:
+		c.counts.Requests--
+	case outcomeFailure:
+		c.counts.Failures++
+		c.counts.ConsecutiveFailures++
+		c.counts.ConsecutiveSuccesses = 0
+	case outcomeSlow:
+		c.counts.SlowCalls++
+		fallthrough
+	case outcomeSuccess:
+		c.counts.ConsecutiveSuccesses++
+		c.counts.ConsecutiveFailures = 0
+	}
+
+	switch state {
+	case StateClosed:
+		if c.readyToTrip() {
+			c.setState(StateOpen, now)
+		}
+	case StateHalfOpen:
+		// A slow probe means the dependency has not recovered either.
+		if result == outcomeFailure || result == outcomeSlow {
+			c.setState(StateOpen, now)
+		} else if c.counts.ConsecutiveSuccesses >= c.policy.HalfOpenRequests {
+			c.setState(StateClosed, now)
+		}
+	}
+}
+
+func (c *CircuitBreaker) readyToTrip() bool {
+	counts, policy := c.counts, c.policy
+	if counts.ConsecutiveFailures > policy.MaxConsecutiveFailures {
+		return true
+	}
+	if counts.Requests < policy.MinRequests {
+		return false
+	}
+	requests := float64(counts.Requests)
+	if policy.FailureRatio > 0 && float64(counts.Failures)/requests >= policy.FailureRatio {
+		return true
+	}
+	return policy.SlowCallRatio > 0 && float64(counts.SlowCalls)/requests >= policy.SlowCallRatio
+}
+
+// currentState moves the breaker on when its window or open period has
+// expired.
+func (c *CircuitBreaker) currentState(now time.Time) State {
+	switch c.state {
+	case StateClosed:
+		if !c.expiry.IsZero() && c.expiry.Before(now) {
+			c.toNewGeneration(now)
+		}
+	case StateOpen:
+		if c.expiry.Before(now) {
+			c.setState(StateHalfOpen, now)
+		}
+	}
+	return c.state
+}
+
+func (c *CircuitBreaker) setState(state State, now time.Time) {
+	if c.state == state {
+		return
+	}
+	from := c.state
+	c.state = state
+	c.since = now
+	c.toNewGeneration(now)
+	if c.onStateChange != nil && !c.released {
+		c.onStateChange(c.name, from, state)
+	}
+}
+
+// release marks the breaker as dropped by the registry.
+func (c *CircuitBreaker) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.released {
+		return
+	}
+	c.released = true
+	if c.onRelease != nil {
+		c.onRelease(c.state)
+	}
+}
+
+// currentMode returns the breaker's mode.
+func (c *CircuitBreaker) currentMode() Mode {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.mode
+}
+
+func (c *CircuitBreaker) toNewGeneration(now time.Time) {
+	c.generation++
+	c.counts = Counts{}
+	c.inFlight = 0
+	c.expiry = time.Time{}
+	switch c.state {
+	case StateClosed:
+		if c.policy.Interval > 0 {
+			c.expiry = now.Add(c.policy.Interval)
+		}
+	case StateOpen:
+		c.expiry = now.Add(c.policy.Timeout)
+	}
+}
+
+// SetMode forces the breaker open or closed, or hands it back to the state
+// machine. Returning to ModeAuto starts from a closed breaker with fresh
+// counts.
+func (c *CircuitBreaker) SetMode(mode Mode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.mode = mode
+	switch mode {
+	case ModeForcedOpen:
+		c.setState(StateOpen, now)
+	case ModeForcedClosed, ModeAuto:
+		c.setState(StateClosed, now)
+		c.toNewGeneration(now)
+	}
+}
+
+func (c *CircuitBreaker) Snapshot() Snapshot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state := c.state
+	if c.mode == ModeAuto {
+		state = c.currentState(time.Now())
+	}
+	return Snapshot{
+		Name:   c.name,
+		State:  state,
+		Mode:   c.mode,
+		Counts: c.counts,
+		Since:  c.since,
+	}
 }
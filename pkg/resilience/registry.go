@@ -0,0 +1,155 @@
+package resilience
+
+import (
+	"container/list"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/connector-recruitment/internal/app/config"
+)
+
+// Breaker names for the dependencies the service calls. Per-workspace Slack
+// breakers are named by SlackWorkspace.
+const (
+	BreakerSlack          = "slack"
+	BreakerSecretsManager = "secretsmanager"
+	BreakerPostgres       = "postgres"
+)
+
+// defaultMaxWorkspaceBreakers bounds the per-workspace breakers kept at once
+// when CIRCUIT_BREAKER_MAX_WORKSPACES is unset.
+const defaultMaxWorkspaceBreakers = 10000
+
+// ErrUnknownBreaker is returned by SetMode for a name no call has used yet.
+var ErrUnknownBreaker = errors.New("unknown circuit breaker")
+
+// SlackWorkspace names the breaker for one Slack workspace, so a workspace
+// with a revoked token or a bad channel cannot trip Slack for everyone.
+func SlackWorkspace(workspaceID string) string {
+	return BreakerSlack + ":" + workspaceID
+}
+
+func isWorkspaceBreaker(name string) bool {
+	return strings.HasPrefix(name, BreakerSlack+":")
+}
+
+// Registry hands out one breaker per dependency, creating each on first use
+// with the same config and options. Workspace IDs come from callers, so only
+// the most recently used per-workspace breakers are kept; the least recently
+// used one is dropped when there are more, unless an operator forced its mode.
+type Registry struct {
+	cfg  *config.Config
+	opts []Option
+
+	mu          sync.Mutex
+	breakers    map[string]*CircuitBreaker
+	maxFailures int
+	// workspaces holds the names of per-workspace breakers, most recently
+	// used first.
+	workspaces    *list.List
+	workspaceUses map[string]*list.Element
+	maxWorkspaces int
+}
+
+func NewRegistry(cfg *config.Config, opts ...Option) *Registry {
+	maxWorkspaces := cfg.CircuitBreakerMaxWorkspaces
+	if maxWorkspaces <= 0 {
+		maxWorkspaces = defaultMaxWorkspaceBreakers
+	}
+	return &Registry{
+		cfg:           cfg,
+		opts:          opts,
+		breakers:      make(map[string]*CircuitBreaker),
+		workspaces:    list.New(),
+		workspaceUses: make(map[string]*list.Element),
+		maxWorkspaces: maxWorkspaces,
+	}
+}
+
+// Get returns the breaker called name, creating it if needed.
+func (r *Registry) Get(name string) *CircuitBreaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cb, ok := r.breakers[name]; ok {
+		if use, ok := r.workspaceUses[name]; ok {
+			r.workspaces.MoveToFront(use)
+		}
+		return cb
+	}
+	cb := New(name, r.cfg, r.opts...)
+	if r.maxFailures > 0 {
+		cb.SetMaxFailures(r.maxFailures)
+	}
+	r.breakers[name] = cb
+	if isWorkspaceBreaker(name) {
+		r.workspaceUses[name] = r.workspaces.PushFront(name)
+		r.evictWorkspaces()
+	}
+	return cb
+}
+
+// evictWorkspaces drops the least recently used per-workspace breakers in
+// automatic mode until there are at most maxWorkspaces. r.mu must be held.
+func (r *Registry) evictWorkspaces() {
+	use := r.workspaces.Back()
+	for r.workspaces.Len() > r.maxWorkspaces && use != nil {
+		name := use.Value.(string)
+		prev := use.Prev()
+		if cb := r.breakers[name]; cb.currentMode() == ModeAuto {
+			r.workspaces.Remove(use)
+			delete(r.workspaceUses, name)
+			delete(r.breakers, name)
+			cb.release()
+		}
+		use = prev
+	}
+}
+
+// Snapshots returns every breaker's state, ordered by name.
+func (r *Registry) Snapshots() []Snapshot {
+	r.mu.Lock()
+	breakers := make([]*CircuitBreaker, 0, len(r.breakers))
+	for _, cb := range r.breakers {
+		breakers = append(breakers, cb)
+	}
+	r.mu.Unlock()
+
+	snapshots := make([]Snapshot, 0, len(breakers))
+	for _, cb := range breakers {
+		snapshots = append(snapshots, cb.Snapshot())
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Name < snapshots[j].Name })
+	return snapshots
+}
+
+// SetMode forces the named breaker open or closed, or returns it to
+// automatic control.
+func (r *Registry) SetMode(name string, mode Mode) (Snapshot, error) {
+	r.mu.Lock()
+	cb, ok := r.breakers[name]
+	r.mu.Unlock()
+	if !ok {
+		return Snapshot{}, ErrUnknownBreaker
+	}
+	cb.SetMode(mode)
+	return cb.Snapshot(), nil
+}
+
+// SetMaxFailures changes the consecutive failure threshold of every breaker,
+// including those created later.
+func (r *Registry) SetMaxFailures(n int) {
+	r.mu.Lock()
+	r.maxFailures = n
+	breakers := make([]*CircuitBreaker, 0, len(r.breakers))
+	for _, cb := range r.breakers {
+		breakers = append(breakers, cb)
+	}
+	r.mu.Unlock()
+
+	for _, cb := range breakers {
+		cb.SetMaxFailures(n)
+	}
+}
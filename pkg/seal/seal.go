@@ -0,0 +1,168 @@
+// Package seal encrypts small secrets to an RSA public key so they can leave
+// the service, for example in a backup, and only the holder of the matching
+// private key can read them. Each secret gets a fresh AES-256-GCM key, which
+// is wrapped with RSA-OAEP (SHA-256).
+package seal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// MinKeyBits is the smallest RSA key accepted for sealing.
+const MinKeyBits = 2048
+
+var (
+	ErrInvalidKey = errors.New("invalid RSA key")
+	// ErrOpen is returned for a sealed secret that was not sealed to the key,
+	// was sealed for different associated data or has been altered.
+	ErrOpen = errors.New("sealed secret cannot be opened with this key")
+)
+
+// Sealed is a secret encrypted by Seal.
+type Sealed struct {
+	WrappedKey []byte
+	Nonce      []byte
+	Ciphertext []byte
+}
+
+// Seal encrypts plaintext to pub. The associated data is authenticated but
+// not encrypted; Open must be given the same value, which ties the secret to
+// its owner so it cannot be moved to another record.
+func Seal(pub *rsa.PublicKey, plaintext, associatedData []byte) (*Sealed, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("wrap key: %w", err)
+	}
+	return &Sealed{
+		WrappedKey: wrapped,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, associatedData),
+	}, nil
+}
+
+// Open decrypts a secret sealed to the public half of priv.
+func Open(priv *rsa.PrivateKey, sealed *Sealed, associatedData []byte) ([]byte, error) {
+	key, err := rsa.DecryptOAEP(sha256.New(), nil, priv, sealed.WrappedKey, nil)
+	if err != nil {
+		return nil, ErrOpen
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed.Nonce) != aead.NonceSize() {
+		return nil, ErrOpen
+	}
+	plaintext, err := aead.Open(nil, sealed.Nonce, sealed.Ciphertext, associatedData)
+	if err != nil {
+		return nil, ErrOpen
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Fingerprint identifies a public key as the hex SHA-256 of its PKIX
+// encoding, so a backup records which key can open it.
+func Fingerprint(pub *rsa.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParsePublicKeyPEM reads a "PUBLIC KEY" (PKIX) or "RSA PUBLIC KEY" (PKCS #1)
+// PEM block.
+func ParsePublicKeyPEM(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrInvalidKey)
+	}
+
+	var pub *rsa.PublicKey
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+		}
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("%w: not an RSA key", ErrInvalidKey)
+		}
+		pub = rsaKey
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+		}
+		pub = key
+	default:
+		return nil, fmt.Errorf("%w: unexpected PEM block %q", ErrInvalidKey, block.Type)
+	}
+
+	if pub.N.BitLen() < MinKeyBits {
+		return nil, fmt.Errorf("%w: key must be at least %d bits", ErrInvalidKey, MinKeyBits)
+	}
+	return pub, nil
+}
+
+// ParsePrivateKeyPEM reads a "PRIVATE KEY" (PKCS #8) or "RSA PRIVATE KEY"
+// (PKCS #1) PEM block.
+func ParsePrivateKeyPEM(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrInvalidKey)
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("%w: not an RSA key", ErrInvalidKey)
+		}
+		return rsaKey, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("%w: unexpected PEM block %q", ErrInvalidKey, block.Type)
+	}
+}
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/connector-recruitment/internal/app/connector"
 	"github.com/connector-recruitment/internal/domain"
 	pgRepo "github.com/connector-recruitment/internal/infrastructure/postgres"
 	"github.com/jmoiron/sqlx"
 )
 
-func SendSlackMessage(ctx context.Context, connectorID string, message string, db *sqlx.DB, smClient domain.SecretsManager, slackClient domain.SlackClient) error {
+// SendSlackMessage posts message to the connector's default channel. Reading
+// the connector's token is recorded in the audit log.
+func SendSlackMessage(ctx context.Context, connectorID string, message string, db *sqlx.DB, smClient domain.SecretsManager, slackClient domain.SlackClient, audit *connector.AuditService) error {
 	repository := pgRepo.NewConnectorRepository(db)
 	id, err := domain.ParseUUID(connectorID)
 	if err != nil {
@@ -21,8 +24,16 @@ func SendSlackMessage(ctx context.Context, connectorID string, message string, d
 		return fmt.Errorf("failed to retrieve connector: %w", err)
 	}
 
-	secretName := fmt.Sprintf("connector-%s-%s", conn.WorkspaceID, conn.TenantID)
-	token, err := smClient.GetToken(ctx, secretName)
+	tenant, err := pgRepo.NewTenantRepository(db).GetByID(ctx, conn.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve tenant: %w", err)
+	}
+	if !tenant.IsActive() {
+		return fmt.Errorf("tenant %s is %s; messages are blocked", tenant.ID, tenant.Status)
+	}
+
+	token, err := smClient.GetToken(ctx, conn.SecretName)
+	audit.Record(ctx, domain.AuditActionSecretRead, conn, err)
 	if err != nil {
 		return fmt.Errorf("failed to get token: %w", err)
 	}
@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+)
+
+// ErrPrivateAddress is returned for webhook endpoints on addresses that are
+// not publicly routable, which would let a subscription reach internal
+// services.
+var ErrPrivateAddress = errors.New("webhook endpoint resolves to a private address")
+
+// PublicAddress reports whether addr may receive webhook deliveries. Loopback,
+// private, link-local, multicast and unspecified addresses may not.
+func PublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified()
+}
+
+// PublicHost reports whether host, the host part of an endpoint URL, may
+// receive webhook deliveries as far as can be told without resolving it. A
+// hostname can still resolve to a private address; the sender checks the
+// address it connects to.
+func PublicHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return PublicAddress(addr)
+	}
+	return true
+}
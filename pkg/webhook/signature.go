@@ -0,0 +1,75 @@
+// Package webhook signs outgoing webhook requests. Receivers can use Verify
+// with the subscription secret to check that a request came from us and is
+// recent.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEventType = "X-Webhook-Event-Type"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+
+	signatureVersion = "v1"
+)
+
+var (
+	ErrInvalidSignature = errors.New("webhook signature does not match")
+	ErrInvalidTimestamp = errors.New("webhook timestamp is missing or outside the tolerance")
+)
+
+// Sign returns "v1=<hex hmac-sha256>" over "<unix timestamp>.<body>". Including
+// the timestamp in the signed content stops a captured request from being
+// replayed later with a fresh timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature header against the timestamp header and body. A
+// tolerance of zero skips the freshness check.
+func Verify(secret, timestampHeader, signatureHeader string, body []byte, tolerance time.Duration) error {
+	seconds, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	timestamp := time.Unix(seconds, 0)
+	if tolerance > 0 {
+		age := time.Since(timestamp)
+		if age > tolerance || age < -tolerance {
+			return ErrInvalidTimestamp
+		}
+	}
+
+	expected := Sign(secret, timestamp, body)
+	// Several signatures may be sent while a secret is being rolled.
+	for _, candidate := range strings.Split(signatureHeader, ",") {
+		if hmac.Equal([]byte(strings.TrimSpace(candidate)), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// Headers returns the headers to send with a signed delivery.
+func Headers(secret, deliveryID, eventType string, timestamp time.Time, body []byte) map[string]string {
+	return map[string]string{
+		"Content-Type":  "application/cloudevents+json",
+		HeaderID:        deliveryID,
+		HeaderEventType: eventType,
+		HeaderTimestamp: strconv.FormatInt(timestamp.Unix(), 10),
+		HeaderSignature: Sign(secret, timestamp, body),
+	}
+}
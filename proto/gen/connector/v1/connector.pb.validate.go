@@ -203,6 +203,17 @@ func (m *Connector) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := ConnectorStatus_name[int32(m.GetStatus())]; !ok {
+		err := ConnectorValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ConnectorMultiError(errors)
 	}
@@ -387,6 +398,17 @@ func (m *CreateConnectorRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		err := CreateConnectorRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateConnectorRequestMultiError(errors)
 	}
@@ -1117,6 +1139,252 @@ var _ interface {
 	ErrorName() string
 } = DeleteConnectorResponseValidationError{}
 
+// Validate checks the field values on RestoreConnectorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreConnectorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreConnectorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreConnectorRequestMultiError, or nil if none found.
+func (m *RestoreConnectorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreConnectorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RestoreConnectorRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := RestoreConnectorRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9a-fA-F\\\\-]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreConnectorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreConnectorRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreConnectorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreConnectorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreConnectorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreConnectorRequestMultiError) AllErrors() []error { return m }
+
+// RestoreConnectorRequestValidationError is the validation error returned by
+// RestoreConnectorRequest.Validate if the designated constraints aren't met.
+type RestoreConnectorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreConnectorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreConnectorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreConnectorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreConnectorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreConnectorRequestValidationError) ErrorName() string {
+	return "RestoreConnectorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreConnectorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreConnectorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreConnectorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreConnectorRequestValidationError{}
+
+var _RestoreConnectorRequest_Id_Pattern = regexp.MustCompile("^[0-9a-fA-F\\-]{36}$")
+
+// Validate checks the field values on RestoreConnectorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreConnectorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreConnectorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreConnectorResponseMultiError, or nil if none found.
+func (m *RestoreConnectorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreConnectorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConnector()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreConnectorResponseValidationError{
+					field:  "Connector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreConnectorResponseValidationError{
+					field:  "Connector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnector()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreConnectorResponseValidationError{
+				field:  "Connector",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreConnectorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreConnectorResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreConnectorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreConnectorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreConnectorResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreConnectorResponseMultiError) AllErrors() []error { return m }
+
+// RestoreConnectorResponseValidationError is the validation error returned by
+// RestoreConnectorResponse.Validate if the designated constraints aren't met.
+type RestoreConnectorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreConnectorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreConnectorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreConnectorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreConnectorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreConnectorResponseValidationError) ErrorName() string {
+	return "RestoreConnectorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreConnectorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreConnectorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreConnectorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreConnectorResponseValidationError{}
+
 // Validate checks the field values on GetOAuthV2URLRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1550,3 +1818,2148 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ExchangeOAuthCodeResponseValidationError{}
+
+// Validate checks the field values on ImportConnectorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportConnectorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportConnectorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportConnectorsRequestMultiError, or nil if none found.
+func (m *ImportConnectorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportConnectorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConnector()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportConnectorsRequestValidationError{
+					field:  "Connector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportConnectorsRequestValidationError{
+					field:  "Connector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnector()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportConnectorsRequestValidationError{
+				field:  "Connector",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportConnectorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportConnectorsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportConnectorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportConnectorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportConnectorsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportConnectorsRequestMultiError) AllErrors() []error { return m }
+
+// ImportConnectorsRequestValidationError is the validation error returned by
+// ImportConnectorsRequest.Validate if the designated constraints aren't met.
+type ImportConnectorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportConnectorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportConnectorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportConnectorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportConnectorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportConnectorsRequestValidationError) ErrorName() string {
+	return "ImportConnectorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportConnectorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportConnectorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportConnectorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportConnectorsRequestValidationError{}
+
+// Validate checks the field values on ImportConnectorsResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportConnectorsResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportConnectorsResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportConnectorsResultMultiError, or nil if none found.
+func (m *ImportConnectorsResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportConnectorsResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for TenantId
+
+	// no validation rules for Status
+
+	// no validation rules for ConnectorId
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return ImportConnectorsResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportConnectorsResultMultiError is an error wrapping multiple validation
+// errors returned by ImportConnectorsResult.ValidateAll() if the designated
+// constraints aren't met.
+type ImportConnectorsResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportConnectorsResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportConnectorsResultMultiError) AllErrors() []error { return m }
+
+// ImportConnectorsResultValidationError is the validation error returned by
+// ImportConnectorsResult.Validate if the designated constraints aren't met.
+type ImportConnectorsResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportConnectorsResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportConnectorsResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportConnectorsResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportConnectorsResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportConnectorsResultValidationError) ErrorName() string {
+	return "ImportConnectorsResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportConnectorsResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportConnectorsResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportConnectorsResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportConnectorsResultValidationError{}
+
+// Validate checks the field values on ImportConnectorsSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportConnectorsSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportConnectorsSummary with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportConnectorsSummaryMultiError, or nil if none found.
+func (m *ImportConnectorsSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportConnectorsSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Created
+
+	// no validation rules for AlreadyExists
+
+	// no validation rules for Duplicates
+
+	// no validation rules for Invalid
+
+	// no validation rules for Failed
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return ImportConnectorsSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportConnectorsSummaryMultiError is an error wrapping multiple validation
+// errors returned by ImportConnectorsSummary.ValidateAll() if the designated
+// constraints aren't met.
+type ImportConnectorsSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportConnectorsSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportConnectorsSummaryMultiError) AllErrors() []error { return m }
+
+// ImportConnectorsSummaryValidationError is the validation error returned by
+// ImportConnectorsSummary.Validate if the designated constraints aren't met.
+type ImportConnectorsSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportConnectorsSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportConnectorsSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportConnectorsSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportConnectorsSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportConnectorsSummaryValidationError) ErrorName() string {
+	return "ImportConnectorsSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportConnectorsSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportConnectorsSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportConnectorsSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportConnectorsSummaryValidationError{}
+
+// Validate checks the field values on ImportConnectorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportConnectorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportConnectorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportConnectorsResponseMultiError, or nil if none found.
+func (m *ImportConnectorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportConnectorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportConnectorsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportConnectorsResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportConnectorsResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportConnectorsResponseValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportConnectorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportConnectorsResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportConnectorsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportConnectorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportConnectorsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportConnectorsResponseMultiError) AllErrors() []error { return m }
+
+// ImportConnectorsResponseValidationError is the validation error returned by
+// ImportConnectorsResponse.Validate if the designated constraints aren't met.
+type ImportConnectorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportConnectorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportConnectorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportConnectorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportConnectorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportConnectorsResponseValidationError) ErrorName() string {
+	return "ImportConnectorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportConnectorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportConnectorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportConnectorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportConnectorsResponseValidationError{}
+
+// Validate checks the field values on WatchConnectorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchConnectorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchConnectorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchConnectorsRequestMultiError, or nil if none found.
+func (m *WatchConnectorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchConnectorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTenantId()); l < 1 || l > 32 {
+		err := WatchConnectorsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WatchConnectorsRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := WatchConnectorsRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResumeToken()) > 19 {
+		err := WatchConnectorsRequestValidationError{
+			field:  "ResumeToken",
+			reason: "value length must be at most 19 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WatchConnectorsRequest_ResumeToken_Pattern.MatchString(m.GetResumeToken()) {
+		err := WatchConnectorsRequestValidationError{
+			field:  "ResumeToken",
+			reason: "value does not match regex pattern \"^[0-9]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchConnectorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchConnectorsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchConnectorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchConnectorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchConnectorsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchConnectorsRequestMultiError) AllErrors() []error { return m }
+
+// WatchConnectorsRequestValidationError is the validation error returned by
+// WatchConnectorsRequest.Validate if the designated constraints aren't met.
+type WatchConnectorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchConnectorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchConnectorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchConnectorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchConnectorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchConnectorsRequestValidationError) ErrorName() string {
+	return "WatchConnectorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchConnectorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchConnectorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchConnectorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchConnectorsRequestValidationError{}
+
+var _WatchConnectorsRequest_TenantId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _WatchConnectorsRequest_ResumeToken_Pattern = regexp.MustCompile("^[0-9]*$")
+
+// Validate checks the field values on ConnectorEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConnectorEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectorEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConnectorEventMultiError,
+// or nil if none found.
+func (m *ConnectorEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectorEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectorEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectorEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectorEventValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConnector()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectorEventValidationError{
+					field:  "Connector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectorEventValidationError{
+					field:  "Connector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnector()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectorEventValidationError{
+				field:  "Connector",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectorEventValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectorEventValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectorEventValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConnectorEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectorEventMultiError is an error wrapping multiple validation errors
+// returned by ConnectorEvent.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectorEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectorEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectorEventMultiError) AllErrors() []error { return m }
+
+// ConnectorEventValidationError is the validation error returned by
+// ConnectorEvent.Validate if the designated constraints aren't met.
+type ConnectorEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectorEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectorEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectorEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectorEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectorEventValidationError) ErrorName() string { return "ConnectorEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectorEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectorEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectorEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectorEventValidationError{}
+
+// Validate checks the field values on WatchHeartbeat with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchHeartbeat) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchHeartbeat with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchHeartbeatMultiError,
+// or nil if none found.
+func (m *WatchHeartbeat) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchHeartbeat) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchHeartbeatValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchHeartbeatValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchHeartbeatValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchHeartbeatMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchHeartbeatMultiError is an error wrapping multiple validation errors
+// returned by WatchHeartbeat.ValidateAll() if the designated constraints
+// aren't met.
+type WatchHeartbeatMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchHeartbeatMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchHeartbeatMultiError) AllErrors() []error { return m }
+
+// WatchHeartbeatValidationError is the validation error returned by
+// WatchHeartbeat.Validate if the designated constraints aren't met.
+type WatchHeartbeatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchHeartbeatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchHeartbeatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchHeartbeatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchHeartbeatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchHeartbeatValidationError) ErrorName() string { return "WatchHeartbeatValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchHeartbeatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchHeartbeat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchHeartbeatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchHeartbeatValidationError{}
+
+// Validate checks the field values on WatchConnectorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchConnectorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchConnectorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchConnectorsResponseMultiError, or nil if none found.
+func (m *WatchConnectorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchConnectorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchConnectorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchConnectorsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchConnectorsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchConnectorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchConnectorsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchConnectorsResponseMultiError) AllErrors() []error { return m }
+
+// WatchConnectorsResponseValidationError is the validation error returned by
+// WatchConnectorsResponse.Validate if the designated constraints aren't met.
+type WatchConnectorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchConnectorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchConnectorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchConnectorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchConnectorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchConnectorsResponseValidationError) ErrorName() string {
+	return "WatchConnectorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchConnectorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchConnectorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchConnectorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchConnectorsResponseValidationError{}
+
+// Validate checks the field values on ExportConnectorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportConnectorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportConnectorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportConnectorsRequestMultiError, or nil if none found.
+func (m *ExportConnectorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportConnectorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) > 32 {
+		err := ExportConnectorsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ExportConnectorsRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := ExportConnectorsRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPublicKeyPem()) > 16384 {
+		err := ExportConnectorsRequestValidationError{
+			field:  "PublicKeyPem",
+			reason: "value length must be at most 16384 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportConnectorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportConnectorsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportConnectorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportConnectorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportConnectorsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportConnectorsRequestMultiError) AllErrors() []error { return m }
+
+// ExportConnectorsRequestValidationError is the validation error returned by
+// ExportConnectorsRequest.Validate if the designated constraints aren't met.
+type ExportConnectorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportConnectorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportConnectorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportConnectorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportConnectorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportConnectorsRequestValidationError) ErrorName() string {
+	return "ExportConnectorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportConnectorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportConnectorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportConnectorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportConnectorsRequestValidationError{}
+
+var _ExportConnectorsRequest_TenantId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]*$")
+
+// Validate checks the field values on ExportHeader with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportHeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportHeader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportHeaderMultiError, or
+// nil if none found.
+func (m *ExportHeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportHeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FormatVersion
+
+	if all {
+		switch v := interface{}(m.GetExportedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportHeaderValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportHeaderValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportHeaderValidationError{
+				field:  "ExportedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TenantId
+
+	// no validation rules for KeyFingerprint
+
+	if len(errors) > 0 {
+		return ExportHeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportHeaderMultiError is an error wrapping multiple validation errors
+// returned by ExportHeader.ValidateAll() if the designated constraints aren't met.
+type ExportHeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportHeaderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportHeaderMultiError) AllErrors() []error { return m }
+
+// ExportHeaderValidationError is the validation error returned by
+// ExportHeader.Validate if the designated constraints aren't met.
+type ExportHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportHeaderValidationError) ErrorName() string { return "ExportHeaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportHeaderValidationError{}
+
+// Validate checks the field values on SealedSecret with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SealedSecret) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SealedSecret with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SealedSecretMultiError, or
+// nil if none found.
+func (m *SealedSecret) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SealedSecret) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WrappedKey
+
+	// no validation rules for Nonce
+
+	// no validation rules for Ciphertext
+
+	if len(errors) > 0 {
+		return SealedSecretMultiError(errors)
+	}
+
+	return nil
+}
+
+// SealedSecretMultiError is an error wrapping multiple validation errors
+// returned by SealedSecret.ValidateAll() if the designated constraints aren't met.
+type SealedSecretMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SealedSecretMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SealedSecretMultiError) AllErrors() []error { return m }
+
+// SealedSecretValidationError is the validation error returned by
+// SealedSecret.Validate if the designated constraints aren't met.
+type SealedSecretValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SealedSecretValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SealedSecretValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SealedSecretValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SealedSecretValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SealedSecretValidationError) ErrorName() string { return "SealedSecretValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SealedSecretValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSealedSecret.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SealedSecretValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SealedSecretValidationError{}
+
+// Validate checks the field values on ExportedConnector with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportedConnector) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportedConnector with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportedConnectorMultiError, or nil if none found.
+func (m *ExportedConnector) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportedConnector) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetWorkspaceId()); l < 1 || l > 32 {
+		err := ExportedConnectorValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ExportedConnector_WorkspaceId_Pattern.MatchString(m.GetWorkspaceId()) {
+		err := ExportedConnectorValidationError{
+			field:  "WorkspaceId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTenantId()); l < 1 || l > 32 {
+		err := ExportedConnectorValidationError{
+			field:  "TenantId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ExportedConnector_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := ExportedConnectorValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetDefaultChannelId()); l < 1 || l > 100 {
+		err := ExportedConnectorValidationError{
+			field:  "DefaultChannelId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ExportedConnector_DefaultChannelId_Pattern.MatchString(m.GetDefaultChannelId()) {
+		err := ExportedConnectorValidationError{
+			field:  "DefaultChannelId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ConnectorStatus_name[int32(m.GetStatus())]; !ok {
+		err := ExportedConnectorValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportedConnectorValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportedConnectorValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportedConnectorValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportedConnectorValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportedConnectorValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportedConnectorValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportedConnectorValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportedConnectorValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportedConnectorValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportedConnectorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportedConnectorMultiError is an error wrapping multiple validation errors
+// returned by ExportedConnector.ValidateAll() if the designated constraints
+// aren't met.
+type ExportedConnectorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportedConnectorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportedConnectorMultiError) AllErrors() []error { return m }
+
+// ExportedConnectorValidationError is the validation error returned by
+// ExportedConnector.Validate if the designated constraints aren't met.
+type ExportedConnectorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportedConnectorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportedConnectorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportedConnectorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportedConnectorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportedConnectorValidationError) ErrorName() string {
+	return "ExportedConnectorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportedConnectorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportedConnector.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportedConnectorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportedConnectorValidationError{}
+
+var _ExportedConnector_WorkspaceId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _ExportedConnector_TenantId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _ExportedConnector_DefaultChannelId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ExportRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportRecordMultiError, or
+// nil if none found.
+func (m *ExportRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExportRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportRecordMultiError is an error wrapping multiple validation errors
+// returned by ExportRecord.ValidateAll() if the designated constraints aren't met.
+type ExportRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportRecordMultiError) AllErrors() []error { return m }
+
+// ExportRecordValidationError is the validation error returned by
+// ExportRecord.Validate if the designated constraints aren't met.
+type ExportRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRecordValidationError) ErrorName() string { return "ExportRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRecordValidationError{}
+
+// Validate checks the field values on RestoreConnectorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreConnectorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreConnectorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreConnectorsRequestMultiError, or nil if none found.
+func (m *RestoreConnectorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreConnectorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreConnectorsRequestValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreConnectorsRequestValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreConnectorsRequestValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return RestoreConnectorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreConnectorsRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreConnectorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreConnectorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreConnectorsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreConnectorsRequestMultiError) AllErrors() []error { return m }
+
+// RestoreConnectorsRequestValidationError is the validation error returned by
+// RestoreConnectorsRequest.Validate if the designated constraints aren't met.
+type RestoreConnectorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreConnectorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreConnectorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreConnectorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreConnectorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreConnectorsRequestValidationError) ErrorName() string {
+	return "RestoreConnectorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreConnectorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreConnectorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreConnectorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreConnectorsRequestValidationError{}
+
+// Validate checks the field values on RestoreConnectorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreConnectorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreConnectorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreConnectorsResponseMultiError, or nil if none found.
+func (m *RestoreConnectorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreConnectorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RestoreConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RestoreConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RestoreConnectorsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreConnectorsResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreConnectorsResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreConnectorsResponseValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreConnectorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreConnectorsResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreConnectorsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RestoreConnectorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreConnectorsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreConnectorsResponseMultiError) AllErrors() []error { return m }
+
+// RestoreConnectorsResponseValidationError is the validation error returned by
+// RestoreConnectorsResponse.Validate if the designated constraints aren't met.
+type RestoreConnectorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreConnectorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreConnectorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreConnectorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreConnectorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreConnectorsResponseValidationError) ErrorName() string {
+	return "RestoreConnectorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreConnectorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreConnectorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreConnectorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreConnectorsResponseValidationError{}
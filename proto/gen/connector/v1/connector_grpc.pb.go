@@ -22,8 +22,13 @@ const (
 	ConnectorService_CreateConnector_FullMethodName   = "/connector.v1.ConnectorService/CreateConnector"
 	ConnectorService_GetConnector_FullMethodName      = "/connector.v1.ConnectorService/GetConnector"
 	ConnectorService_DeleteConnector_FullMethodName   = "/connector.v1.ConnectorService/DeleteConnector"
+	ConnectorService_RestoreConnector_FullMethodName  = "/connector.v1.ConnectorService/RestoreConnector"
 	ConnectorService_GetOAuthV2URL_FullMethodName     = "/connector.v1.ConnectorService/GetOAuthV2URL"
 	ConnectorService_ExchangeOAuthCode_FullMethodName = "/connector.v1.ConnectorService/ExchangeOAuthCode"
+	ConnectorService_WatchConnectors_FullMethodName   = "/connector.v1.ConnectorService/WatchConnectors"
+	ConnectorService_ImportConnectors_FullMethodName  = "/connector.v1.ConnectorService/ImportConnectors"
+	ConnectorService_ExportConnectors_FullMethodName  = "/connector.v1.ConnectorService/ExportConnectors"
+	ConnectorService_RestoreConnectors_FullMethodName = "/connector.v1.ConnectorService/RestoreConnectors"
 )
 
 // ConnectorServiceClient is the client API for ConnectorService service.
@@ -32,9 +37,24 @@ const (
 type ConnectorServiceClient interface {
 	CreateConnector(ctx context.Context, in *CreateConnectorRequest, opts ...grpc.CallOption) (*CreateConnectorResponse, error)
 	GetConnector(ctx context.Context, in *GetConnectorRequest, opts ...grpc.CallOption) (*GetConnectorResponse, error)
+	// workspace_id and tenant_id are passed as query parameters.
 	DeleteConnector(ctx context.Context, in *DeleteConnectorRequest, opts ...grpc.CallOption) (*DeleteConnectorResponse, error)
+	// Undoes a delete while the connector's secret is still in its recovery window.
+	RestoreConnector(ctx context.Context, in *RestoreConnectorRequest, opts ...grpc.CallOption) (*RestoreConnectorResponse, error)
 	GetOAuthV2URL(ctx context.Context, in *GetOAuthV2URLRequest, opts ...grpc.CallOption) (*GetOAuthV2URLResponse, error)
 	ExchangeOAuthCode(ctx context.Context, in *ExchangeOAuthCodeRequest, opts ...grpc.CallOption) (*ExchangeOAuthCodeResponse, error)
+	// Streams a tenant's connector changes. A stream that falls behind ends with
+	// ABORTED; a resume token older than the event retention ends with
+	// OUT_OF_RANGE, after which the caller should reload its state.
+	WatchConnectors(ctx context.Context, in *WatchConnectorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchConnectorsResponse], error)
+	// Creates one connector per request message, several at a time, and reports
+	// each of them once the client closes the stream.
+	ImportConnectors(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportConnectorsRequest, ImportConnectorsResponse], error)
+	// Streams a header followed by every live connector, for backups.
+	ExportConnectors(ctx context.Context, in *ExportConnectorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportRecord], error)
+	// Recreates connectors from an export with their original IDs. Connectors
+	// that still exist are left alone.
+	RestoreConnectors(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[RestoreConnectorsRequest, RestoreConnectorsResponse], error)
 }
 
 type connectorServiceClient struct {
@@ -75,6 +95,16 @@ func (c *connectorServiceClient) DeleteConnector(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *connectorServiceClient) RestoreConnector(ctx context.Context, in *RestoreConnectorRequest, opts ...grpc.CallOption) (*RestoreConnectorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreConnectorResponse)
+	err := c.cc.Invoke(ctx, ConnectorService_RestoreConnector_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *connectorServiceClient) GetOAuthV2URL(ctx context.Context, in *GetOAuthV2URLRequest, opts ...grpc.CallOption) (*GetOAuthV2URLResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOAuthV2URLResponse)
@@ -95,15 +125,94 @@ func (c *connectorServiceClient) ExchangeOAuthCode(ctx context.Context, in *Exch
 	return out, nil
 }
 
+func (c *connectorServiceClient) WatchConnectors(ctx context.Context, in *WatchConnectorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchConnectorsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConnectorService_ServiceDesc.Streams[0], ConnectorService_WatchConnectors_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchConnectorsRequest, WatchConnectorsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConnectorService_WatchConnectorsClient = grpc.ServerStreamingClient[WatchConnectorsResponse]
+
+func (c *connectorServiceClient) ImportConnectors(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportConnectorsRequest, ImportConnectorsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConnectorService_ServiceDesc.Streams[1], ConnectorService_ImportConnectors_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportConnectorsRequest, ImportConnectorsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConnectorService_ImportConnectorsClient = grpc.ClientStreamingClient[ImportConnectorsRequest, ImportConnectorsResponse]
+
+func (c *connectorServiceClient) ExportConnectors(ctx context.Context, in *ExportConnectorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportRecord], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConnectorService_ServiceDesc.Streams[2], ConnectorService_ExportConnectors_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportConnectorsRequest, ExportRecord]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConnectorService_ExportConnectorsClient = grpc.ServerStreamingClient[ExportRecord]
+
+func (c *connectorServiceClient) RestoreConnectors(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[RestoreConnectorsRequest, RestoreConnectorsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConnectorService_ServiceDesc.Streams[3], ConnectorService_RestoreConnectors_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RestoreConnectorsRequest, RestoreConnectorsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConnectorService_RestoreConnectorsClient = grpc.ClientStreamingClient[RestoreConnectorsRequest, RestoreConnectorsResponse]
+
 // ConnectorServiceServer is the server API for ConnectorService service.
 // All implementations must embed UnimplementedConnectorServiceServer
 // for forward compatibility.
 type ConnectorServiceServer interface {
 	CreateConnector(context.Context, *CreateConnectorRequest) (*CreateConnectorResponse, error)
 	GetConnector(context.Context, *GetConnectorRequest) (*GetConnectorResponse, error)
+	// workspace_id and tenant_id are passed as query parameters.
 	DeleteConnector(context.Context, *DeleteConnectorRequest) (*DeleteConnectorResponse, error)
+	// Undoes a delete while the connector's secret is still in its recovery window.
+	RestoreConnector(context.Context, *RestoreConnectorRequest) (*RestoreConnectorResponse, error)
 	GetOAuthV2URL(context.Context, *GetOAuthV2URLRequest) (*GetOAuthV2URLResponse, error)
 	ExchangeOAuthCode(context.Context, *ExchangeOAuthCodeRequest) (*ExchangeOAuthCodeResponse, error)
+	// Streams a tenant's connector changes. A stream that falls behind ends with
+	// ABORTED; a resume token older than the event retention ends with
+	// OUT_OF_RANGE, after which the caller should reload its state.
+	WatchConnectors(*WatchConnectorsRequest, grpc.ServerStreamingServer[WatchConnectorsResponse]) error
+	// Creates one connector per request message, several at a time, and reports
+	// each of them once the client closes the stream.
+	ImportConnectors(grpc.ClientStreamingServer[ImportConnectorsRequest, ImportConnectorsResponse]) error
+	// Streams a header followed by every live connector, for backups.
+	ExportConnectors(*ExportConnectorsRequest, grpc.ServerStreamingServer[ExportRecord]) error
+	// Recreates connectors from an export with their original IDs. Connectors
+	// that still exist are left alone.
+	RestoreConnectors(grpc.ClientStreamingServer[RestoreConnectorsRequest, RestoreConnectorsResponse]) error
 	mustEmbedUnimplementedConnectorServiceServer()
 }
 
@@ -123,12 +232,27 @@ func (UnimplementedConnectorServiceServer) GetConnector(context.Context, *GetCon
 func (UnimplementedConnectorServiceServer) DeleteConnector(context.Context, *DeleteConnectorRequest) (*DeleteConnectorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnector not implemented")
 }
+func (UnimplementedConnectorServiceServer) RestoreConnector(context.Context, *RestoreConnectorRequest) (*RestoreConnectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConnector not implemented")
+}
 func (UnimplementedConnectorServiceServer) GetOAuthV2URL(context.Context, *GetOAuthV2URLRequest) (*GetOAuthV2URLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOAuthV2URL not implemented")
 }
 func (UnimplementedConnectorServiceServer) ExchangeOAuthCode(context.Context, *ExchangeOAuthCodeRequest) (*ExchangeOAuthCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeOAuthCode not implemented")
 }
+func (UnimplementedConnectorServiceServer) WatchConnectors(*WatchConnectorsRequest, grpc.ServerStreamingServer[WatchConnectorsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConnectors not implemented")
+}
+func (UnimplementedConnectorServiceServer) ImportConnectors(grpc.ClientStreamingServer[ImportConnectorsRequest, ImportConnectorsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportConnectors not implemented")
+}
+func (UnimplementedConnectorServiceServer) ExportConnectors(*ExportConnectorsRequest, grpc.ServerStreamingServer[ExportRecord]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportConnectors not implemented")
+}
+func (UnimplementedConnectorServiceServer) RestoreConnectors(grpc.ClientStreamingServer[RestoreConnectorsRequest, RestoreConnectorsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreConnectors not implemented")
+}
 func (UnimplementedConnectorServiceServer) mustEmbedUnimplementedConnectorServiceServer() {}
 func (UnimplementedConnectorServiceServer) testEmbeddedByValue()                          {}
 
@@ -204,6 +328,24 @@ func _ConnectorService_DeleteConnector_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConnectorService_RestoreConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreConnectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectorServiceServer).RestoreConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectorService_RestoreConnector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectorServiceServer).RestoreConnector(ctx, req.(*RestoreConnectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConnectorService_GetOAuthV2URL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOAuthV2URLRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +382,42 @@ func _ConnectorService_ExchangeOAuthCode_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConnectorService_WatchConnectors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConnectorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectorServiceServer).WatchConnectors(m, &grpc.GenericServerStream[WatchConnectorsRequest, WatchConnectorsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConnectorService_WatchConnectorsServer = grpc.ServerStreamingServer[WatchConnectorsResponse]
+
+func _ConnectorService_ImportConnectors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConnectorServiceServer).ImportConnectors(&grpc.GenericServerStream[ImportConnectorsRequest, ImportConnectorsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConnectorService_ImportConnectorsServer = grpc.ClientStreamingServer[ImportConnectorsRequest, ImportConnectorsResponse]
+
+func _ConnectorService_ExportConnectors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportConnectorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectorServiceServer).ExportConnectors(m, &grpc.GenericServerStream[ExportConnectorsRequest, ExportRecord]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConnectorService_ExportConnectorsServer = grpc.ServerStreamingServer[ExportRecord]
+
+func _ConnectorService_RestoreConnectors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConnectorServiceServer).RestoreConnectors(&grpc.GenericServerStream[RestoreConnectorsRequest, RestoreConnectorsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConnectorService_RestoreConnectorsServer = grpc.ClientStreamingServer[RestoreConnectorsRequest, RestoreConnectorsResponse]
+
 // ConnectorService_ServiceDesc is the grpc.ServiceDesc for ConnectorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +437,10 @@ var ConnectorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteConnector",
 			Handler:    _ConnectorService_DeleteConnector_Handler,
 		},
+		{
+			MethodName: "RestoreConnector",
+			Handler:    _ConnectorService_RestoreConnector_Handler,
+		},
 		{
 			MethodName: "GetOAuthV2URL",
 			Handler:    _ConnectorService_GetOAuthV2URL_Handler,
@@ -268,6 +450,27 @@ var ConnectorService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ConnectorService_ExchangeOAuthCode_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConnectors",
+			Handler:       _ConnectorService_WatchConnectors_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportConnectors",
+			Handler:       _ConnectorService_ImportConnectors_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportConnectors",
+			Handler:       _ConnectorService_ExportConnectors_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreConnectors",
+			Handler:       _ConnectorService_RestoreConnectors_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "connector/v1/connector.proto",
 }
@@ -0,0 +1,390 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: connector/v1/connector.proto
+
+package connectorv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConnectorServiceName is the fully-qualified name of the ConnectorService service.
+	ConnectorServiceName = "connector.v1.ConnectorService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConnectorServiceCreateConnectorProcedure is the fully-qualified name of the ConnectorService's
+	// CreateConnector RPC.
+	ConnectorServiceCreateConnectorProcedure = "/connector.v1.ConnectorService/CreateConnector"
+	// ConnectorServiceGetConnectorProcedure is the fully-qualified name of the ConnectorService's
+	// GetConnector RPC.
+	ConnectorServiceGetConnectorProcedure = "/connector.v1.ConnectorService/GetConnector"
+	// ConnectorServiceDeleteConnectorProcedure is the fully-qualified name of the ConnectorService's
+	// DeleteConnector RPC.
+	ConnectorServiceDeleteConnectorProcedure = "/connector.v1.ConnectorService/DeleteConnector"
+	// ConnectorServiceRestoreConnectorProcedure is the fully-qualified name of the ConnectorService's
+	// RestoreConnector RPC.
+	ConnectorServiceRestoreConnectorProcedure = "/connector.v1.ConnectorService/RestoreConnector"
+	// ConnectorServiceGetOAuthV2URLProcedure is the fully-qualified name of the ConnectorService's
+	// GetOAuthV2URL RPC.
+	ConnectorServiceGetOAuthV2URLProcedure = "/connector.v1.ConnectorService/GetOAuthV2URL"
+	// ConnectorServiceExchangeOAuthCodeProcedure is the fully-qualified name of the ConnectorService's
+	// ExchangeOAuthCode RPC.
+	ConnectorServiceExchangeOAuthCodeProcedure = "/connector.v1.ConnectorService/ExchangeOAuthCode"
+	// ConnectorServiceWatchConnectorsProcedure is the fully-qualified name of the ConnectorService's
+	// WatchConnectors RPC.
+	ConnectorServiceWatchConnectorsProcedure = "/connector.v1.ConnectorService/WatchConnectors"
+	// ConnectorServiceImportConnectorsProcedure is the fully-qualified name of the ConnectorService's
+	// ImportConnectors RPC.
+	ConnectorServiceImportConnectorsProcedure = "/connector.v1.ConnectorService/ImportConnectors"
+	// ConnectorServiceExportConnectorsProcedure is the fully-qualified name of the ConnectorService's
+	// ExportConnectors RPC.
+	ConnectorServiceExportConnectorsProcedure = "/connector.v1.ConnectorService/ExportConnectors"
+	// ConnectorServiceRestoreConnectorsProcedure is the fully-qualified name of the ConnectorService's
+	// RestoreConnectors RPC.
+	ConnectorServiceRestoreConnectorsProcedure = "/connector.v1.ConnectorService/RestoreConnectors"
+)
+
+// ConnectorServiceClient is a client for the connector.v1.ConnectorService service.
+type ConnectorServiceClient interface {
+	CreateConnector(context.Context, *connect.Request[v1.CreateConnectorRequest]) (*connect.Response[v1.CreateConnectorResponse], error)
+	GetConnector(context.Context, *connect.Request[v1.GetConnectorRequest]) (*connect.Response[v1.GetConnectorResponse], error)
+	// workspace_id and tenant_id are passed as query parameters.
+	DeleteConnector(context.Context, *connect.Request[v1.DeleteConnectorRequest]) (*connect.Response[v1.DeleteConnectorResponse], error)
+	// Undoes a delete while the connector's secret is still in its recovery window.
+	RestoreConnector(context.Context, *connect.Request[v1.RestoreConnectorRequest]) (*connect.Response[v1.RestoreConnectorResponse], error)
+	GetOAuthV2URL(context.Context, *connect.Request[v1.GetOAuthV2URLRequest]) (*connect.Response[v1.GetOAuthV2URLResponse], error)
+	ExchangeOAuthCode(context.Context, *connect.Request[v1.ExchangeOAuthCodeRequest]) (*connect.Response[v1.ExchangeOAuthCodeResponse], error)
+	// Streams a tenant's connector changes. A stream that falls behind ends with
+	// ABORTED; a resume token older than the event retention ends with
+	// OUT_OF_RANGE, after which the caller should reload its state.
+	WatchConnectors(context.Context, *connect.Request[v1.WatchConnectorsRequest]) (*connect.ServerStreamForClient[v1.WatchConnectorsResponse], error)
+	// Creates one connector per request message, several at a time, and reports
+	// each of them once the client closes the stream.
+	ImportConnectors(context.Context) *connect.ClientStreamForClient[v1.ImportConnectorsRequest, v1.ImportConnectorsResponse]
+	// Streams a header followed by every live connector, for backups.
+	ExportConnectors(context.Context, *connect.Request[v1.ExportConnectorsRequest]) (*connect.ServerStreamForClient[v1.ExportRecord], error)
+	// Recreates connectors from an export with their original IDs. Connectors
+	// that still exist are left alone.
+	RestoreConnectors(context.Context) *connect.ClientStreamForClient[v1.RestoreConnectorsRequest, v1.RestoreConnectorsResponse]
+}
+
+// NewConnectorServiceClient constructs a client for the connector.v1.ConnectorService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConnectorServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConnectorServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	connectorServiceMethods := v1.File_connector_v1_connector_proto.Services().ByName("ConnectorService").Methods()
+	return &connectorServiceClient{
+		createConnector: connect.NewClient[v1.CreateConnectorRequest, v1.CreateConnectorResponse](
+			httpClient,
+			baseURL+ConnectorServiceCreateConnectorProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("CreateConnector")),
+			connect.WithClientOptions(opts...),
+		),
+		getConnector: connect.NewClient[v1.GetConnectorRequest, v1.GetConnectorResponse](
+			httpClient,
+			baseURL+ConnectorServiceGetConnectorProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("GetConnector")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteConnector: connect.NewClient[v1.DeleteConnectorRequest, v1.DeleteConnectorResponse](
+			httpClient,
+			baseURL+ConnectorServiceDeleteConnectorProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("DeleteConnector")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreConnector: connect.NewClient[v1.RestoreConnectorRequest, v1.RestoreConnectorResponse](
+			httpClient,
+			baseURL+ConnectorServiceRestoreConnectorProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("RestoreConnector")),
+			connect.WithClientOptions(opts...),
+		),
+		getOAuthV2URL: connect.NewClient[v1.GetOAuthV2URLRequest, v1.GetOAuthV2URLResponse](
+			httpClient,
+			baseURL+ConnectorServiceGetOAuthV2URLProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("GetOAuthV2URL")),
+			connect.WithClientOptions(opts...),
+		),
+		exchangeOAuthCode: connect.NewClient[v1.ExchangeOAuthCodeRequest, v1.ExchangeOAuthCodeResponse](
+			httpClient,
+			baseURL+ConnectorServiceExchangeOAuthCodeProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("ExchangeOAuthCode")),
+			connect.WithClientOptions(opts...),
+		),
+		watchConnectors: connect.NewClient[v1.WatchConnectorsRequest, v1.WatchConnectorsResponse](
+			httpClient,
+			baseURL+ConnectorServiceWatchConnectorsProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("WatchConnectors")),
+			connect.WithClientOptions(opts...),
+		),
+		importConnectors: connect.NewClient[v1.ImportConnectorsRequest, v1.ImportConnectorsResponse](
+			httpClient,
+			baseURL+ConnectorServiceImportConnectorsProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("ImportConnectors")),
+			connect.WithClientOptions(opts...),
+		),
+		exportConnectors: connect.NewClient[v1.ExportConnectorsRequest, v1.ExportRecord](
+			httpClient,
+			baseURL+ConnectorServiceExportConnectorsProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("ExportConnectors")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreConnectors: connect.NewClient[v1.RestoreConnectorsRequest, v1.RestoreConnectorsResponse](
+			httpClient,
+			baseURL+ConnectorServiceRestoreConnectorsProcedure,
+			connect.WithSchema(connectorServiceMethods.ByName("RestoreConnectors")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// connectorServiceClient implements ConnectorServiceClient.
+type connectorServiceClient struct {
+	createConnector   *connect.Client[v1.CreateConnectorRequest, v1.CreateConnectorResponse]
+	getConnector      *connect.Client[v1.GetConnectorRequest, v1.GetConnectorResponse]
+	deleteConnector   *connect.Client[v1.DeleteConnectorRequest, v1.DeleteConnectorResponse]
+	restoreConnector  *connect.Client[v1.RestoreConnectorRequest, v1.RestoreConnectorResponse]
+	getOAuthV2URL     *connect.Client[v1.GetOAuthV2URLRequest, v1.GetOAuthV2URLResponse]
+	exchangeOAuthCode *connect.Client[v1.ExchangeOAuthCodeRequest, v1.ExchangeOAuthCodeResponse]
+	watchConnectors   *connect.Client[v1.WatchConnectorsRequest, v1.WatchConnectorsResponse]
+	importConnectors  *connect.Client[v1.ImportConnectorsRequest, v1.ImportConnectorsResponse]
+	exportConnectors  *connect.Client[v1.ExportConnectorsRequest, v1.ExportRecord]
+	restoreConnectors *connect.Client[v1.RestoreConnectorsRequest, v1.RestoreConnectorsResponse]
+}
+
+// CreateConnector calls connector.v1.ConnectorService.CreateConnector.
+func (c *connectorServiceClient) CreateConnector(ctx context.Context, req *connect.Request[v1.CreateConnectorRequest]) (*connect.Response[v1.CreateConnectorResponse], error) {
+	return c.createConnector.CallUnary(ctx, req)
+}
+
+// GetConnector calls connector.v1.ConnectorService.GetConnector.
+func (c *connectorServiceClient) GetConnector(ctx context.Context, req *connect.Request[v1.GetConnectorRequest]) (*connect.Response[v1.GetConnectorResponse], error) {
+	return c.getConnector.CallUnary(ctx, req)
+}
+
+// DeleteConnector calls connector.v1.ConnectorService.DeleteConnector.
+func (c *connectorServiceClient) DeleteConnector(ctx context.Context, req *connect.Request[v1.DeleteConnectorRequest]) (*connect.Response[v1.DeleteConnectorResponse], error) {
+	return c.deleteConnector.CallUnary(ctx, req)
+}
+
+// RestoreConnector calls connector.v1.ConnectorService.RestoreConnector.
+func (c *connectorServiceClient) RestoreConnector(ctx context.Context, req *connect.Request[v1.RestoreConnectorRequest]) (*connect.Response[v1.RestoreConnectorResponse], error) {
+	return c.restoreConnector.CallUnary(ctx, req)
+}
+
+// GetOAuthV2URL calls connector.v1.ConnectorService.GetOAuthV2URL.
+func (c *connectorServiceClient) GetOAuthV2URL(ctx context.Context, req *connect.Request[v1.GetOAuthV2URLRequest]) (*connect.Response[v1.GetOAuthV2URLResponse], error) {
+	return c.getOAuthV2URL.CallUnary(ctx, req)
+}
+
+// ExchangeOAuthCode calls connector.v1.ConnectorService.ExchangeOAuthCode.
+func (c *connectorServiceClient) ExchangeOAuthCode(ctx context.Context, req *connect.Request[v1.ExchangeOAuthCodeRequest]) (*connect.Response[v1.ExchangeOAuthCodeResponse], error) {
+	return c.exchangeOAuthCode.CallUnary(ctx, req)
+}
+
+// WatchConnectors calls connector.v1.ConnectorService.WatchConnectors.
+func (c *connectorServiceClient) WatchConnectors(ctx context.Context, req *connect.Request[v1.WatchConnectorsRequest]) (*connect.ServerStreamForClient[v1.WatchConnectorsResponse], error) {
+	return c.watchConnectors.CallServerStream(ctx, req)
+}
+
+// ImportConnectors calls connector.v1.ConnectorService.ImportConnectors.
+func (c *connectorServiceClient) ImportConnectors(ctx context.Context) *connect.ClientStreamForClient[v1.ImportConnectorsRequest, v1.ImportConnectorsResponse] {
+	return c.importConnectors.CallClientStream(ctx)
+}
+
+// ExportConnectors calls connector.v1.ConnectorService.ExportConnectors.
+func (c *connectorServiceClient) ExportConnectors(ctx context.Context, req *connect.Request[v1.ExportConnectorsRequest]) (*connect.ServerStreamForClient[v1.ExportRecord], error) {
+	return c.exportConnectors.CallServerStream(ctx, req)
+}
+
+// RestoreConnectors calls connector.v1.ConnectorService.RestoreConnectors.
+func (c *connectorServiceClient) RestoreConnectors(ctx context.Context) *connect.ClientStreamForClient[v1.RestoreConnectorsRequest, v1.RestoreConnectorsResponse] {
+	return c.restoreConnectors.CallClientStream(ctx)
+}
+
+// ConnectorServiceHandler is an implementation of the connector.v1.ConnectorService service.
+type ConnectorServiceHandler interface {
+	CreateConnector(context.Context, *connect.Request[v1.CreateConnectorRequest]) (*connect.Response[v1.CreateConnectorResponse], error)
+	GetConnector(context.Context, *connect.Request[v1.GetConnectorRequest]) (*connect.Response[v1.GetConnectorResponse], error)
+	// workspace_id and tenant_id are passed as query parameters.
+	DeleteConnector(context.Context, *connect.Request[v1.DeleteConnectorRequest]) (*connect.Response[v1.DeleteConnectorResponse], error)
+	// Undoes a delete while the connector's secret is still in its recovery window.
+	RestoreConnector(context.Context, *connect.Request[v1.RestoreConnectorRequest]) (*connect.Response[v1.RestoreConnectorResponse], error)
+	GetOAuthV2URL(context.Context, *connect.Request[v1.GetOAuthV2URLRequest]) (*connect.Response[v1.GetOAuthV2URLResponse], error)
+	ExchangeOAuthCode(context.Context, *connect.Request[v1.ExchangeOAuthCodeRequest]) (*connect.Response[v1.ExchangeOAuthCodeResponse], error)
+	// Streams a tenant's connector changes. A stream that falls behind ends with
+	// ABORTED; a resume token older than the event retention ends with
+	// OUT_OF_RANGE, after which the caller should reload its state.
+	WatchConnectors(context.Context, *connect.Request[v1.WatchConnectorsRequest], *connect.ServerStream[v1.WatchConnectorsResponse]) error
+	// Creates one connector per request message, several at a time, and reports
+	// each of them once the client closes the stream.
+	ImportConnectors(context.Context, *connect.ClientStream[v1.ImportConnectorsRequest]) (*connect.Response[v1.ImportConnectorsResponse], error)
+	// Streams a header followed by every live connector, for backups.
+	ExportConnectors(context.Context, *connect.Request[v1.ExportConnectorsRequest], *connect.ServerStream[v1.ExportRecord]) error
+	// Recreates connectors from an export with their original IDs. Connectors
+	// that still exist are left alone.
+	RestoreConnectors(context.Context, *connect.ClientStream[v1.RestoreConnectorsRequest]) (*connect.Response[v1.RestoreConnectorsResponse], error)
+}
+
+// NewConnectorServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConnectorServiceHandler(svc ConnectorServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	connectorServiceMethods := v1.File_connector_v1_connector_proto.Services().ByName("ConnectorService").Methods()
+	connectorServiceCreateConnectorHandler := connect.NewUnaryHandler(
+		ConnectorServiceCreateConnectorProcedure,
+		svc.CreateConnector,
+		connect.WithSchema(connectorServiceMethods.ByName("CreateConnector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceGetConnectorHandler := connect.NewUnaryHandler(
+		ConnectorServiceGetConnectorProcedure,
+		svc.GetConnector,
+		connect.WithSchema(connectorServiceMethods.ByName("GetConnector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceDeleteConnectorHandler := connect.NewUnaryHandler(
+		ConnectorServiceDeleteConnectorProcedure,
+		svc.DeleteConnector,
+		connect.WithSchema(connectorServiceMethods.ByName("DeleteConnector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceRestoreConnectorHandler := connect.NewUnaryHandler(
+		ConnectorServiceRestoreConnectorProcedure,
+		svc.RestoreConnector,
+		connect.WithSchema(connectorServiceMethods.ByName("RestoreConnector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceGetOAuthV2URLHandler := connect.NewUnaryHandler(
+		ConnectorServiceGetOAuthV2URLProcedure,
+		svc.GetOAuthV2URL,
+		connect.WithSchema(connectorServiceMethods.ByName("GetOAuthV2URL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceExchangeOAuthCodeHandler := connect.NewUnaryHandler(
+		ConnectorServiceExchangeOAuthCodeProcedure,
+		svc.ExchangeOAuthCode,
+		connect.WithSchema(connectorServiceMethods.ByName("ExchangeOAuthCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceWatchConnectorsHandler := connect.NewServerStreamHandler(
+		ConnectorServiceWatchConnectorsProcedure,
+		svc.WatchConnectors,
+		connect.WithSchema(connectorServiceMethods.ByName("WatchConnectors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceImportConnectorsHandler := connect.NewClientStreamHandler(
+		ConnectorServiceImportConnectorsProcedure,
+		svc.ImportConnectors,
+		connect.WithSchema(connectorServiceMethods.ByName("ImportConnectors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceExportConnectorsHandler := connect.NewServerStreamHandler(
+		ConnectorServiceExportConnectorsProcedure,
+		svc.ExportConnectors,
+		connect.WithSchema(connectorServiceMethods.ByName("ExportConnectors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectorServiceRestoreConnectorsHandler := connect.NewClientStreamHandler(
+		ConnectorServiceRestoreConnectorsProcedure,
+		svc.RestoreConnectors,
+		connect.WithSchema(connectorServiceMethods.ByName("RestoreConnectors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/connector.v1.ConnectorService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConnectorServiceCreateConnectorProcedure:
+			connectorServiceCreateConnectorHandler.ServeHTTP(w, r)
+		case ConnectorServiceGetConnectorProcedure:
+			connectorServiceGetConnectorHandler.ServeHTTP(w, r)
+		case ConnectorServiceDeleteConnectorProcedure:
+			connectorServiceDeleteConnectorHandler.ServeHTTP(w, r)
+		case ConnectorServiceRestoreConnectorProcedure:
+			connectorServiceRestoreConnectorHandler.ServeHTTP(w, r)
+		case ConnectorServiceGetOAuthV2URLProcedure:
+			connectorServiceGetOAuthV2URLHandler.ServeHTTP(w, r)
+		case ConnectorServiceExchangeOAuthCodeProcedure:
+			connectorServiceExchangeOAuthCodeHandler.ServeHTTP(w, r)
+		case ConnectorServiceWatchConnectorsProcedure:
+			connectorServiceWatchConnectorsHandler.ServeHTTP(w, r)
+		case ConnectorServiceImportConnectorsProcedure:
+			connectorServiceImportConnectorsHandler.ServeHTTP(w, r)
+		case ConnectorServiceExportConnectorsProcedure:
+			connectorServiceExportConnectorsHandler.ServeHTTP(w, r)
+		case ConnectorServiceRestoreConnectorsProcedure:
+			connectorServiceRestoreConnectorsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConnectorServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConnectorServiceHandler struct{}
+
+func (UnimplementedConnectorServiceHandler) CreateConnector(context.Context, *connect.Request[v1.CreateConnectorRequest]) (*connect.Response[v1.CreateConnectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.CreateConnector is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) GetConnector(context.Context, *connect.Request[v1.GetConnectorRequest]) (*connect.Response[v1.GetConnectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.GetConnector is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) DeleteConnector(context.Context, *connect.Request[v1.DeleteConnectorRequest]) (*connect.Response[v1.DeleteConnectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.DeleteConnector is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) RestoreConnector(context.Context, *connect.Request[v1.RestoreConnectorRequest]) (*connect.Response[v1.RestoreConnectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.RestoreConnector is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) GetOAuthV2URL(context.Context, *connect.Request[v1.GetOAuthV2URLRequest]) (*connect.Response[v1.GetOAuthV2URLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.GetOAuthV2URL is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) ExchangeOAuthCode(context.Context, *connect.Request[v1.ExchangeOAuthCodeRequest]) (*connect.Response[v1.ExchangeOAuthCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.ExchangeOAuthCode is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) WatchConnectors(context.Context, *connect.Request[v1.WatchConnectorsRequest], *connect.ServerStream[v1.WatchConnectorsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.WatchConnectors is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) ImportConnectors(context.Context, *connect.ClientStream[v1.ImportConnectorsRequest]) (*connect.Response[v1.ImportConnectorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.ImportConnectors is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) ExportConnectors(context.Context, *connect.Request[v1.ExportConnectorsRequest], *connect.ServerStream[v1.ExportRecord]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.ExportConnectors is not implemented"))
+}
+
+func (UnimplementedConnectorServiceHandler) RestoreConnectors(context.Context, *connect.ClientStream[v1.RestoreConnectorsRequest]) (*connect.Response[v1.RestoreConnectorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connector.v1.ConnectorService.RestoreConnectors is not implemented"))
+}
@@ -0,0 +1,1729 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: connector/v1/tenant.proto
+
+package connectorv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Tenant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tenant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tenant with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TenantMultiError, or nil if none found.
+func (m *Tenant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tenant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TenantMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantMultiError is an error wrapping multiple validation errors returned by
+// Tenant.ValidateAll() if the designated constraints aren't met.
+type TenantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantMultiError) AllErrors() []error { return m }
+
+// TenantValidationError is the validation error returned by Tenant.Validate if
+// the designated constraints aren't met.
+type TenantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantValidationError) ErrorName() string { return "TenantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantValidationError{}
+
+// Validate checks the field values on CreateTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTenantRequestMultiError, or nil if none found.
+func (m *CreateTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTenantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 32 {
+		err := CreateTenantRequestValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateTenantRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := CreateTenantRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 128 {
+		err := CreateTenantRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTenantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTenantRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTenantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTenantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTenantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTenantRequestMultiError) AllErrors() []error { return m }
+
+// CreateTenantRequestValidationError is the validation error returned by
+// CreateTenantRequest.Validate if the designated constraints aren't met.
+type CreateTenantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTenantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTenantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTenantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTenantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTenantRequestValidationError) ErrorName() string {
+	return "CreateTenantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTenantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTenantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTenantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTenantRequestValidationError{}
+
+var _CreateTenantRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on CreateTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTenantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTenantResponseMultiError, or nil if none found.
+func (m *CreateTenantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTenantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTenantResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTenantResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTenantResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTenantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTenantResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateTenantResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTenantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTenantResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTenantResponseMultiError) AllErrors() []error { return m }
+
+// CreateTenantResponseValidationError is the validation error returned by
+// CreateTenantResponse.Validate if the designated constraints aren't met.
+type CreateTenantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTenantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTenantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTenantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTenantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTenantResponseValidationError) ErrorName() string {
+	return "CreateTenantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTenantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTenantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTenantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTenantResponseValidationError{}
+
+// Validate checks the field values on GetTenantRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantRequestMultiError, or nil if none found.
+func (m *GetTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 32 {
+		err := GetTenantRequestValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetTenantRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := GetTenantRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTenantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTenantRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTenantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantRequestMultiError) AllErrors() []error { return m }
+
+// GetTenantRequestValidationError is the validation error returned by
+// GetTenantRequest.Validate if the designated constraints aren't met.
+type GetTenantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantRequestValidationError) ErrorName() string {
+	return "GetTenantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantRequestValidationError{}
+
+var _GetTenantRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on GetTenantResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantResponseMultiError, or nil if none found.
+func (m *GetTenantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTenantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantResponseMultiError is an error wrapping multiple validation errors
+// returned by GetTenantResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetTenantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantResponseMultiError) AllErrors() []error { return m }
+
+// GetTenantResponseValidationError is the validation error returned by
+// GetTenantResponse.Validate if the designated constraints aren't met.
+type GetTenantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantResponseValidationError) ErrorName() string {
+	return "GetTenantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantResponseValidationError{}
+
+// Validate checks the field values on ListTenantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantsRequestMultiError, or nil if none found.
+func (m *ListTenantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListTenantsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 256 {
+		err := ListTenantsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTenantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTenantsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTenantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantsRequestMultiError) AllErrors() []error { return m }
+
+// ListTenantsRequestValidationError is the validation error returned by
+// ListTenantsRequest.Validate if the designated constraints aren't met.
+type ListTenantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantsRequestValidationError) ErrorName() string {
+	return "ListTenantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantsRequestValidationError{}
+
+// Validate checks the field values on ListTenantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantsResponseMultiError, or nil if none found.
+func (m *ListTenantsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantsResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantsResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantsResponseValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTenantsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTenantsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTenantsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantsResponseMultiError) AllErrors() []error { return m }
+
+// ListTenantsResponseValidationError is the validation error returned by
+// ListTenantsResponse.Validate if the designated constraints aren't met.
+type ListTenantsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantsResponseValidationError) ErrorName() string {
+	return "ListTenantsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantsResponseValidationError{}
+
+// Validate checks the field values on SuspendTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuspendTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuspendTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuspendTenantRequestMultiError, or nil if none found.
+func (m *SuspendTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuspendTenantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 32 {
+		err := SuspendTenantRequestValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SuspendTenantRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := SuspendTenantRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SuspendTenantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuspendTenantRequestMultiError is an error wrapping multiple validation
+// errors returned by SuspendTenantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuspendTenantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuspendTenantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuspendTenantRequestMultiError) AllErrors() []error { return m }
+
+// SuspendTenantRequestValidationError is the validation error returned by
+// SuspendTenantRequest.Validate if the designated constraints aren't met.
+type SuspendTenantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuspendTenantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuspendTenantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuspendTenantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuspendTenantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuspendTenantRequestValidationError) ErrorName() string {
+	return "SuspendTenantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuspendTenantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuspendTenantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuspendTenantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuspendTenantRequestValidationError{}
+
+var _SuspendTenantRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on SuspendTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuspendTenantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuspendTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuspendTenantResponseMultiError, or nil if none found.
+func (m *SuspendTenantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuspendTenantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuspendTenantResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuspendTenantResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuspendTenantResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SuspendTenantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuspendTenantResponseMultiError is an error wrapping multiple validation
+// errors returned by SuspendTenantResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SuspendTenantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuspendTenantResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuspendTenantResponseMultiError) AllErrors() []error { return m }
+
+// SuspendTenantResponseValidationError is the validation error returned by
+// SuspendTenantResponse.Validate if the designated constraints aren't met.
+type SuspendTenantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuspendTenantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuspendTenantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuspendTenantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuspendTenantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuspendTenantResponseValidationError) ErrorName() string {
+	return "SuspendTenantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuspendTenantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuspendTenantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuspendTenantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuspendTenantResponseValidationError{}
+
+// Validate checks the field values on ResumeTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeTenantRequestMultiError, or nil if none found.
+func (m *ResumeTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeTenantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 32 {
+		err := ResumeTenantRequestValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ResumeTenantRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := ResumeTenantRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeTenantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeTenantRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeTenantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeTenantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeTenantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeTenantRequestMultiError) AllErrors() []error { return m }
+
+// ResumeTenantRequestValidationError is the validation error returned by
+// ResumeTenantRequest.Validate if the designated constraints aren't met.
+type ResumeTenantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeTenantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeTenantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeTenantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeTenantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeTenantRequestValidationError) ErrorName() string {
+	return "ResumeTenantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeTenantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeTenantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeTenantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeTenantRequestValidationError{}
+
+var _ResumeTenantRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ResumeTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeTenantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeTenantResponseMultiError, or nil if none found.
+func (m *ResumeTenantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeTenantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResumeTenantResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResumeTenantResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResumeTenantResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResumeTenantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeTenantResponseMultiError is an error wrapping multiple validation
+// errors returned by ResumeTenantResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeTenantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeTenantResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeTenantResponseMultiError) AllErrors() []error { return m }
+
+// ResumeTenantResponseValidationError is the validation error returned by
+// ResumeTenantResponse.Validate if the designated constraints aren't met.
+type ResumeTenantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeTenantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeTenantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeTenantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeTenantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeTenantResponseValidationError) ErrorName() string {
+	return "ResumeTenantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeTenantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeTenantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeTenantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeTenantResponseValidationError{}
+
+// Validate checks the field values on DeleteTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTenantRequestMultiError, or nil if none found.
+func (m *DeleteTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTenantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 32 {
+		err := DeleteTenantRequestValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteTenantRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := DeleteTenantRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTenantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTenantRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTenantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTenantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTenantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTenantRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTenantRequestValidationError is the validation error returned by
+// DeleteTenantRequest.Validate if the designated constraints aren't met.
+type DeleteTenantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTenantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTenantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTenantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTenantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTenantRequestValidationError) ErrorName() string {
+	return "DeleteTenantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTenantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTenantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTenantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTenantRequestValidationError{}
+
+var _DeleteTenantRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on DeleteTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTenantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTenantResponseMultiError, or nil if none found.
+func (m *DeleteTenantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTenantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteTenantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTenantResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTenantResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTenantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTenantResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTenantResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTenantResponseValidationError is the validation error returned by
+// DeleteTenantResponse.Validate if the designated constraints aren't met.
+type DeleteTenantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTenantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTenantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTenantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTenantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTenantResponseValidationError) ErrorName() string {
+	return "DeleteTenantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTenantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTenantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTenantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTenantResponseValidationError{}
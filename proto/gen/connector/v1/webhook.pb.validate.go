@@ -0,0 +1,1970 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: connector/v1/webhook.proto
+
+package connectorv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on WebhookSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebhookSubscription) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookSubscriptionMultiError, or nil if none found.
+func (m *WebhookSubscription) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookSubscription) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for Url
+
+	// no validation rules for EventTypes
+
+	// no validation rules for Status
+
+	// no validation rules for ConsecutiveFailures
+
+	if all {
+		switch v := interface{}(m.GetDisabledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDisabledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionValidationError{
+				field:  "DisabledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookSubscriptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookSubscriptionMultiError is an error wrapping multiple validation
+// errors returned by WebhookSubscription.ValidateAll() if the designated
+// constraints aren't met.
+type WebhookSubscriptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookSubscriptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookSubscriptionMultiError) AllErrors() []error { return m }
+
+// WebhookSubscriptionValidationError is the validation error returned by
+// WebhookSubscription.Validate if the designated constraints aren't met.
+type WebhookSubscriptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookSubscriptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookSubscriptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookSubscriptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookSubscriptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookSubscriptionValidationError) ErrorName() string {
+	return "WebhookSubscriptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookSubscriptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookSubscription.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookSubscriptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookSubscriptionValidationError{}
+
+// Validate checks the field values on WebhookDeliveryAttempt with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDeliveryAttempt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDeliveryAttempt with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryAttemptMultiError, or nil if none found.
+func (m *WebhookDeliveryAttempt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDeliveryAttempt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DeliveryId
+
+	// no validation rules for EventId
+
+	// no validation rules for EventType
+
+	// no validation rules for AttemptNumber
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryAttemptValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryAttemptValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryAttemptValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttemptedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryAttemptValidationError{
+					field:  "AttemptedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryAttemptValidationError{
+					field:  "AttemptedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttemptedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryAttemptValidationError{
+				field:  "AttemptedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Succeeded
+
+	if len(errors) > 0 {
+		return WebhookDeliveryAttemptMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryAttemptMultiError is an error wrapping multiple validation
+// errors returned by WebhookDeliveryAttempt.ValidateAll() if the designated
+// constraints aren't met.
+type WebhookDeliveryAttemptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryAttemptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryAttemptMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryAttemptValidationError is the validation error returned by
+// WebhookDeliveryAttempt.Validate if the designated constraints aren't met.
+type WebhookDeliveryAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryAttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryAttemptValidationError) ErrorName() string {
+	return "WebhookDeliveryAttemptValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDeliveryAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryAttemptValidationError{}
+
+// Validate checks the field values on CreateWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateWebhookSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *CreateWebhookSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTenantId()); l < 1 || l > 32 {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateWebhookSubscriptionRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEventTypes()) > 16 {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "EventTypes",
+			reason: "value must contain no more than 16 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSecret()) > 256 {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "Secret",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateWebhookSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateWebhookSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookSubscriptionRequestValidationError is the validation error
+// returned by CreateWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "CreateWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookSubscriptionRequestValidationError{}
+
+var _CreateWebhookSubscriptionRequest_TenantId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on CreateWebhookSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateWebhookSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookSubscriptionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateWebhookSubscriptionResponseMultiError, or nil if none found.
+func (m *CreateWebhookSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWebhookSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateWebhookSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateWebhookSubscriptionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// CreateWebhookSubscriptionResponseValidationError is the validation error
+// returned by CreateWebhookSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookSubscriptionResponseValidationError) ErrorName() string {
+	return "CreateWebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on GetWebhookSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *GetWebhookSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWebhookSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetWebhookSubscriptionRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := GetWebhookSubscriptionRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9a-fA-F\\\\-]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetWebhookSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by GetWebhookSubscriptionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetWebhookSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWebhookSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// GetWebhookSubscriptionRequestValidationError is the validation error
+// returned by GetWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type GetWebhookSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWebhookSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "GetWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWebhookSubscriptionRequestValidationError{}
+
+var _GetWebhookSubscriptionRequest_Id_Pattern = regexp.MustCompile("^[0-9a-fA-F\\-]{36}$")
+
+// Validate checks the field values on GetWebhookSubscriptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWebhookSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetWebhookSubscriptionResponseMultiError, or nil if none found.
+func (m *GetWebhookSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWebhookSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWebhookSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetWebhookSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWebhookSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetWebhookSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWebhookSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by GetWebhookSubscriptionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetWebhookSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWebhookSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWebhookSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// GetWebhookSubscriptionResponseValidationError is the validation error
+// returned by GetWebhookSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type GetWebhookSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWebhookSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWebhookSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWebhookSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWebhookSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWebhookSubscriptionResponseValidationError) ErrorName() string {
+	return "GetWebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on ListWebhookSubscriptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookSubscriptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookSubscriptionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookSubscriptionsRequestMultiError, or nil if none found.
+func (m *ListWebhookSubscriptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookSubscriptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTenantId()); l < 1 || l > 32 {
+		err := ListWebhookSubscriptionsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListWebhookSubscriptionsRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := ListWebhookSubscriptionsRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookSubscriptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookSubscriptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookSubscriptionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWebhookSubscriptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookSubscriptionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookSubscriptionsRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhookSubscriptionsRequestValidationError is the validation error
+// returned by ListWebhookSubscriptionsRequest.Validate if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookSubscriptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookSubscriptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookSubscriptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookSubscriptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookSubscriptionsRequestValidationError) ErrorName() string {
+	return "ListWebhookSubscriptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookSubscriptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookSubscriptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookSubscriptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookSubscriptionsRequestValidationError{}
+
+var _ListWebhookSubscriptionsRequest_TenantId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListWebhookSubscriptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListWebhookSubscriptionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookSubscriptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookSubscriptionsResponseMultiError, or nil if none found.
+func (m *ListWebhookSubscriptionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookSubscriptionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubscriptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookSubscriptionsResponseValidationError{
+					field:  fmt.Sprintf("Subscriptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookSubscriptionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookSubscriptionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListWebhookSubscriptionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookSubscriptionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookSubscriptionsResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhookSubscriptionsResponseValidationError is the validation error
+// returned by ListWebhookSubscriptionsResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookSubscriptionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookSubscriptionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookSubscriptionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookSubscriptionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookSubscriptionsResponseValidationError) ErrorName() string {
+	return "ListWebhookSubscriptionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookSubscriptionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookSubscriptionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookSubscriptionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookSubscriptionsResponseValidationError{}
+
+// Validate checks the field values on DeleteWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteWebhookSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *DeleteWebhookSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DeleteWebhookSubscriptionRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := DeleteWebhookSubscriptionRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9a-fA-F\\\\-]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteWebhookSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteWebhookSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookSubscriptionRequestValidationError is the validation error
+// returned by DeleteWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "DeleteWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookSubscriptionRequestValidationError{}
+
+var _DeleteWebhookSubscriptionRequest_Id_Pattern = regexp.MustCompile("^[0-9a-fA-F\\-]{36}$")
+
+// Validate checks the field values on DeleteWebhookSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteWebhookSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookSubscriptionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteWebhookSubscriptionResponseMultiError, or nil if none found.
+func (m *DeleteWebhookSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteWebhookSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteWebhookSubscriptionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookSubscriptionResponseValidationError is the validation error
+// returned by DeleteWebhookSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookSubscriptionResponseValidationError) ErrorName() string {
+	return "DeleteWebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on EnableWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *EnableWebhookSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// EnableWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *EnableWebhookSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableWebhookSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_EnableWebhookSubscriptionRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := EnableWebhookSubscriptionRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[0-9a-fA-F\\\\-]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnableWebhookSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// EnableWebhookSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EnableWebhookSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableWebhookSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// EnableWebhookSubscriptionRequestValidationError is the validation error
+// returned by EnableWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type EnableWebhookSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableWebhookSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "EnableWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableWebhookSubscriptionRequestValidationError{}
+
+var _EnableWebhookSubscriptionRequest_Id_Pattern = regexp.MustCompile("^[0-9a-fA-F\\-]{36}$")
+
+// Validate checks the field values on EnableWebhookSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *EnableWebhookSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableWebhookSubscriptionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// EnableWebhookSubscriptionResponseMultiError, or nil if none found.
+func (m *EnableWebhookSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableWebhookSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EnableWebhookSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EnableWebhookSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EnableWebhookSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EnableWebhookSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableWebhookSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// EnableWebhookSubscriptionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnableWebhookSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableWebhookSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableWebhookSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// EnableWebhookSubscriptionResponseValidationError is the validation error
+// returned by EnableWebhookSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type EnableWebhookSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableWebhookSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableWebhookSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableWebhookSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableWebhookSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableWebhookSubscriptionResponseValidationError) ErrorName() string {
+	return "EnableWebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableWebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableWebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableWebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveryAttemptsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListWebhookDeliveryAttemptsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveryAttemptsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveryAttemptsRequestMultiError, or nil if none found.
+func (m *ListWebhookDeliveryAttemptsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveryAttemptsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ListWebhookDeliveryAttemptsRequest_SubscriptionId_Pattern.MatchString(m.GetSubscriptionId()) {
+		err := ListWebhookDeliveryAttemptsRequestValidationError{
+			field:  "SubscriptionId",
+			reason: "value does not match regex pattern \"^[0-9a-fA-F\\\\-]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 200 {
+		err := ListWebhookDeliveryAttemptsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 200]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveryAttemptsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveryAttemptsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListWebhookDeliveryAttemptsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhookDeliveryAttemptsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveryAttemptsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveryAttemptsRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveryAttemptsRequestValidationError is the validation error
+// returned by ListWebhookDeliveryAttemptsRequest.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveryAttemptsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveryAttemptsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveryAttemptsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveryAttemptsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveryAttemptsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveryAttemptsRequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveryAttemptsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveryAttemptsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveryAttemptsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveryAttemptsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveryAttemptsRequestValidationError{}
+
+var _ListWebhookDeliveryAttemptsRequest_SubscriptionId_Pattern = regexp.MustCompile("^[0-9a-fA-F\\-]{36}$")
+
+// Validate checks the field values on ListWebhookDeliveryAttemptsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListWebhookDeliveryAttemptsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveryAttemptsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveryAttemptsResponseMultiError, or nil if none found.
+func (m *ListWebhookDeliveryAttemptsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveryAttemptsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAttempts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookDeliveryAttemptsResponseValidationError{
+						field:  fmt.Sprintf("Attempts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookDeliveryAttemptsResponseValidationError{
+						field:  fmt.Sprintf("Attempts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveryAttemptsResponseValidationError{
+					field:  fmt.Sprintf("Attempts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveryAttemptsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveryAttemptsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListWebhookDeliveryAttemptsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhookDeliveryAttemptsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveryAttemptsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveryAttemptsResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveryAttemptsResponseValidationError is the validation error
+// returned by ListWebhookDeliveryAttemptsResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveryAttemptsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveryAttemptsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveryAttemptsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveryAttemptsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveryAttemptsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveryAttemptsResponseValidationError) ErrorName() string {
+	return "ListWebhookDeliveryAttemptsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveryAttemptsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveryAttemptsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveryAttemptsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveryAttemptsResponseValidationError{}
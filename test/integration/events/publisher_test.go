@@ -0,0 +1,137 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	natsInfra "github.com/connector-recruitment/internal/infrastructure/nats"
+	"github.com/connector-recruitment/internal/infrastructure/redis"
+	"github.com/connector-recruitment/test/integration/testserver"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+func testEvent() *domain.CloudEvent {
+	return &domain.CloudEvent{
+		SpecVersion:     domain.CloudEventsSpecVersion,
+		ID:              uuid.NewString(),
+		Source:          "/test",
+		Type:            string(domain.ConnectorEventCreated),
+		Subject:         uuid.NewString(),
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		TenantID:        "tenant-1",
+		Data:            json.RawMessage(`{"id":"connector-1"}`),
+	}
+}
+
+func TestJetStreamPublisher(t *testing.T) {
+	ctx := context.Background()
+	natsContainer, err := testserver.NewNatsContainer(ctx)
+	if err != nil {
+		t.Fatalf("failed to start NATS: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := natsContainer.Cleanup(ctx); err != nil {
+			t.Errorf("failed to cleanup NATS container: %v", err)
+		}
+	})
+
+	publisher, err := natsInfra.NewJetStreamPublisher(ctx, natsContainer.URL, "CONNECTOR_EVENTS", "connectors", time.Minute)
+	if err != nil {
+		t.Fatalf("failed to create publisher: %v", err)
+	}
+	t.Cleanup(publisher.Close)
+
+	event := testEvent()
+	// The relay may publish the same outbox entry twice; the second copy must be dropped.
+	for i := 0; i < 2; i++ {
+		if err := publisher.Publish(ctx, event); err != nil {
+			t.Fatalf("publish %d failed: %v", i, err)
+		}
+	}
+
+	conn, err := nats.Connect(natsContainer.URL)
+	if err != nil {
+		t.Fatalf("failed to connect to NATS: %v", err)
+	}
+	t.Cleanup(conn.Close)
+	js, err := jetstream.New(conn)
+	if err != nil {
+		t.Fatalf("failed to open JetStream: %v", err)
+	}
+
+	stream, err := js.Stream(ctx, "CONNECTOR_EVENTS")
+	if err != nil {
+		t.Fatalf("failed to look up stream: %v", err)
+	}
+	info, err := stream.Info(ctx)
+	if err != nil {
+		t.Fatalf("failed to get stream info: %v", err)
+	}
+	if info.State.Msgs != 1 {
+		t.Errorf("expected 1 message after duplicate publish, got %d", info.State.Msgs)
+	}
+
+	msg, err := stream.GetLastMsgForSubject(ctx, "connectors.tenant-1.created")
+	if err != nil {
+		t.Fatalf("failed to read message: %v", err)
+	}
+	var received domain.CloudEvent
+	if err := json.Unmarshal(msg.Data, &received); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if received.ID != event.ID {
+		t.Errorf("expected event id %q, got %q", event.ID, received.ID)
+	}
+	if got := msg.Header.Get(jetstream.MsgIDHeader); got != event.ID {
+		t.Errorf("expected Nats-Msg-Id %q, got %q", event.ID, got)
+	}
+}
+
+func TestRedisStreamPublisher(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, err := testserver.NewRedisContainer(ctx)
+	if err != nil {
+		t.Fatalf("failed to start Redis: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := redisContainer.Cleanup(ctx); err != nil {
+			t.Errorf("failed to cleanup Redis container: %v", err)
+		}
+	})
+
+	client, err := redis.IntClient(ctx, redisContainer.Address)
+	if err != nil {
+		t.Fatalf("failed to connect to Redis: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	publisher := redis.NewStreamPublisher(client, "connector-events", 1000)
+	event := testEvent()
+	if err := publisher.Publish(ctx, event); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+
+	entries, err := client.XRange(ctx, "connector-events", "-", "+").Result()
+	if err != nil {
+		t.Fatalf("failed to read stream: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 stream entry, got %d", len(entries))
+	}
+	if got := entries[0].Values["id"]; got != event.ID {
+		t.Errorf("expected dedupe id %q, got %v", event.ID, got)
+	}
+	var received domain.CloudEvent
+	if err := json.Unmarshal([]byte(entries[0].Values["event"].(string)), &received); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if received.Type != event.Type {
+		t.Errorf("expected type %q, got %q", event.Type, received.Type)
+	}
+}
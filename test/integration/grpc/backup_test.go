@@ -0,0 +1,129 @@
+package grpc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"testing"
+
+	"github.com/connector-recruitment/pkg/seal"
+	"github.com/connector-recruitment/test/integration/testserver"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	connV1 "github.com/connector-recruitment/proto/gen/connector/v1"
+)
+
+func TestExportAndRestoreConnectors(t *testing.T) {
+	server := testserver.SetupIntegrationTestServer(t)
+
+	ctx := context.Background()
+	client := connV1.NewConnectorServiceClient(server.GrpcConn)
+	tenantClient := connV1.NewTenantServiceClient(server.GrpcConn)
+	if _, err := tenantClient.CreateTenant(ctx, &connV1.CreateTenantRequest{Id: "tenant-backup", Name: "Backup"}); err != nil {
+		t.Fatalf("CreateTenant failed: %v", err)
+	}
+	created, err := client.CreateConnector(ctx, &connV1.CreateConnectorRequest{
+		WorkspaceId:        "workspace-backup",
+		TenantId:           "tenant-backup",
+		Token:              "test-token",
+		DefaultChannelName: "general",
+	})
+	if err != nil {
+		t.Fatalf("CreateConnector failed: %v", err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey failed: %v", err)
+	}
+	stream, err := client.ExportConnectors(ctx, &connV1.ExportConnectorsRequest{
+		TenantId:     "tenant-backup",
+		PublicKeyPem: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	})
+	if err != nil {
+		t.Fatalf("ExportConnectors failed: %v", err)
+	}
+	var records []*connV1.ExportRecord
+	for {
+		record, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		records = append(records, record)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected a header and one connector, got %d records", len(records))
+	}
+	header := records[0].GetHeader()
+	if header.GetFormatVersion() != 1 || header.GetKeyFingerprint() != seal.Fingerprint(&key.PublicKey) {
+		t.Fatalf("unexpected header %v", header)
+	}
+	exported := records[1].GetConnector()
+	if exported.GetId() != created.Connector.Id || exported.GetSecret() == nil {
+		t.Fatalf("unexpected connector record %v", exported)
+	}
+	token, err := seal.Open(key, &seal.Sealed{
+		WrappedKey: exported.Secret.WrappedKey,
+		Nonce:      exported.Secret.Nonce,
+		Ciphertext: exported.Secret.Ciphertext,
+	}, []byte(exported.Id))
+	if err != nil || string(token) != "test-token" {
+		t.Fatalf("sealed secret did not open to the token: %q, %v", token, err)
+	}
+
+	// A connector lost since the export is recreated; the live one is left alone.
+	lost := proto.Clone(exported).(*connV1.ExportedConnector)
+	lost.Id = uuid.NewString()
+	lost.WorkspaceId = "workspace-lost"
+	lost.Secret = nil
+
+	restore, err := client.RestoreConnectors(ctx)
+	if err != nil {
+		t.Fatalf("RestoreConnectors failed: %v", err)
+	}
+	for _, req := range []*connV1.RestoreConnectorsRequest{
+		{Record: records[0]},
+		{Record: &connV1.ExportRecord{Record: &connV1.ExportRecord_Connector{Connector: exported}}, Token: string(token)},
+		{Record: &connV1.ExportRecord{Record: &connV1.ExportRecord_Connector{Connector: lost}}, Token: "lost-token"},
+	} {
+		if err := restore.Send(req); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	res, err := restore.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv failed: %v", err)
+	}
+	want := []connV1.ImportStatus{
+		connV1.ImportStatus_IMPORT_STATUS_ALREADY_EXISTS,
+		connV1.ImportStatus_IMPORT_STATUS_CREATED,
+	}
+	if len(res.Results) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(res.Results))
+	}
+	for i, result := range res.Results {
+		if result.Status != want[i] {
+			t.Errorf("item %d: expected %v, got %v (%s)", i, want[i], result.Status, result.Error)
+		}
+	}
+
+	got, err := client.GetConnector(ctx, &connV1.GetConnectorRequest{Id: lost.Id})
+	if err != nil {
+		t.Fatalf("restored connector not found: %v", err)
+	}
+	if got.Connector.WorkspaceId != "workspace-lost" || got.Connector.Status != connV1.ConnectorStatus_CONNECTOR_STATUS_ACTIVE {
+		t.Errorf("unexpected restored connector %v", got.Connector)
+	}
+}
@@ -0,0 +1,90 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/connector-recruitment/test/integration/testserver"
+
+	connV1 "github.com/connector-recruitment/proto/gen/connector/v1"
+)
+
+func TestImportConnectors(t *testing.T) {
+	server := testserver.SetupIntegrationTestServer(t)
+
+	ctx := context.Background()
+	client := connV1.NewConnectorServiceClient(server.GrpcConn)
+	tenantClient := connV1.NewTenantServiceClient(server.GrpcConn)
+	if _, err := tenantClient.CreateTenant(ctx, &connV1.CreateTenantRequest{Id: "tenant-import", Name: "Import"}); err != nil {
+		t.Fatalf("CreateTenant failed: %v", err)
+	}
+
+	item := func(workspaceID string) *connV1.ImportConnectorsRequest {
+		return &connV1.ImportConnectorsRequest{Connector: &connV1.CreateConnectorRequest{
+			WorkspaceId:        workspaceID,
+			TenantId:           "tenant-import",
+			Token:              "test-token",
+			DefaultChannelName: "general",
+		}}
+	}
+	items := []*connV1.ImportConnectorsRequest{
+		item("workspace-a"),
+		item("workspace-b"),
+		item("workspace-a"),
+		item("bad workspace"),
+		{},
+	}
+
+	stream, err := client.ImportConnectors(ctx)
+	if err != nil {
+		t.Fatalf("ImportConnectors failed: %v", err)
+	}
+	for _, req := range items {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv failed: %v", err)
+	}
+
+	want := []connV1.ImportStatus{
+		connV1.ImportStatus_IMPORT_STATUS_CREATED,
+		connV1.ImportStatus_IMPORT_STATUS_CREATED,
+		connV1.ImportStatus_IMPORT_STATUS_DUPLICATE,
+		connV1.ImportStatus_IMPORT_STATUS_INVALID,
+		connV1.ImportStatus_IMPORT_STATUS_INVALID,
+	}
+	if len(res.Results) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(res.Results))
+	}
+	for i, result := range res.Results {
+		if result.Status != want[i] {
+			t.Errorf("item %d: expected %v, got %v (%s)", i, want[i], result.Status, result.Error)
+		}
+	}
+	if res.Summary.Created != 2 || res.Summary.Total != 5 {
+		t.Errorf("unexpected summary %v", res.Summary)
+	}
+
+	if _, err := client.GetConnector(ctx, &connV1.GetConnectorRequest{Id: res.Results[0].ConnectorId}); err != nil {
+		t.Errorf("imported connector not found: %v", err)
+	}
+
+	// Importing the same file again reports the connectors as already there.
+	again, err := client.ImportConnectors(ctx)
+	if err != nil {
+		t.Fatalf("ImportConnectors failed: %v", err)
+	}
+	if err := again.Send(item("workspace-a")); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	res, err = again.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv failed: %v", err)
+	}
+	if res.Results[0].Status != connV1.ImportStatus_IMPORT_STATUS_ALREADY_EXISTS {
+		t.Errorf("expected ALREADY_EXISTS, got %v", res.Results[0].Status)
+	}
+}
@@ -15,6 +15,14 @@ func TestConnectorServiceGRPC(t *testing.T) {
 
 	ctx := context.Background()
 	client := connV1.NewConnectorServiceClient(server.GrpcConn)
+	tenantClient := connV1.NewTenantServiceClient(server.GrpcConn)
+
+	if _, err := tenantClient.CreateTenant(ctx, &connV1.CreateTenantRequest{
+		Id:   "tenant-1",
+		Name: "Tenant One",
+	}); err != nil {
+		t.Fatalf("CreateTenant failed: %v", err)
+	}
 
 	createReq := &connV1.CreateConnectorRequest{
 		WorkspaceId:        "workspace-1",
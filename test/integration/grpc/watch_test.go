@@ -0,0 +1,105 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/test/integration/testserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	connV1 "github.com/connector-recruitment/proto/gen/connector/v1"
+)
+
+func TestWatchConnectors(t *testing.T) {
+	server := testserver.SetupIntegrationTestServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	client := connV1.NewConnectorServiceClient(server.GrpcConn)
+	tenantClient := connV1.NewTenantServiceClient(server.GrpcConn)
+
+	for _, id := range []string{"tenant-watch", "tenant-other"} {
+		if _, err := tenantClient.CreateTenant(ctx, &connV1.CreateTenantRequest{Id: id, Name: id}); err != nil {
+			t.Fatalf("CreateTenant failed: %v", err)
+		}
+	}
+
+	// Wait for the first heartbeat so the stream is known to be live.
+	stream, err := client.WatchConnectors(ctx, &connV1.WatchConnectorsRequest{TenantId: "tenant-watch"})
+	if err != nil {
+		t.Fatalf("WatchConnectors failed: %v", err)
+	}
+	res, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv failed: %v", err)
+	}
+	if res.GetHeartbeat() == nil {
+		t.Fatalf("expected a heartbeat, got %v", res)
+	}
+
+	create := func(workspaceID, tenantID string) *connV1.Connector {
+		resp, err := client.CreateConnector(ctx, &connV1.CreateConnectorRequest{
+			WorkspaceId:        workspaceID,
+			TenantId:           tenantID,
+			Token:              "test-token",
+			DefaultChannelName: "general",
+		})
+		if err != nil {
+			t.Fatalf("CreateConnector failed: %v", err)
+		}
+		return resp.Connector
+	}
+	create("workspace-other", "tenant-other")
+	created := create("workspace-watch", "tenant-watch")
+	if _, err := client.DeleteConnector(ctx, &connV1.DeleteConnectorRequest{
+		Id:          created.Id,
+		WorkspaceId: "workspace-watch",
+		TenantId:    "tenant-watch",
+	}); err != nil {
+		t.Fatalf("DeleteConnector failed: %v", err)
+	}
+
+	nextEvent := func(stream connV1.ConnectorService_WatchConnectorsClient) (*connV1.ConnectorEvent, string) {
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				t.Fatalf("Recv failed: %v", err)
+			}
+			if event := res.GetEvent(); event != nil {
+				return event, res.ResumeToken
+			}
+		}
+	}
+
+	createdEvent, createdToken := nextEvent(stream)
+	if createdEvent.Type != connV1.ConnectorEventType_CONNECTOR_EVENT_TYPE_CREATED || createdEvent.Connector.Id != created.Id {
+		t.Fatalf("expected created event for %s, got %v", created.Id, createdEvent)
+	}
+	deletedEvent, _ := nextEvent(stream)
+	if deletedEvent.Type != connV1.ConnectorEventType_CONNECTOR_EVENT_TYPE_DELETED || deletedEvent.DeletedAt == nil {
+		t.Fatalf("expected deleted event, got %v", deletedEvent)
+	}
+
+	// Resuming after the created event replays the delete.
+	resumed, err := client.WatchConnectors(ctx, &connV1.WatchConnectorsRequest{
+		TenantId:    "tenant-watch",
+		ResumeToken: createdToken,
+	})
+	if err != nil {
+		t.Fatalf("WatchConnectors failed: %v", err)
+	}
+	replayed, _ := nextEvent(resumed)
+	if replayed.Id != deletedEvent.Id {
+		t.Errorf("expected replay of %s, got %s", deletedEvent.Id, replayed.Id)
+	}
+
+	invalid, err := client.WatchConnectors(ctx, &connV1.WatchConnectorsRequest{TenantId: "tenant-watch", ResumeToken: "abc"})
+	if err == nil {
+		_, err = invalid.Recv()
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	connV1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/connector-recruitment/proto/gen/connector/v1/connectorv1connect"
+	"github.com/connector-recruitment/test/integration/testserver"
+	"github.com/google/uuid"
+)
+
+func TestConnect_ConnectorLifecycle(t *testing.T) {
+	srv := testserver.SetupIntegrationTestServer(t)
+
+	tenantClient := connV1.NewTenantServiceClient(srv.GrpcConn)
+	if _, err := tenantClient.CreateTenant(context.Background(), &connV1.CreateTenantRequest{
+		Id:   "tenant-connect",
+		Name: "Tenant Connect",
+	}); err != nil {
+		t.Fatalf("CreateTenant failed: %v", err)
+	}
+
+	clients := map[string]connectorv1connect.ConnectorServiceClient{
+		"connect":  connectorv1connect.NewConnectorServiceClient(http.DefaultClient, srv.HTTPAddress),
+		"grpc-web": connectorv1connect.NewConnectorServiceClient(http.DefaultClient, srv.HTTPAddress, connect.WithGRPCWeb()),
+	}
+	for name, client := range clients {
+		t.Run(name, func(t *testing.T) {
+			workspaceID := "workspace-" + name
+			created, err := client.CreateConnector(context.Background(), connect.NewRequest(&connV1.CreateConnectorRequest{
+				WorkspaceId:        workspaceID,
+				TenantId:           "tenant-connect",
+				Token:              "test-token",
+				DefaultChannelName: "general",
+			}))
+			if err != nil {
+				t.Fatalf("CreateConnector failed: %v", err)
+			}
+			if created.Header().Get("X-Request-Id") == "" {
+				t.Error("expected X-Request-Id response header")
+			}
+
+			got, err := client.GetConnector(context.Background(), connect.NewRequest(&connV1.GetConnectorRequest{
+				Id: created.Msg.Connector.Id,
+			}))
+			if err != nil {
+				t.Fatalf("GetConnector failed: %v", err)
+			}
+			if got.Msg.Connector.WorkspaceId != workspaceID {
+				t.Errorf("expected workspace id %q, got %q", workspaceID, got.Msg.Connector.WorkspaceId)
+			}
+
+			_, err = client.GetConnector(context.Background(), connect.NewRequest(&connV1.GetConnectorRequest{
+				Id: uuid.NewString(),
+			}))
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeNotFound {
+				t.Errorf("expected NotFound, got %v", err)
+			}
+
+			_, err = client.GetConnector(context.Background(), connect.NewRequest(&connV1.GetConnectorRequest{Id: "bad"}))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
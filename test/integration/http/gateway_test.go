@@ -0,0 +1,102 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	connV1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/connector-recruitment/test/integration/testserver"
+	"github.com/google/uuid"
+)
+
+func TestRESTGateway_ConnectorLifecycle(t *testing.T) {
+	srv := testserver.SetupIntegrationTestServer(t)
+
+	tenantClient := connV1.NewTenantServiceClient(srv.GrpcConn)
+	if _, err := tenantClient.CreateTenant(context.Background(), &connV1.CreateTenantRequest{
+		Id:   "tenant-rest",
+		Name: "Tenant REST",
+	}); err != nil {
+		t.Fatalf("CreateTenant failed: %v", err)
+	}
+
+	body, _ := json.Marshal(map[string]string{
+		"workspace_id":         "workspace-rest",
+		"tenant_id":            "tenant-rest",
+		"token":                "test-token",
+		"default_channel_name": "general",
+	})
+	req, _ := http.NewRequest(http.MethodPost, srv.HTTPAddress+"/v1/connectors", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /v1/connectors failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 OK, got %d", resp.StatusCode)
+	}
+
+	var created struct {
+		Connector struct {
+			ID          string `json:"id"`
+			WorkspaceID string `json:"workspace_id"`
+		} `json:"connector"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if created.Connector.WorkspaceID != "workspace-rest" {
+		t.Errorf("expected workspace id %q, got %q", "workspace-rest", created.Connector.WorkspaceID)
+	}
+
+	getResp, err := http.Get(srv.HTTPAddress + "/v1/connectors/" + created.Connector.ID)
+	if err != nil {
+		t.Fatalf("GET /v1/connectors/{id} failed: %v", err)
+	}
+	getResp.Body.Close()
+	if getResp.StatusCode != http.StatusOK {
+		t.Errorf("expected 200 OK, got %d", getResp.StatusCode)
+	}
+
+	delReq, _ := http.NewRequest(http.MethodDelete, srv.HTTPAddress+"/v1/connectors/"+created.Connector.ID+
+		"?workspace_id=workspace-rest&tenant_id=tenant-rest", nil)
+	delResp, err := http.DefaultClient.Do(delReq)
+	if err != nil {
+		t.Fatalf("DELETE /v1/connectors/{id} failed: %v", err)
+	}
+	delResp.Body.Close()
+	if delResp.StatusCode != http.StatusOK {
+		t.Errorf("expected 200 OK, got %d", delResp.StatusCode)
+	}
+}
+
+func TestRESTGateway_StatusMapping(t *testing.T) {
+	srv := testserver.SetupIntegrationTestServer(t)
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+	}{
+		{name: "not found", path: "/v1/connectors/" + uuid.NewString(), expectedStatus: http.StatusNotFound},
+		{name: "invalid id", path: "/v1/connectors/not-a-uuid", expectedStatus: http.StatusBadRequest},
+		{name: "unknown route", path: "/v1/unknown", expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(srv.HTTPAddress + tt.path)
+			if err != nil {
+				t.Fatalf("GET %s failed: %v", tt.path, err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	pg "github.com/connector-recruitment/internal/infrastructure/postgres"
+	"github.com/connector-recruitment/test/integration/testserver"
+)
+
+func TestAuditRepository_ConcurrentAppendsKeepChain(t *testing.T) {
+	ctx := context.Background()
+	pgContainer, err := testserver.NewPostgresContainer(ctx)
+	if err != nil {
+		t.Fatalf("failed to start Postgres: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := pgContainer.Cleanup(ctx); err != nil {
+			t.Errorf("failed to cleanup Postgres container: %v", err)
+		}
+	})
+
+	db, err := pg.InitDb(ctx, pgContainer.DSN)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := pg.NewAuditRepository(db)
+
+	const writers = 20
+	var wg sync.WaitGroup
+	errs := make([]error, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = repo.Append(ctx, &domain.AuditEvent{
+				OccurredAt: time.Now(),
+				Actor:      "test",
+				TenantID:   fmt.Sprintf("tenant-%d", i%3),
+				Action:     domain.AuditActionConnectorRead,
+				TargetType: "connector",
+				TargetID:   fmt.Sprintf("connector-%d", i),
+				Outcome:    domain.AuditOutcomeSuccess,
+			})
+		}(i)
+	}
+
+	// Reads are not blocked by writers holding the chain lock.
+	if _, _, err := repo.List(ctx, domain.AuditFilter{}, 10, 0); err != nil {
+		t.Errorf("list: %v", err)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+	checked, err := connector.NewAuditService(repo).VerifyChain(ctx)
+	if err != nil {
+		t.Fatalf("verify chain: %v", err)
+	}
+	if checked != writers {
+		t.Errorf("verified %d events, want %d", checked, writers)
+	}
+}
@@ -0,0 +1,138 @@
+package postgres_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	pg "github.com/connector-recruitment/internal/infrastructure/postgres"
+	"github.com/connector-recruitment/migrations"
+	"github.com/connector-recruitment/test/integration/testserver"
+)
+
+func TestMigrator_DownAndUp(t *testing.T) {
+	ctx := context.Background()
+	pgContainer, err := testserver.NewPostgresContainer(ctx)
+	if err != nil {
+		t.Fatalf("failed to start Postgres: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := pgContainer.Cleanup(ctx); err != nil {
+			t.Errorf("failed to cleanup Postgres container: %v", err)
+		}
+	})
+
+	db, err := pg.InitDb(ctx, pgContainer.DSN)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrator, err := pg.NewMigrator(db, migrations.FS)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	known, _ := pg.LoadMigrations(migrations.FS)
+
+	// The container is already migrated.
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	for _, s := range statuses {
+		if s.AppliedAt == nil {
+			t.Errorf("migration %03d_%s not applied", s.Version, s.Name)
+		}
+	}
+
+	reverted, err := migrator.Down(ctx, len(known))
+	if err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	if len(reverted) != len(known) {
+		t.Fatalf("reverted %d migrations, want %d", len(reverted), len(known))
+	}
+	var tables int
+	if err := db.GetContext(ctx, &tables,
+		`SELECT count(*) FROM information_schema.tables WHERE table_schema = 'public' AND table_name <> 'schema_migrations'`); err != nil {
+		t.Fatalf("count tables: %v", err)
+	}
+	if tables != 0 {
+		t.Errorf("%d tables left after reverting every migration", tables)
+	}
+
+	// Servers starting together apply each migration once.
+	var wg sync.WaitGroup
+	applied := make([][]pg.Migration, 3)
+	errs := make([]error, 3)
+	for i := range applied {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			applied[i], errs[i] = migrator.Up(ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	total := 0
+	for i := range applied {
+		if errs[i] != nil {
+			t.Fatalf("up: %v", errs[i])
+		}
+		total += len(applied[i])
+	}
+	if total != len(known) {
+		t.Errorf("applied %d migrations in total, want %d", total, len(known))
+	}
+}
+
+func TestMigrator_SoftDeleteDownKeepsDeletedConnectors(t *testing.T) {
+	ctx := context.Background()
+	pgContainer, err := testserver.NewPostgresContainer(ctx)
+	if err != nil {
+		t.Fatalf("failed to start Postgres: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := pgContainer.Cleanup(ctx); err != nil {
+			t.Errorf("failed to cleanup Postgres container: %v", err)
+		}
+	})
+
+	db, err := pg.InitDb(ctx, pgContainer.DSN)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	if _, err := db.ExecContext(ctx, `INSERT INTO tenants (id, name, status, created_at, updated_at) VALUES ('440', '440', 'active', NOW(), NOW())`); err != nil {
+		t.Fatalf("insert tenant: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO connectors (id, workspace_id, tenant_id, default_channel_id, created_at, updated_at, secret_version, secret_name, deleted_at)
+		VALUES (gen_random_uuid(), 'T1', '440', 'C1', NOW(), NOW(), 'v1', 'connector/test/connectors/1', NOW())`); err != nil {
+		t.Fatalf("insert connector: %v", err)
+	}
+
+	migrator, err := pg.NewMigrator(db, migrations.FS)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	known, _ := pg.LoadMigrations(migrations.FS)
+	var steps int
+	for _, m := range known {
+		if m.Version >= 7 {
+			steps++
+		}
+	}
+
+	if _, err := migrator.Down(ctx, steps); err == nil {
+		t.Fatal("reverting 007 with a soft deleted connector succeeded")
+	}
+	var deleted int
+	if err := db.GetContext(ctx, &deleted, `SELECT count(*) FROM connectors WHERE deleted_at IS NOT NULL`); err != nil {
+		t.Fatalf("count deleted connectors: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("%d soft deleted connectors left, want 1", deleted)
+	}
+}
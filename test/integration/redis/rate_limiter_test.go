@@ -0,0 +1,79 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/infrastructure/redis"
+	"github.com/connector-recruitment/test/integration/testserver"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, err := testserver.NewRedisContainer(ctx)
+	if err != nil {
+		t.Fatalf("failed to start Redis: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := redisContainer.Cleanup(ctx); err != nil {
+			t.Errorf("failed to cleanup Redis container: %v", err)
+		}
+	})
+
+	client, err := redis.IntClient(ctx, redisContainer.Address)
+	if err != nil {
+		t.Fatalf("failed to connect to Redis: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	// Two limiters on one Redis stand in for two replicas.
+	replicaA := redis.NewRateLimiter(client, "slack:ratelimit:")
+	replicaB := redis.NewRateLimiter(client, "slack:ratelimit:")
+
+	t.Run("replicas share the burst", func(t *testing.T) {
+		for i, limiter := range []*redis.RateLimiter{replicaA, replicaB, replicaA} {
+			wait, err := limiter.Reserve(ctx, "shared", time.Minute, 3)
+			if err != nil {
+				t.Fatalf("reserve %d failed: %v", i, err)
+			}
+			if wait != 0 {
+				t.Fatalf("reserve %d: expected no wait within the burst, got %v", i, wait)
+			}
+		}
+		wait, err := replicaB.Reserve(ctx, "shared", time.Minute, 3)
+		if err != nil {
+			t.Fatalf("reserve failed: %v", err)
+		}
+		if wait <= 55*time.Second || wait > time.Minute {
+			t.Errorf("expected to wait about a minute once the burst is spent, got %v", wait)
+		}
+	})
+
+	t.Run("buckets refill", func(t *testing.T) {
+		if wait, err := replicaA.Reserve(ctx, "refill", 100*time.Millisecond, 1); err != nil || wait != 0 {
+			t.Fatalf("expected first reserve to pass, got wait %v, err %v", wait, err)
+		}
+		wait, err := replicaB.Reserve(ctx, "refill", 100*time.Millisecond, 1)
+		if err != nil || wait <= 0 {
+			t.Fatalf("expected second reserve to wait, got wait %v, err %v", wait, err)
+		}
+		time.Sleep(wait)
+		if wait, err := replicaB.Reserve(ctx, "refill", 100*time.Millisecond, 1); err != nil || wait != 0 {
+			t.Errorf("expected reserve after the wait to pass, got wait %v, err %v", wait, err)
+		}
+	})
+
+	t.Run("pause holds back every replica", func(t *testing.T) {
+		if err := replicaA.Pause(ctx, "paused", time.Millisecond, 5, 2*time.Second); err != nil {
+			t.Fatalf("pause failed: %v", err)
+		}
+		wait, err := replicaB.Reserve(ctx, "paused", time.Millisecond, 5)
+		if err != nil {
+			t.Fatalf("reserve failed: %v", err)
+		}
+		if wait <= time.Second || wait > 2*time.Second {
+			t.Errorf("expected to wait out the pause, got %v", wait)
+		}
+	})
+}
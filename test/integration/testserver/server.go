@@ -9,8 +9,6 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
-	"path/filepath"
-	"runtime"
 	"testing"
 	"time"
 
@@ -32,6 +30,7 @@ import (
 	"github.com/connector-recruitment/internal/infrastructure/aws/secretsmanager"
 	pg "github.com/connector-recruitment/internal/infrastructure/postgres" // alias for postgres package
 	grpcHandler "github.com/connector-recruitment/internal/transport/grpc"
+	"github.com/connector-recruitment/migrations"
 	conV1 "github.com/connector-recruitment/proto/gen/connector/v1"
 	_ "github.com/lib/pq"
 	"github.com/testcontainers/testcontainers-go"
@@ -53,12 +52,6 @@ type PostgresContainer struct {
 }
 
 func NewPostgresContainer(ctx context.Context) (*PostgresContainer, error) {
-	_, filename, _, ok := runtime.Caller(0)
-	if !ok {
-		return nil, fmt.Errorf("failed to get caller info")
-	}
-	initSQLPath := filepath.Join(filepath.Dir(filename), "../../../migrations/001_init.sql")
-
 	req := testcontainers.ContainerRequest{
 		Image:        "postgres:15-alpine",
 		ExposedPorts: []string{"5432/tcp"},
@@ -67,14 +60,8 @@ func NewPostgresContainer(ctx context.Context) (*PostgresContainer, error) {
 			"POSTGRES_PASSWORD": "test",
 			"POSTGRES_DB":       "testdb",
 		},
-		Files: []testcontainers.ContainerFile{
-			{
-				HostFilePath:      initSQLPath,
-				ContainerFilePath: "/docker-entrypoint-initdb.d/init.sql",
-				FileMode:          0644,
-			},
-		},
-		WaitingFor: wait.ForListeningPort("5432/tcp"),
+		// The entrypoint restarts the server once after initdb.
+		WaitingFor: wait.ForLog("database system is ready to accept connections").WithOccurrence(2),
 	}
 	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
 		ContainerRequest: req,
@@ -94,12 +81,35 @@ func NewPostgresContainer(ctx context.Context) (*PostgresContainer, error) {
 		return nil, fmt.Errorf("failed to get Postgres container port: %w", err)
 	}
 	dsn := fmt.Sprintf("postgres://test:test@%s:%s/testdb?sslmode=disable", host, port.Port())
+	if err := migrate(ctx, dsn); err != nil {
+		_ = container.Terminate(ctx)
+		return nil, err
+	}
 	return &PostgresContainer{
 		Container: container,
 		DSN:       dsn,
 	}, nil
 }
 
+// migrate applies the embedded migrations, as the server does with
+// MIGRATE_ON_START.
+func migrate(ctx context.Context, dsn string) error {
+	db, err := pg.InitDb(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Postgres: %w", err)
+	}
+	defer db.Close()
+
+	migrator, err := pg.NewMigrator(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+	return nil
+}
+
 func (p *PostgresContainer) Cleanup(ctx context.Context) error {
 	if p.Container != nil {
 		return p.Container.Terminate(ctx)
@@ -234,6 +244,55 @@ func (r *RedisContainer) Cleanup(ctx context.Context) error {
 	return nil
 }
 
+///////////////////////////////////////////////////////////////////////////////
+// NATS Container with JetStream for event publishing
+///////////////////////////////////////////////////////////////////////////////
+
+type NatsContainer struct {
+	Container testcontainers.Container
+	URL       string
+}
+
+func NewNatsContainer(ctx context.Context) (*NatsContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "nats:2-alpine",
+		ExposedPorts: []string{"4222/tcp"},
+		Cmd:          []string{"-js"},
+		WaitingFor:   wait.ForLog("Server is ready"),
+	}
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start NATS container: %w", err)
+	}
+
+	mappedPort, err := container.MappedPort(ctx, "4222")
+	if err != nil {
+		_ = container.Terminate(ctx)
+		return nil, fmt.Errorf("failed to get NATS container port: %w", err)
+	}
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		_ = container.Terminate(ctx)
+		return nil, fmt.Errorf("failed to get NATS container host: %w", err)
+	}
+
+	return &NatsContainer{
+		Container: container,
+		URL:       fmt.Sprintf("nats://%s:%s", host, mappedPort.Port()),
+	}, nil
+}
+
+func (n *NatsContainer) Cleanup(ctx context.Context) error {
+	if n.Container != nil {
+		return n.Container.Terminate(ctx)
+	}
+	return nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // IntegrationTestServer: Bringing It All Together
 ///////////////////////////////////////////////////////////////////////////////
@@ -268,7 +327,20 @@ func SetupIntegrationTestServer(t *testing.T) *IntegrationTestServer {
 			t.Errorf("failed to close database connection: %v", err)
 		}
 	})
-	repo := pg.NewConnectorRepository(db)
+	// Changes go through the outbox so WatchConnectors sees them; nothing relays it.
+	repo := connector.NewEventingRepository(pg.NewConnectorRepository(db), pg.NewTransactor(db),
+		pg.NewOutboxRepository(db), "/connector-recruitment-test")
+	tenantRepo := pg.NewTenantRepository(db)
+
+	outboxListener, err := pg.NewOutboxListener(pgContainer.DSN)
+	if err != nil {
+		t.Fatalf("failed to listen for outbox notifications: %v", err)
+	}
+	t.Cleanup(func() { _ = outboxListener.Close() })
+	watcher := connector.NewConnectorWatcher(pg.NewEventLog(db), 100*time.Millisecond,
+		connector.WithWatchNotifications(outboxListener.Notifications()),
+		connector.WithWatchHeartbeat(time.Second))
+	go watcher.Start(ctx)
 
 	// (2) Start LocalStack Secrets Manager
 	secretsContainer, err := NewSecretsManagerContainer(ctx)
@@ -311,14 +383,20 @@ func SetupIntegrationTestServer(t *testing.T) *IntegrationTestServer {
 	}
 
 	// (4) Create the main Service
+	auditLog := pg.NewAuditRepository(db)
 	svc := connector.NewService(repo, secretsManager, slackClient,
-		resilience.New("github.com/connector-recruitment", testConfig),
-		connector.WithOAuthManager(oauthManager))
+		resilience.NewRegistry(testConfig),
+		connector.WithOAuthManager(oauthManager),
+		connector.WithTenantRepository(tenantRepo),
+		connector.WithIdempotencyStore(pg.NewIdempotencyRepository(db)),
+		connector.WithOperationLog(pg.NewOperationRepository(db)),
+		connector.WithAuditLog(auditLog))
+	tenantSvc := connector.NewTenantService(tenantRepo)
 
 	//---------------------------------------------------------------------
 	// gRPC Setup
 	//---------------------------------------------------------------------
-	grpcSvcHandler := grpcHandler.NewHandler(svc)
+	grpcSvcHandler := grpcHandler.NewHandler(svc, grpcHandler.WithWatcher(watcher))
 
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
@@ -327,6 +405,10 @@ func SetupIntegrationTestServer(t *testing.T) *IntegrationTestServer {
 	bufListener := bufconn.Listen(bufSize)
 
 	conV1.RegisterConnectorServiceServer(grpcServer, grpcSvcHandler)
+	conV1.RegisterTenantServiceServer(grpcServer, grpcHandler.NewTenantHandler(tenantSvc))
+	conV1.RegisterAuditServiceServer(grpcServer, grpcHandler.NewAuditHandler(connector.NewAuditService(auditLog)))
+	conV1.RegisterWebhookServiceServer(grpcServer, grpcHandler.NewWebhookHandler(connector.NewWebhookService(
+		pg.NewWebhookSubscriptionRepository(db), pg.NewWebhookDeliveryRepository(db), tenantRepo, true)))
 
 	log.Println("Starting gRPC server on buf listener...")
 	go func() {
@@ -368,6 +450,15 @@ func SetupIntegrationTestServer(t *testing.T) *IntegrationTestServer {
 	httpMux := http.NewServeMux()
 	httpMux.HandleFunc("/health", httpHandler.Health)
 	httpMux.HandleFunc("/oauth/callback", httpHandler.OAuthCallback)
+	httpMux.HandleFunc("/openapi.json", httpTransport.ServeOpenAPI)
+
+	gateway, closeGateway, err := httpTransport.NewGateway(ctx, "passthrough://bufnet", grpc.WithContextDialer(dialer))
+	if err != nil {
+		t.Fatalf("failed to create REST gateway: %v", err)
+	}
+	t.Cleanup(func() { _ = closeGateway() })
+	httpMux.Handle(httpTransport.GatewayPrefix, gateway)
+	httpMux.Handle(grpcHandler.NewConnectHandler(grpcSvcHandler))
 
 	httpTestServer := httptest.NewServer(httpMux)
 	t.Cleanup(httpTestServer.Close)
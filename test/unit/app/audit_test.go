@@ -0,0 +1,261 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/pkg/requestctx"
+	"github.com/connector-recruitment/pkg/resilience"
+
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// auditRecorder captures events appended through a MockAuditLog.
+type auditRecorder struct {
+	mu     sync.Mutex
+	events []domain.AuditEvent
+}
+
+func (r *auditRecorder) record(args mock.Arguments) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, *args.Get(1).(*domain.AuditEvent))
+}
+
+func (r *auditRecorder) snapshot() []domain.AuditEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]domain.AuditEvent(nil), r.events...)
+}
+
+func setupAuditTest() (*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager, *mocks2.MockSlackClient, *auditRecorder, *connector.Service) {
+	repo := new(mocks2.MockConnectorRepository)
+	sm := new(mocks2.MockSecretsManager)
+	sc := new(mocks2.MockSlackClient)
+	auditLog := new(mocks2.MockAuditLog)
+	recorder := &auditRecorder{}
+	auditLog.On("Append", mock.Anything, mock.Anything).Run(recorder.record).Return(nil)
+
+	testConfig := &config.Config{
+		CircuitBreakerInterval: 60 * time.Second,
+		CircuitBreakerTimeout:  30 * time.Second,
+	}
+	breakers := resilience.NewRegistry(testConfig)
+	service := connector.NewService(repo, sm, sc, breakers, connector.WithAuditLog(auditLog))
+	return repo, sm, sc, recorder, service
+}
+
+func auditContext() context.Context {
+	ctx := requestctx.WithActor(context.Background(), "alice@example.com")
+	return requestctx.WithRequestID(ctx, "req-1")
+}
+
+func TestService_CreateConnector_Audit(t *testing.T) {
+	repo, sm, sc, recorder, service := setupAuditTest()
+	repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").Return(nil, sql.ErrNoRows)
+	sc.On("ResolveChannelID", mock.Anything, "valid-token-12345", "general").Return("C123", nil)
+	sm.On("StoreToken", mock.Anything, mock.Anything, "valid-token-12345").Return(nil)
+	repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	conn, err := service.CreateConnector(auditContext(), connector.CreateInput{
+		WorkspaceID:    "workspace123",
+		TenantID:       "tenant123",
+		Token:          "valid-token-12345",
+		DefaultChannel: "general",
+	})
+	require.NoError(t, err)
+
+	events := recorder.snapshot()
+	require.Len(t, events, 1)
+	event := events[0]
+	assert.Equal(t, domain.AuditActionConnectorCreate, event.Action)
+	assert.Equal(t, domain.AuditOutcomeSuccess, event.Outcome)
+	assert.Equal(t, "alice@example.com", event.Actor)
+	assert.Equal(t, "req-1", event.RequestID)
+	assert.Equal(t, "tenant123", event.TenantID)
+	assert.Equal(t, conn.ID.String(), event.TargetID)
+
+	var diff map[string]map[string]string
+	require.NoError(t, json.Unmarshal(event.Diff, &diff))
+	assert.Equal(t, "C123", diff["default_channel_id"]["new"])
+	assert.NotContains(t, string(event.Diff), "valid-token-12345")
+}
+
+func TestService_DeleteConnector_Audit(t *testing.T) {
+	conn := &domain.Connector{
+		ID:          uuid.New(),
+		WorkspaceID: "workspace123",
+		TenantID:    "tenant123",
+		SecretName:  "connector/development/connectors/1",
+		Status:      domain.ConnectorStatusActive,
+	}
+
+	t.Run("successful delete records the deletion", func(t *testing.T) {
+		repo, sm, _, recorder, service := setupAuditTest()
+		repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil)
+		repo.On("Delete", mock.Anything, conn.ID).Return(nil)
+		sm.On("DeleteToken", mock.Anything, conn.SecretName).Return(nil)
+
+		require.NoError(t, service.DeleteConnector(auditContext(), conn.ID, "workspace123", "tenant123"))
+
+		events := recorder.snapshot()
+		require.Len(t, events, 1)
+		assert.Equal(t, domain.AuditActionConnectorDelete, events[0].Action)
+		assert.Equal(t, domain.AuditOutcomeSuccess, events[0].Outcome)
+		assert.Contains(t, string(events[0].Diff), "deleted_at")
+	})
+
+	t.Run("wrong tenant records a failure", func(t *testing.T) {
+		repo, _, _, recorder, service := setupAuditTest()
+		repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil)
+
+		err := service.DeleteConnector(auditContext(), conn.ID, "workspace123", "other-tenant")
+		assert.ErrorIs(t, err, connector.ErrNotFound)
+
+		events := recorder.snapshot()
+		require.Len(t, events, 1)
+		assert.Equal(t, domain.AuditOutcomeFailure, events[0].Outcome)
+		assert.Equal(t, "other-tenant", events[0].TenantID)
+		assert.Empty(t, events[0].Diff)
+	})
+}
+
+func TestService_GetConnector_Audit(t *testing.T) {
+	repo, _, _, recorder, service := setupAuditTest()
+	id := uuid.New()
+	repo.On("GetByID", mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	_, err := service.GetConnector(context.Background(), id)
+	assert.ErrorIs(t, err, connector.ErrNotFound)
+
+	events := recorder.snapshot()
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.AuditActionConnectorRead, events[0].Action)
+	assert.Equal(t, domain.AuditOutcomeFailure, events[0].Outcome)
+	assert.Equal(t, requestctx.UnknownActor, events[0].Actor)
+	assert.Equal(t, id.String(), events[0].TargetID)
+}
+
+func TestService_AuditFailureDoesNotFailOperation(t *testing.T) {
+	repo := new(mocks2.MockConnectorRepository)
+	auditLog := new(mocks2.MockAuditLog)
+	auditLog.On("Append", mock.Anything, mock.Anything).Return(errors.New("audit unavailable"))
+	conn := &domain.Connector{ID: uuid.New()}
+	repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil)
+
+	testConfig := &config.Config{
+		CircuitBreakerInterval: 60 * time.Second,
+		CircuitBreakerTimeout:  30 * time.Second,
+	}
+	service := connector.NewService(repo, nil, nil, resilience.NewRegistry(testConfig),
+		connector.WithAuditLog(auditLog))
+
+	got, err := service.GetConnector(context.Background(), conn.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, conn, got)
+	auditLog.AssertExpectations(t)
+}
+
+func TestRotationService_Audit(t *testing.T) {
+	repo := new(mocks2.MockConnectorRepository)
+	sm := new(mocks2.MockSecretsManager)
+	auditLog := new(mocks2.MockAuditLog)
+	recorder := &auditRecorder{}
+	auditLog.On("Append", mock.Anything, mock.Anything).Run(recorder.record).Return(nil)
+
+	conn := domain.Connector{ID: uuid.New(), TenantID: "tenant1", SecretName: "secret"}
+	repo.On("ListConnectors", mock.Anything, mock.Anything, mock.Anything).Return([]domain.Connector{conn}, (*domain.ListCursor)(nil), nil)
+	sm.On("StoreToken", mock.Anything, "secret", mock.Anything).Return(nil)
+	repo.On("UpdateConnector", mock.Anything, conn.ID, mock.Anything).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rs := connector.NewRotationService(repo, sm, 10*time.Millisecond, connector.WithRotationAuditLog(auditLog))
+	go rs.Start(ctx)
+
+	require.Eventually(t, func() bool { return len(recorder.snapshot()) > 0 }, time.Second, 5*time.Millisecond)
+	cancel()
+
+	event := recorder.snapshot()[0]
+	assert.Equal(t, domain.AuditActionSecretRotate, event.Action)
+	assert.Equal(t, domain.AuditOutcomeSuccess, event.Outcome)
+	assert.Equal(t, "system:rotation", event.Actor)
+	assert.Equal(t, conn.ID.String(), event.TargetID)
+	assert.Empty(t, event.Diff)
+}
+
+func TestAuditService_Record(t *testing.T) {
+	auditLog := new(mocks2.MockAuditLog)
+	recorder := &auditRecorder{}
+	auditLog.On("Append", mock.Anything, mock.Anything).Run(recorder.record).Return(nil)
+	conn := &domain.Connector{ID: uuid.New(), TenantID: "tenant1", SecretName: "secret"}
+
+	connector.NewAuditService(auditLog).Record(auditContext(), domain.AuditActionSecretRead, conn, errors.New("throttled"))
+
+	events := recorder.snapshot()
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.AuditActionSecretRead, events[0].Action)
+	assert.Equal(t, domain.AuditOutcomeFailure, events[0].Outcome)
+	assert.Equal(t, "alice@example.com", events[0].Actor)
+	assert.Equal(t, conn.ID.String(), events[0].TargetID)
+	assert.Equal(t, "tenant1", events[0].TenantID)
+}
+
+func TestAuditService_VerifyChain(t *testing.T) {
+	chain := func() []domain.AuditEvent {
+		events := make([]domain.AuditEvent, 0, 3)
+		prevHash := ""
+		for i := int64(1); i <= 3; i++ {
+			event := domain.AuditEvent{ID: i, Action: domain.AuditActionConnectorRead, PrevHash: prevHash}
+			event.Hash = event.ComputeHash()
+			prevHash = event.Hash
+			events = append(events, event)
+		}
+		return events
+	}
+
+	t.Run("walks every page", func(t *testing.T) {
+		auditLog := new(mocks2.MockAuditLog)
+		events := chain()
+		auditLog.On("List", mock.Anything, domain.AuditFilter{}, mock.Anything, int64(0)).Return(events[:2], int64(2), nil)
+		auditLog.On("List", mock.Anything, domain.AuditFilter{}, mock.Anything, int64(2)).Return(events[2:], int64(0), nil)
+
+		checked, err := connector.NewAuditService(auditLog).VerifyChain(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, checked)
+	})
+
+	t.Run("detects a tampered event on a later page", func(t *testing.T) {
+		auditLog := new(mocks2.MockAuditLog)
+		events := chain()
+		events[2].Actor = "mallory"
+		auditLog.On("List", mock.Anything, domain.AuditFilter{}, mock.Anything, int64(0)).Return(events[:2], int64(2), nil)
+		auditLog.On("List", mock.Anything, domain.AuditFilter{}, mock.Anything, int64(2)).Return(events[2:], int64(0), nil)
+
+		checked, err := connector.NewAuditService(auditLog).VerifyChain(context.Background())
+		var chainErr *domain.AuditChainError
+		require.ErrorAs(t, err, &chainErr)
+		assert.Equal(t, int64(3), chainErr.EventID)
+		assert.Equal(t, 2, checked)
+	})
+}
+
+func TestAuditService_ListAuditEvents_RejectsInvertedRange(t *testing.T) {
+	now := time.Now()
+	_, _, err := connector.NewAuditService(new(mocks2.MockAuditLog)).ListAuditEvents(context.Background(),
+		domain.AuditFilter{Since: now, Until: now.Add(-time.Hour)}, 10, 0)
+	assert.ErrorIs(t, err, connector.ErrInvalidInput)
+}
@@ -0,0 +1,226 @@
+package app_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"database/sql"
+	"encoding/pem"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/connector-recruitment/pkg/resilience"
+	"github.com/connector-recruitment/pkg/seal"
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupBackupTest() (*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager, *auditRecorder, *connector.Service) {
+	repo := new(mocks2.MockConnectorRepository)
+	sm := new(mocks2.MockSecretsManager)
+	auditLog := new(mocks2.MockAuditLog)
+	recorder := &auditRecorder{}
+	auditLog.On("Append", mock.Anything, mock.Anything).Run(recorder.record).Return(nil)
+	breakers := resilience.NewRegistry(&config.Config{
+		CircuitBreakerInterval: 60 * time.Second,
+		CircuitBreakerTimeout:  30 * time.Second,
+	})
+	service := connector.NewService(repo, sm, new(mocks2.MockSlackClient), breakers,
+		connector.WithSecretNaming(domain.NewSecretNaming("connector", "test")),
+		connector.WithAuditLog(auditLog))
+	return repo, sm, recorder, service
+}
+
+// restoreSource returns a next function handing out items, then io.EOF.
+func restoreSource(items ...connector.RestoreItem) func() (connector.RestoreItem, error) {
+	var i int
+	return func() (connector.RestoreItem, error) {
+		if i == len(items) {
+			return connector.RestoreItem{}, io.EOF
+		}
+		i++
+		return items[i-1], nil
+	}
+}
+
+func backupConnector(workspaceID, tenantID string) domain.Connector {
+	return domain.Connector{
+		ID:               uuid.New(),
+		WorkspaceID:      workspaceID,
+		TenantID:         tenantID,
+		DefaultChannelID: "C123",
+		CreatedAt:        time.Now().UTC(),
+		UpdatedAt:        time.Now().UTC(),
+		SecretVersion:    "v1",
+		SecretName:       "connector-" + workspaceID,
+		Status:           domain.ConnectorStatusActive,
+	}
+}
+
+func publicKeyPEM(t *testing.T, pub *rsa.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestSeal_RoundTrip(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	sealed, err := seal.Seal(&key.PublicKey, []byte("xoxb-secret"), []byte("connector-a"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed.Ciphertext), "xoxb-secret")
+
+	plaintext, err := seal.Open(key, sealed, []byte("connector-a"))
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-secret", string(plaintext))
+
+	_, err = seal.Open(key, sealed, []byte("connector-b"))
+	assert.ErrorIs(t, err, seal.ErrOpen, "a secret moved to another connector must not open")
+	_, err = seal.Open(other, sealed, []byte("connector-a"))
+	assert.ErrorIs(t, err, seal.ErrOpen)
+	assert.NotEqual(t, seal.Fingerprint(&key.PublicKey), seal.Fingerprint(&other.PublicKey))
+}
+
+func TestSeal_RejectsSmallKeys(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = seal.ParsePublicKeyPEM(publicKeyPEM(t, &key.PublicKey))
+	assert.ErrorIs(t, err, seal.ErrInvalidKey)
+}
+
+func TestService_ExportConnectors(t *testing.T) {
+	repo, sm, recorder, service := setupBackupTest()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	withSecret := backupConnector("workspace-a", "tenant123")
+	missingSecret := backupConnector("workspace-b", "tenant123")
+	otherTenant := backupConnector("workspace-c", "tenant456")
+	repo.On("ListConnectors", mock.Anything, mock.Anything, (*domain.ListCursor)(nil)).
+		Return([]domain.Connector{withSecret, otherTenant, missingSecret}, (*domain.ListCursor)(nil), nil)
+	sm.On("GetToken", mock.Anything, withSecret.SecretName).Return("xoxb-a", nil)
+	sm.On("GetToken", mock.Anything, missingSecret.SecretName).Return("", &types.ResourceNotFoundException{})
+
+	var exported []connector.ExportedConnector
+	err = service.ExportConnectors(context.Background(), "tenant123", &key.PublicKey, func(item connector.ExportedConnector) error {
+		exported = append(exported, item)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, exported, 2)
+
+	assert.Equal(t, withSecret.ID, exported[0].Connector.ID)
+	require.NotNil(t, exported[0].Secret)
+	token, err := seal.Open(key, exported[0].Secret, connector.SecretAssociatedData(&withSecret))
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-a", string(token))
+
+	assert.Equal(t, missingSecret.ID, exported[1].Connector.ID)
+	assert.Nil(t, exported[1].Secret, "a missing secret is left out, not fatal")
+
+	events := recorder.snapshot()
+	require.Len(t, events, 1, "only connectors whose secret left the service are audited")
+	assert.Equal(t, domain.AuditActionConnectorExport, events[0].Action)
+	assert.Equal(t, withSecret.ID.String(), events[0].TargetID)
+}
+
+func TestService_ExportConnectors_WithoutKeyLeavesSecretsAlone(t *testing.T) {
+	repo, sm, recorder, service := setupBackupTest()
+	conn := backupConnector("workspace-a", "tenant123")
+	repo.On("ListConnectors", mock.Anything, mock.Anything, (*domain.ListCursor)(nil)).
+		Return([]domain.Connector{conn}, (*domain.ListCursor)(nil), nil)
+
+	var exported []connector.ExportedConnector
+	err := service.ExportConnectors(context.Background(), "", nil, func(item connector.ExportedConnector) error {
+		exported = append(exported, item)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	assert.Nil(t, exported[0].Secret)
+	sm.AssertNotCalled(t, "GetToken", mock.Anything, mock.Anything)
+	assert.Empty(t, recorder.snapshot())
+}
+
+func TestService_RestoreConnectors(t *testing.T) {
+	repo, sm, recorder, service := setupBackupTest()
+	naming := domain.NewSecretNaming("connector", "test")
+
+	restored := backupConnector("workspace-a", "tenant123")
+	restored.SecretVersion = "xoxb-old-token"
+	stillLive := backupConnector("workspace-b", "tenant123")
+	noSecret := backupConnector("workspace-c", "tenant123")
+
+	for _, conn := range []domain.Connector{restored, noSecret} {
+		repo.On("GetByID", mock.Anything, conn.ID).Return(nil, sql.ErrNoRows)
+		repo.On("GetDeletedByID", mock.Anything, conn.ID).Return(nil, sql.ErrNoRows)
+		repo.On("GetByWorkspaceAndTenant", mock.Anything, conn.WorkspaceID, conn.TenantID).Return(nil, sql.ErrNoRows)
+	}
+	repo.On("GetByID", mock.Anything, stillLive.ID).Return(&stillLive, nil)
+	sm.On("StoreToken", mock.Anything, naming.Name(restored.ID), "xoxb-a").Return(nil)
+	sm.On("GetToken", mock.Anything, naming.Name(noSecret.ID)).Return("", &types.ResourceNotFoundException{})
+	repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	report, err := service.RestoreConnectors(context.Background(), connector.ExportFormatVersion, restoreSource(
+		connector.RestoreItem{Connector: restored, Token: "xoxb-a"},
+		connector.RestoreItem{Connector: stillLive, Token: "xoxb-b"},
+		connector.RestoreItem{Connector: noSecret},
+		connector.RestoreItem{Connector: restored, Token: "xoxb-a"},
+	))
+	require.NoError(t, err)
+
+	statuses := make([]connector.ImportStatus, 0, len(report.Results))
+	for _, result := range report.Results {
+		statuses = append(statuses, result.Status)
+	}
+	assert.Equal(t, []connector.ImportStatus{
+		connector.ImportStatusCreated,
+		connector.ImportStatusAlreadyExists,
+		connector.ImportStatusCreated,
+		connector.ImportStatusDuplicate,
+	}, statuses)
+	assert.Equal(t, 2, report.Summary.Created)
+
+	var created []mock.Call
+	for _, call := range repo.Calls {
+		if call.Method == "Create" {
+			created = append(created, call)
+		}
+	}
+	require.Len(t, created, 2)
+	first := created[0].Arguments.Get(1).(*domain.Connector)
+	assert.Equal(t, restored.ID, first.ID, "the original ID is kept")
+	assert.Equal(t, naming.Name(restored.ID), first.SecretName)
+	assert.Equal(t, "v1", first.SecretVersion)
+	assert.Equal(t, domain.ConnectorStatusActive, first.Status)
+	second := created[1].Arguments.Get(1).(*domain.Connector)
+	assert.Equal(t, domain.ConnectorStatusErrored, second.Status, "a connector without a secret is restored as errored")
+	sm.AssertNotCalled(t, "StoreToken", mock.Anything, naming.Name(stillLive.ID), mock.Anything)
+
+	events := recorder.snapshot()
+	require.Len(t, events, 2)
+	for _, event := range events {
+		assert.Equal(t, domain.AuditActionConnectorRecover, event.Action)
+		assert.Equal(t, domain.AuditOutcomeSuccess, event.Outcome)
+	}
+}
+
+func TestService_RestoreConnectors_RejectsUnknownFormat(t *testing.T) {
+	_, _, _, service := setupBackupTest()
+	for _, version := range []int{0, connector.ExportFormatVersion + 1} {
+		_, err := service.RestoreConnectors(context.Background(), version, restoreSource())
+		assert.ErrorIs(t, err, connector.ErrInvalidInput, "version %d", version)
+	}
+}
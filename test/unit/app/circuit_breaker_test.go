@@ -0,0 +1,199 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/pkg/resilience"
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var errDependency = errors.New("dependency failed")
+
+func newTestBreaker(policy resilience.Policy, opts ...resilience.Option) *resilience.CircuitBreaker {
+	if policy.MaxConsecutiveFailures == 0 {
+		policy.MaxConsecutiveFailures = 100
+	}
+	if policy.HalfOpenRequests == 0 {
+		policy.HalfOpenRequests = 1
+	}
+	if policy.Timeout == 0 {
+		policy.Timeout = time.Minute
+	}
+	return resilience.New("test", &config.Config{}, append(opts, resilience.WithPolicy(policy))...)
+}
+
+func TestCircuitBreaker_FailureRatio(t *testing.T) {
+	cb := newTestBreaker(resilience.Policy{FailureRatio: 0.5, MinRequests: 4})
+
+	for _, err := range []error{nil, errDependency, nil} {
+		assert.Equal(t, err, cb.Do(context.Background(), func() error { return err }))
+	}
+	assert.Equal(t, resilience.StateClosed, cb.Snapshot().State, "below the minimum request count")
+
+	_ = cb.Do(context.Background(), func() error { return errDependency })
+	assert.Equal(t, resilience.StateOpen, cb.Snapshot().State)
+
+	called := false
+	err := cb.Do(context.Background(), func() error { called = true; return nil })
+	assert.ErrorIs(t, err, resilience.ErrOpen)
+	assert.False(t, called)
+}
+
+func TestCircuitBreaker_IgnoresClientErrorsAndCancellation(t *testing.T) {
+	cb := newTestBreaker(resilience.Policy{MaxConsecutiveFailures: 1},
+		resilience.WithClientErrors(connector.IsClientError))
+
+	for i := 0; i < 3; i++ {
+		_ = cb.Do(context.Background(), func() error { return connector.ErrInvalidInput })
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		_ = cb.Do(ctx, func() error {
+			cancel()
+			return ctx.Err()
+		})
+	}
+	snapshot := cb.Snapshot()
+	assert.Equal(t, resilience.StateClosed, snapshot.State)
+	assert.Equal(t, 0, snapshot.Counts.Failures)
+
+	called := false
+	err := cb.Do(ctx, func() error { called = true; return nil })
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, called, "a done context skips the call")
+}
+
+func TestCircuitBreaker_SlowCallRatio(t *testing.T) {
+	cb := newTestBreaker(resilience.Policy{
+		SlowCallDuration: 5 * time.Millisecond,
+		SlowCallRatio:    0.5,
+		MinRequests:      2,
+	})
+
+	_ = cb.Do(context.Background(), func() error { return nil })
+	_ = cb.Do(context.Background(), func() error {
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+
+	assert.Equal(t, resilience.StateOpen, cb.Snapshot().State)
+}
+
+func TestCircuitBreaker_HalfOpenRecovers(t *testing.T) {
+	cb := newTestBreaker(resilience.Policy{MaxConsecutiveFailures: 1, Timeout: 10 * time.Millisecond})
+
+	for i := 0; i < 2; i++ {
+		_ = cb.Do(context.Background(), func() error { return errDependency })
+	}
+	require.Equal(t, resilience.StateOpen, cb.Snapshot().State)
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, resilience.StateHalfOpen, cb.Snapshot().State)
+	require.NoError(t, cb.Do(context.Background(), func() error { return nil }))
+	assert.Equal(t, resilience.StateClosed, cb.Snapshot().State)
+}
+
+func TestRegistry_SetMode(t *testing.T) {
+	breakers := resilience.NewRegistry(&config.Config{CircuitBreakerTimeout: time.Minute})
+	slack := breakers.Get(resilience.BreakerSlack)
+	postgres := breakers.Get(resilience.BreakerPostgres)
+
+	snapshot, err := breakers.SetMode(resilience.BreakerSlack, resilience.ModeForcedOpen)
+	require.NoError(t, err)
+	assert.Equal(t, resilience.StateOpen, snapshot.State)
+	assert.ErrorIs(t, slack.Do(context.Background(), func() error { return nil }), resilience.ErrOpen)
+	assert.NoError(t, postgres.Do(context.Background(), func() error { return nil }), "other dependencies are unaffected")
+
+	_, err = breakers.SetMode(resilience.BreakerSlack, resilience.ModeForcedClosed)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		_ = slack.Do(context.Background(), func() error { return errDependency })
+	}
+	assert.Equal(t, resilience.StateClosed, slack.Snapshot().State, "forced closed never trips")
+
+	_, err = breakers.SetMode(resilience.BreakerSlack, resilience.ModeAuto)
+	require.NoError(t, err)
+	assert.Equal(t, resilience.ModeAuto, slack.Snapshot().Mode)
+
+	_, err = breakers.SetMode("missing", resilience.ModeForcedOpen)
+	assert.ErrorIs(t, err, resilience.ErrUnknownBreaker)
+
+	names := []string{}
+	for _, s := range breakers.Snapshots() {
+		names = append(names, s.Name)
+	}
+	assert.Equal(t, []string{resilience.BreakerPostgres, resilience.BreakerSlack}, names)
+}
+
+func TestRegistry_EvictsLeastRecentlyUsedWorkspaces(t *testing.T) {
+	breakers := resilience.NewRegistry(&config.Config{CircuitBreakerTimeout: time.Minute, CircuitBreakerMaxWorkspaces: 2})
+	names := func() []string {
+		var names []string
+		for _, s := range breakers.Snapshots() {
+			names = append(names, s.Name)
+		}
+		return names
+	}
+
+	breakers.Get(resilience.BreakerSlack)
+	breakers.Get(resilience.SlackWorkspace("T1"))
+	breakers.Get(resilience.SlackWorkspace("T2"))
+	breakers.Get(resilience.SlackWorkspace("T1"))
+	breakers.Get(resilience.SlackWorkspace("T3"))
+	assert.Equal(t, []string{"slack", "slack:T1", "slack:T3"}, names(), "T2 was used least recently; shared breakers are never dropped")
+
+	_, err := breakers.SetMode(resilience.SlackWorkspace("T1"), resilience.ModeForcedOpen)
+	require.NoError(t, err)
+	breakers.Get(resilience.SlackWorkspace("T3"))
+	breakers.Get(resilience.SlackWorkspace("T4"))
+	assert.Equal(t, []string{"slack", "slack:T1", "slack:T4"}, names(), "a breaker forced by an operator is kept")
+}
+
+func TestService_BreakersArePerDependency(t *testing.T) {
+	repo := new(mocks2.MockConnectorRepository)
+	sm := new(mocks2.MockSecretsManager)
+	sc := new(mocks2.MockSlackClient)
+	breakers := resilience.NewRegistry(&config.Config{CircuitBreakerTimeout: time.Minute},
+		resilience.WithClientErrors(connector.IsClientError))
+	service := connector.NewService(repo, sm, sc, breakers, connector.WithSlackBreakerPerWorkspace(true))
+
+	repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").Return(nil, sql.ErrNoRows)
+	sc.On("ResolveChannelID", mock.Anything, "valid-token-12345", "general").Return("C123", nil)
+	sm.On("StoreToken", mock.Anything, mock.Anything, "valid-token-12345").Return(nil)
+	sm.On("DeleteToken", mock.Anything, mock.Anything).Return(nil)
+	repo.On("Create", mock.Anything, mock.Anything).Return(errDependency)
+
+	input := connector.CreateInput{
+		WorkspaceID:    "workspace123",
+		TenantID:       "tenant123",
+		Token:          "valid-token-12345",
+		DefaultChannel: "general",
+	}
+	for i := 0; i < 6; i++ {
+		_, err := service.CreateConnector(context.Background(), input)
+		require.ErrorIs(t, err, errDependency)
+	}
+	_, err := service.CreateConnector(context.Background(), input)
+	assert.ErrorIs(t, err, resilience.ErrOpen)
+
+	states := map[string]resilience.State{}
+	for _, snapshot := range breakers.Snapshots() {
+		states[snapshot.Name] = snapshot.State
+	}
+	assert.Equal(t, map[string]resilience.State{
+		resilience.BreakerPostgres:                resilience.StateOpen,
+		resilience.BreakerSecretsManager:          resilience.StateClosed,
+		resilience.SlackWorkspace("workspace123"): resilience.StateClosed,
+	}, states)
+}
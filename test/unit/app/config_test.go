@@ -0,0 +1,130 @@
+package app_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeConfigFile points CONFIG_FILE at a temporary file holding body.
+func writeConfigFile(t *testing.T, body string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o600))
+	t.Setenv("CONFIG_FILE", path)
+}
+
+func setRequiredSlackEnv(t *testing.T) {
+	t.Setenv("SLACK_CLIENT_ID", "client")
+	t.Setenv("SLACK_CLIENT_SECRET", "secret")
+	t.Setenv("SLACK_REDIRECT_URL", "https://example.com/callback")
+}
+
+func TestLoadConfig_FileWithEnvOverrides(t *testing.T) {
+	setRequiredSlackEnv(t)
+	writeConfigFile(t, `
+db:
+  max_open_conns: 40
+  conn_max_lifetime_minutes: 2
+redis:
+  db: 3
+slack:
+  base_url: http://slack.test/api
+  rate_limit_burst: 7
+log:
+  level: debug
+otel:
+  resource_attributes: service.namespace=connectors
+`)
+	t.Setenv("DB_MAX_OPEN_CONNS", "60")
+
+	cfg, err := config.LoadConfig()
+	require.NoError(t, err)
+
+	assert.Equal(t, 60, cfg.DBMaxOpenConns, "the environment wins over the file")
+	assert.Equal(t, 2*time.Minute, cfg.DBConnMaxLifetime)
+	assert.Equal(t, 3, cfg.RedisDB)
+	assert.Equal(t, "http://slack.test/api", cfg.SlackBaseURL)
+	assert.Equal(t, 7, cfg.SlackRateLimitBurst)
+	assert.Equal(t, "debug", cfg.Logging.Level)
+	assert.Equal(t, "connectors", cfg.Telemetry.ResourceAttributes["service.namespace"])
+	assert.Equal(t, 5, cfg.CircuitBreakerMaxFailures)
+}
+
+func TestLoadConfig_ReportsEveryError(t *testing.T) {
+	setRequiredSlackEnv(t)
+	writeConfigFile(t, `
+slack:
+  retry_max: lots
+  rate_limt_rps: 2
+grpc_port: "70000"
+`)
+	t.Setenv("LOG_FORMAT", "xml")
+	t.Setenv("DB_MAX_OPEN_CONNS", "0")
+	t.Setenv("SLACK_RATE_LIMIT_BACKEND", "memcached")
+
+	_, err := config.LoadConfig()
+	require.Error(t, err)
+
+	for _, want := range []string{
+		`SLACK_RETRY_MAX: "lots" is not an integer`,
+		"unknown setting SLACK_RATE_LIMT_RPS",
+		`GRPC_PORT: "70000" is not a valid port`,
+		`unsupported LOG_FORMAT "xml"`,
+		"DB_MAX_OPEN_CONNS must be at least 1",
+		`unsupported SLACK_RATE_LIMIT_BACKEND "memcached"`,
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
+
+func TestLoadConfig_Durations(t *testing.T) {
+	tests := []struct {
+		key     string
+		value   string
+		wantErr string
+	}{
+		{key: "WEBHOOK_DELIVERY_INTERVAL_MILLISECONDS", value: "0", wantErr: "WEBHOOK_DELIVERY_INTERVAL_MILLISECONDS must be positive"},
+		{key: "WATCH_POLL_INTERVAL_MILLISECONDS", value: "-5", wantErr: "WATCH_POLL_INTERVAL_MILLISECONDS must be positive"},
+		{key: "WATCH_HEARTBEAT_SECONDS", value: "0", wantErr: "WATCH_HEARTBEAT_SECONDS must be positive"},
+		{key: "OUTBOX_RELAY_INTERVAL_MILLISECONDS", value: "0", wantErr: "OUTBOX_RELAY_INTERVAL_MILLISECONDS must be positive"},
+		{key: "CIRCUIT_BREAKER_INTERVAL_SECONDS", value: "-1", wantErr: "CIRCUIT_BREAKER_INTERVAL_SECONDS must not be negative"},
+		{key: "CIRCUIT_BREAKER_INTERVAL_SECONDS", value: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			setRequiredSlackEnv(t)
+			t.Setenv(tt.key, tt.value)
+
+			_, err := config.LoadConfig()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestConfig_RestartRequired(t *testing.T) {
+	setRequiredSlackEnv(t)
+	current, err := config.LoadConfig()
+	require.NoError(t, err)
+
+	t.Setenv("LOG_LEVEL", "warn")
+	t.Setenv("RPC_RATE_LIMIT_RPS", "25")
+	t.Setenv("REDIS_DB", "2")
+	next, err := config.LoadConfig()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"RedisDB"}, current.RestartRequired(next))
+	assert.Equal(t, "warn", next.Reloadable().LogLevel)
+	assert.Equal(t, 25.0, next.Reloadable().RPCRateLimitRPS)
+}
@@ -0,0 +1,106 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDriftReconciler_Run(t *testing.T) {
+	naming := domain.NewSecretNaming("connector", "test")
+	old := time.Now().Add(-24 * time.Hour)
+
+	healthy := domain.Connector{ID: uuid.New(), Status: domain.ConnectorStatusActive}
+	healthy.SecretName = naming.Name(healthy.ID)
+	broken := domain.Connector{ID: uuid.New(), Status: domain.ConnectorStatusActive}
+	broken.SecretName = naming.Name(broken.ID)
+	recovered := domain.Connector{ID: uuid.New(), Status: domain.ConnectorStatusErrored, SecretName: domain.LegacySecretName("ws", "t")}
+	orphan := naming.Name(uuid.New())
+	fresh := naming.Name(uuid.New())
+	// Another environment's connector, under the shared legacy prefix.
+	otherEnvironment := domain.LegacySecretName("other-ws", "other-tenant")
+
+	setup := func() (*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager) {
+		repo := new(mocks2.MockConnectorRepository)
+		sm := new(mocks2.MockSecretsManager)
+		sm.On("ListSecrets", mock.Anything, naming.ListPrefix()).Return([]domain.SecretSummary{
+			{Name: healthy.SecretName, CreatedAt: old},
+			{Name: orphan, CreatedAt: old},
+			{Name: fresh, CreatedAt: time.Now()},
+		}, nil)
+		sm.On("ListSecrets", mock.Anything, domain.LegacySecretPrefix).Return([]domain.SecretSummary{
+			{Name: recovered.SecretName, CreatedAt: old},
+			{Name: otherEnvironment, CreatedAt: old},
+		}, nil)
+		repo.On("ListConnectors", mock.Anything, mock.Anything, mock.Anything).
+			Return([]domain.Connector{healthy, broken, recovered}, (*domain.ListCursor)(nil), nil)
+		return repo, sm
+	}
+
+	t.Run("reports drift without repairing", func(t *testing.T) {
+		repo, sm := setup()
+
+		report, err := connector.NewDriftReconciler(repo, sm, naming, time.Hour).Run(context.Background(), false)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, report.ScannedSecrets)
+		assert.Equal(t, 3, report.ScannedConnectors)
+		assert.Equal(t, []string{orphan}, report.OrphanedSecrets)
+		assert.Equal(t, []string{otherEnvironment}, report.UnmanagedSecrets)
+		assert.Equal(t, []connector.MissingSecret{{ConnectorID: broken.ID.String(), SecretName: broken.SecretName}}, report.MissingSecrets)
+		assert.Equal(t, []string{recovered.ID.String()}, report.Recovered)
+		assert.True(t, report.HasDrift())
+
+		sm.AssertNotCalled(t, "DeleteToken", mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repairs drift", func(t *testing.T) {
+		repo, sm := setup()
+		repo.On("ReferencesSecret", mock.Anything, orphan).Return(false, nil)
+		sm.On("DeleteToken", mock.Anything, orphan).Return(nil)
+		repo.On("UpdateStatus", mock.Anything, broken.ID, domain.ConnectorStatusErrored).Return(nil)
+		repo.On("UpdateStatus", mock.Anything, recovered.ID, domain.ConnectorStatusActive).Return(errors.New("db error"))
+
+		report, err := connector.NewDriftReconciler(repo, sm, naming, time.Hour).Run(context.Background(), true)
+		assert.NoError(t, err)
+		assert.True(t, report.Repaired)
+		assert.Len(t, report.RepairErrors, 1)
+		assert.Equal(t, []string{otherEnvironment}, report.UnmanagedSecrets)
+
+		sm.AssertExpectations(t)
+		sm.AssertNotCalled(t, "DeleteToken", mock.Anything, otherEnvironment)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("keeps a secret referenced since the scan", func(t *testing.T) {
+		repo, sm := setup()
+		// Restored, or imported, between the listing and the check.
+		repo.On("ReferencesSecret", mock.Anything, orphan).Return(true, nil)
+		repo.On("UpdateStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		report, err := connector.NewDriftReconciler(repo, sm, naming, time.Hour).Run(context.Background(), true)
+		assert.NoError(t, err)
+		assert.Empty(t, report.OrphanedSecrets)
+		assert.Empty(t, report.RepairErrors)
+		sm.AssertNotCalled(t, "DeleteToken", mock.Anything, mock.Anything)
+	})
+
+	t.Run("listing failure aborts the run", func(t *testing.T) {
+		repo := new(mocks2.MockConnectorRepository)
+		sm := new(mocks2.MockSecretsManager)
+		sm.On("ListSecrets", mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+
+		_, err := connector.NewDriftReconciler(repo, sm, naming, time.Hour).Run(context.Background(), true)
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "ListConnectors", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
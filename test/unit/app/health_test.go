@@ -0,0 +1,80 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func probeResult(err *error) func(context.Context) error {
+	return func(context.Context) error { return *err }
+}
+
+func TestMonitor_Readiness(t *testing.T) {
+	var postgresErr, slackErr error
+	monitor := health.NewMonitor([]health.Check{
+		{Name: health.CheckPostgres, Critical: true, Probe: probeResult(&postgresErr)},
+		{Name: health.CheckSlack, Critical: false, Probe: probeResult(&slackErr)},
+	}, time.Minute)
+
+	report := monitor.Report()
+	assert.Equal(t, health.StatusNotReady, report.Status, "not ready before the first probe")
+	assert.Equal(t, health.CheckUnknown, report.Checks[health.CheckPostgres].Status)
+
+	slackErr = errors.New("slack unreachable")
+	monitor.RunOnce(context.Background())
+	report = monitor.Report()
+	assert.True(t, report.Ready(), "a failing non-critical check does not affect readiness")
+	assert.Equal(t, health.CheckDown, report.Checks[health.CheckSlack].Status)
+	assert.Equal(t, "slack unreachable", report.Checks[health.CheckSlack].Error)
+	assert.True(t, report.Up(health.CheckPostgres))
+
+	postgresErr = errors.New("connection refused")
+	monitor.RunOnce(context.Background())
+	report = monitor.Report()
+	assert.Equal(t, health.StatusNotReady, report.Status)
+	assert.False(t, report.Up(health.CheckPostgres))
+
+	postgresErr = nil
+	monitor.RunOnce(context.Background())
+	assert.True(t, monitor.Report().Ready())
+}
+
+func TestMonitor_ProbeTimeout(t *testing.T) {
+	monitor := health.NewMonitor([]health.Check{
+		{Name: health.CheckRedis, Critical: true, Probe: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	}, time.Minute, health.WithTimeout(10*time.Millisecond))
+
+	monitor.RunOnce(context.Background())
+	result := monitor.Report().Checks[health.CheckRedis]
+	assert.Equal(t, health.CheckDown, result.Status)
+	assert.Contains(t, result.Error, "deadline exceeded")
+}
+
+func TestMonitor_SubscribeAndDrain(t *testing.T) {
+	monitor := health.NewMonitor([]health.Check{
+		{Name: health.CheckPostgres, Critical: true, Probe: func(context.Context) error { return nil }},
+	}, time.Minute)
+
+	var statuses []string
+	monitor.Subscribe(func(report health.Report) {
+		statuses = append(statuses, report.Status)
+	})
+	monitor.RunOnce(context.Background())
+	monitor.Drain()
+	monitor.RunOnce(context.Background())
+
+	require.Len(t, statuses, 4)
+	assert.Equal(t, []string{
+		health.StatusNotReady, health.StatusReady, health.StatusDraining, health.StatusDraining,
+	}, statuses)
+	assert.False(t, monitor.Report().Ready(), "draining is permanent")
+}
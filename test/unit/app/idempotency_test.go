@@ -0,0 +1,151 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/pkg/resilience"
+
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupIdempotencyTest() (*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager, *mocks2.MockSlackClient, *mocks2.MockIdempotencyStore, *connector.Service) {
+	repo := new(mocks2.MockConnectorRepository)
+	sm := new(mocks2.MockSecretsManager)
+	sc := new(mocks2.MockSlackClient)
+	store := new(mocks2.MockIdempotencyStore)
+	testConfig := &config.Config{
+		CircuitBreakerInterval: 60 * time.Second,
+		CircuitBreakerTimeout:  30 * time.Second,
+	}
+	breakers := resilience.NewRegistry(testConfig)
+	service := connector.NewService(repo, sm, sc, breakers, connector.WithIdempotencyStore(store))
+	return repo, sm, sc, store, service
+}
+
+func TestService_CreateConnector_Idempotency(t *testing.T) {
+	input := connector.CreateInput{
+		WorkspaceID:    "workspace123",
+		TenantID:       "tenant123",
+		Token:          "valid-token-12345",
+		DefaultChannel: "general",
+		IdempotencyKey: "key-1",
+	}
+
+	t.Run("first request records the key", func(t *testing.T) {
+		repo, sm, sc, store, service := setupIdempotencyTest()
+		store.On("Get", mock.Anything, "key-1").Return(nil, sql.ErrNoRows)
+		repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").Return(nil, sql.ErrNoRows)
+		sc.On("ResolveChannelID", mock.Anything, "valid-token-12345", "general").Return("C123", nil)
+		sm.On("StoreToken", mock.Anything, mock.MatchedBy(isConnectorSecretName), "valid-token-12345").Return(nil)
+		repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+		var recorded *domain.IdempotencyRecord
+		store.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			recorded = args.Get(1).(*domain.IdempotencyRecord)
+		}).Return(nil)
+
+		conn, err := service.CreateConnector(context.Background(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, "key-1", recorded.Key)
+		assert.Equal(t, conn.ID, recorded.ConnectorID)
+		assert.NotEmpty(t, recorded.RequestHash)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("retry returns the original connector", func(t *testing.T) {
+		repo, sm, sc, store, service := setupIdempotencyTest()
+
+		// Record the hash of the first request, then replay it.
+		store.On("Get", mock.Anything, "key-1").Return(nil, sql.ErrNoRows).Once()
+		repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").Return(nil, sql.ErrNoRows).Once()
+		sc.On("ResolveChannelID", mock.Anything, mock.Anything, mock.Anything).Return("C123", nil)
+		sm.On("StoreToken", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+		var recorded *domain.IdempotencyRecord
+		store.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			recorded = args.Get(1).(*domain.IdempotencyRecord)
+		}).Return(nil)
+		original, err := service.CreateConnector(context.Background(), input)
+		assert.NoError(t, err)
+
+		store.On("Get", mock.Anything, "key-1").Return(recorded, nil).Once()
+		repo.On("GetByID", mock.Anything, original.ID).Return(original, nil)
+
+		replayed, err := service.CreateConnector(context.Background(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, original.ID, replayed.ID)
+		repo.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("key reused with a different request", func(t *testing.T) {
+		_, _, _, store, service := setupIdempotencyTest()
+		store.On("Get", mock.Anything, "key-1").Return(&domain.IdempotencyRecord{
+			Key:         "key-1",
+			RequestHash: "other",
+			ConnectorID: uuid.New(),
+		}, nil)
+
+		_, err := service.CreateConnector(context.Background(), input)
+		assert.ErrorIs(t, err, connector.ErrInvalidInput)
+	})
+}
+
+func TestService_CreateConnector_AlreadyExists(t *testing.T) {
+	existingID := uuid.New()
+	input := connector.CreateInput{
+		WorkspaceID:    "workspace123",
+		TenantID:       "tenant123",
+		Token:          "valid-token-12345",
+		DefaultChannel: "general",
+	}
+
+	t.Run("existing connector", func(t *testing.T) {
+		repo, _, sc, _, service := setupIdempotencyTest()
+		repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").
+			Return(&domain.Connector{ID: existingID}, nil)
+
+		_, err := service.CreateConnector(context.Background(), input)
+		assert.ErrorIs(t, err, connector.ErrAlreadyExists)
+		sc.AssertNotCalled(t, "ResolveChannelID", mock.Anything, mock.Anything, mock.Anything)
+
+		st, _ := status.FromError(connector.GRPCError(err))
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Len(t, st.Details(), 1)
+		info := st.Details()[0].(*errdetails.ResourceInfo)
+		assert.Equal(t, existingID.String(), info.ResourceName)
+	})
+
+	t.Run("concurrent create loses the race", func(t *testing.T) {
+		repo, sm, sc, _, service := setupIdempotencyTest()
+		repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").
+			Return(nil, sql.ErrNoRows).Once()
+		sc.On("ResolveChannelID", mock.Anything, mock.Anything, mock.Anything).Return("C123", nil)
+		sm.On("StoreToken", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		repo.On("Create", mock.Anything, mock.Anything).
+			Return(fmt.Errorf("uq_connectors_workspace_tenant: %w", domain.ErrConflict))
+		repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").
+			Return(&domain.Connector{ID: existingID}, nil).Once()
+		// The losing request's secret has its own name and is safe to remove.
+		sm.On("DeleteToken", mock.Anything, mock.MatchedBy(isConnectorSecretName)).Return(nil)
+
+		_, err := service.CreateConnector(context.Background(), input)
+		var existsErr *connector.ConnectorExistsError
+		assert.ErrorAs(t, err, &existsErr)
+		assert.Equal(t, existingID, existsErr.ConnectorID)
+		sm.AssertExpectations(t)
+	})
+}
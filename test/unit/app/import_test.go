@@ -0,0 +1,139 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func importInput(workspaceID string) connector.CreateInput {
+	return connector.CreateInput{
+		WorkspaceID:    workspaceID,
+		TenantID:       "tenant123",
+		Token:          "token-" + workspaceID,
+		DefaultChannel: "general",
+	}
+}
+
+// itemSource returns a next function handing out items, then io.EOF.
+func itemSource(items ...connector.ImportItem) func() (connector.ImportItem, error) {
+	var i int
+	return func() (connector.ImportItem, error) {
+		if i == len(items) {
+			return connector.ImportItem{}, io.EOF
+		}
+		i++
+		return items[i-1], nil
+	}
+}
+
+func TestImporter_Import(t *testing.T) {
+	repo, sm, sc, _, service := setupServiceTest()
+	existing := &domain.Connector{ID: uuid.New(), WorkspaceID: "workspace-existing", TenantID: "tenant123"}
+
+	repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace-new", "tenant123").Return(nil, sql.ErrNoRows)
+	repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace-existing", "tenant123").Return(existing, nil)
+	repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace-broken", "tenant123").Return(nil, sql.ErrNoRows)
+	sc.On("ResolveChannelID", mock.Anything, "token-workspace-new", "general").Return("C123", nil)
+	sc.On("ResolveChannelID", mock.Anything, "token-workspace-broken", "general").Return("", errors.New("channel_not_found"))
+	sm.On("StoreToken", mock.Anything, mock.Anything, "token-workspace-new").Return(nil)
+	repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	report, err := connector.NewImporter(service).Import(context.Background(), itemSource(
+		connector.ImportItem{Input: importInput("workspace-new")},
+		connector.ImportItem{Input: importInput("workspace-invalid"), Invalid: errors.New("bad token")},
+		connector.ImportItem{Input: importInput("workspace-new")},
+		connector.ImportItem{Input: importInput("workspace-existing")},
+		connector.ImportItem{Input: importInput("workspace-broken")},
+	))
+	require.NoError(t, err)
+	require.Len(t, report.Results, 5)
+
+	statuses := make([]connector.ImportStatus, 0, len(report.Results))
+	for i, result := range report.Results {
+		assert.Equal(t, i, result.Index)
+		statuses = append(statuses, result.Status)
+	}
+	assert.Equal(t, []connector.ImportStatus{
+		connector.ImportStatusCreated,
+		connector.ImportStatusInvalid,
+		connector.ImportStatusDuplicate,
+		connector.ImportStatusAlreadyExists,
+		connector.ImportStatusFailed,
+	}, statuses)
+	assert.NotEqual(t, uuid.Nil, report.Results[0].ConnectorID)
+	assert.EqualError(t, report.Results[2].Err, "duplicate of item 0")
+	assert.Equal(t, existing.ID, report.Results[3].ConnectorID)
+	assert.Error(t, report.Results[4].Err)
+
+	assert.Equal(t, connector.ImportSummary{
+		Total: 5, Created: 1, AlreadyExists: 1, Duplicates: 1, Invalid: 1, Failed: 1,
+	}, report.Summary)
+	repo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestImporter_BoundsConcurrency(t *testing.T) {
+	repo, sm, sc, _, service := setupServiceTest()
+	var active, peak int32
+	repo.On("GetByWorkspaceAndTenant", mock.Anything, mock.Anything, "tenant123").Return(nil, sql.ErrNoRows)
+	sc.On("ResolveChannelID", mock.Anything, mock.Anything, "general").Return("C123", nil).Run(func(mock.Arguments) {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+	})
+	sm.On("StoreToken", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	items := make([]connector.ImportItem, 0, 8)
+	for i := 0; i < 8; i++ {
+		items = append(items, connector.ImportItem{Input: importInput(fmt.Sprintf("workspace%d", i))})
+	}
+	report, err := connector.NewImporter(service, connector.WithImportConcurrency(2)).
+		Import(context.Background(), itemSource(items...))
+	require.NoError(t, err)
+	assert.Equal(t, 8, report.Summary.Created)
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+}
+
+func TestImporter_StopsImport(t *testing.T) {
+	_, _, _, _, service := setupServiceTest()
+	invalid := connector.ImportItem{Input: importInput("workspace1"), Invalid: errors.New("bad")}
+
+	t.Run("too many items", func(t *testing.T) {
+		report, err := connector.NewImporter(service, connector.WithImportMaxItems(2)).
+			Import(context.Background(), itemSource(invalid, invalid, invalid, invalid))
+		require.NoError(t, err)
+		assert.True(t, report.Summary.Truncated)
+		assert.Equal(t, 3, report.Summary.Total)
+		require.Len(t, report.Results, 3)
+		assert.Equal(t, connector.ImportStatusInvalid, report.Results[2].Status)
+		assert.ErrorIs(t, report.Results[2].Err, connector.ErrInvalidInput)
+	})
+
+	t.Run("receive error", func(t *testing.T) {
+		streamErr := errors.New("stream reset")
+		report, err := connector.NewImporter(service).Import(context.Background(), func() (connector.ImportItem, error) {
+			return connector.ImportItem{}, streamErr
+		})
+		assert.ErrorIs(t, err, streamErr)
+		assert.Empty(t, report.Results)
+	})
+}
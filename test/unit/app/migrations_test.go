@@ -0,0 +1,46 @@
+package app_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	pg "github.com/connector-recruitment/internal/infrastructure/postgres"
+	"github.com/connector-recruitment/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations_Embedded(t *testing.T) {
+	loaded, err := pg.LoadMigrations(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded)
+
+	for i, m := range loaded {
+		assert.Equal(t, i+1, m.Version, "versions are contiguous")
+		assert.NotEmpty(t, m.Up, "%03d_%s up", m.Version, m.Name)
+		assert.NotEmpty(t, m.Down, "%03d_%s down", m.Version, m.Name)
+	}
+	assert.Equal(t, "init", loaded[0].Name)
+}
+
+func TestLoadMigrations_Invalid(t *testing.T) {
+	for name, fsys := range map[string]fstest.MapFS{
+		"missing down": {
+			"001_init.up.sql": {Data: []byte("SELECT 1;")},
+		},
+		"bad name": {
+			"init.sql": {Data: []byte("SELECT 1;")},
+		},
+		"duplicate version": {
+			"001_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"001_a.down.sql": {Data: []byte("SELECT 1;")},
+			"001_b.up.sql":   {Data: []byte("SELECT 1;")},
+			"001_b.down.sql": {Data: []byte("SELECT 1;")},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := pg.LoadMigrations(fsys)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/pkg/resilience"
+
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupOperationTest() (*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager, *mocks2.MockSlackClient, *mocks2.MockOperationLog, *connector.Service) {
+	repo := new(mocks2.MockConnectorRepository)
+	sm := new(mocks2.MockSecretsManager)
+	sc := new(mocks2.MockSlackClient)
+	ops := new(mocks2.MockOperationLog)
+	testConfig := &config.Config{
+		CircuitBreakerInterval: 60 * time.Second,
+		CircuitBreakerTimeout:  30 * time.Second,
+	}
+	breakers := resilience.NewRegistry(testConfig)
+	service := connector.NewService(repo, sm, sc, breakers, connector.WithOperationLog(ops))
+	return repo, sm, sc, ops, service
+}
+
+func TestService_CreateConnector_OperationLog(t *testing.T) {
+	input := connector.CreateInput{
+		WorkspaceID:    "workspace123",
+		TenantID:       "tenant123",
+		Token:          "valid-token-12345",
+		DefaultChannel: "general",
+	}
+
+	tests := []struct {
+		name           string
+		createErr      error
+		deleteTokenErr error
+		expectedStates []domain.OperationState
+	}{
+		{
+			name:           "successful creation completes the operation",
+			expectedStates: []domain.OperationState{domain.OperationStateSecretStored, domain.OperationStateCompleted},
+		},
+		{
+			name:      "database failure compensates the secret",
+			createErr: errors.New("db error"),
+			expectedStates: []domain.OperationState{
+				domain.OperationStateSecretStored,
+				domain.OperationStateCompensating,
+				domain.OperationStateCompensated,
+			},
+		},
+		{
+			name:           "failed compensation is left for the reconciler",
+			createErr:      errors.New("db error"),
+			deleteTokenErr: errors.New("deletion error"),
+			expectedStates: []domain.OperationState{domain.OperationStateSecretStored, domain.OperationStateCompensating},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, sm, sc, ops, service := setupOperationTest()
+			repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").Return(nil, sql.ErrNoRows)
+			sc.On("ResolveChannelID", mock.Anything, mock.Anything, mock.Anything).Return("C123", nil)
+			sm.On("StoreToken", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			repo.On("Create", mock.Anything, mock.Anything).Return(tt.createErr)
+			if tt.createErr != nil {
+				sm.On("DeleteToken", mock.Anything, mock.MatchedBy(isConnectorSecretName)).Return(tt.deleteTokenErr)
+			}
+			if tt.deleteTokenErr != nil {
+				ops.On("RecordFailure", mock.Anything, mock.Anything, tt.deleteTokenErr.Error()).Return(nil)
+			}
+
+			ops.On("Begin", mock.Anything, mock.MatchedBy(func(op *domain.ConnectorOperation) bool {
+				return op.Kind == domain.OperationKindCreate &&
+					op.State == domain.OperationStateStarted &&
+					op.Connector != nil && op.Connector.ID == op.ConnectorID
+			})).Return(nil)
+			var states []domain.OperationState
+			ops.On("Advance", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				states = append(states, args.Get(2).(domain.OperationState))
+			}).Return(nil)
+
+			_, err := service.CreateConnector(context.Background(), input)
+			assert.Equal(t, tt.createErr != nil, err != nil)
+			assert.Equal(t, tt.expectedStates, states)
+			ops.AssertExpectations(t)
+			sm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_DeleteConnector_OperationLog(t *testing.T) {
+	conn := &domain.Connector{
+		ID:          uuid.New(),
+		WorkspaceID: "workspace123",
+		TenantID:    "tenant123",
+		SecretName:  "connector/development/connectors/1",
+	}
+
+	repo, sm, _, ops, service := setupOperationTest()
+	repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil)
+	repo.On("Delete", mock.Anything, conn.ID).Return(nil)
+	sm.On("DeleteToken", mock.Anything, conn.SecretName).Return(errors.New("deletion error"))
+	ops.On("Begin", mock.Anything, mock.MatchedBy(func(op *domain.ConnectorOperation) bool {
+		return op.Kind == domain.OperationKindDelete && op.SecretName == conn.SecretName
+	})).Return(nil)
+	ops.On("Advance", mock.Anything, mock.Anything, domain.OperationStateRowDeleted).Return(nil)
+	ops.On("RecordFailure", mock.Anything, mock.Anything, "deletion error").Return(nil)
+
+	// The row is gone, so the delete succeeds and the reconciler retries the secret.
+	err := service.DeleteConnector(context.Background(), conn.ID, "workspace123", "tenant123")
+	assert.NoError(t, err)
+	ops.AssertExpectations(t)
+	ops.AssertNotCalled(t, "Advance", mock.Anything, mock.Anything, domain.OperationStateCompleted)
+}
+
+func TestOperationReconciler_RunOnce(t *testing.T) {
+	conn := &domain.Connector{ID: uuid.New(), WorkspaceID: "workspace123", TenantID: "tenant123", SecretName: "secret"}
+
+	tests := []struct {
+		name          string
+		op            domain.ConnectorOperation
+		setupMocks    func(*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager)
+		expectedFinal domain.OperationState
+	}{
+		{
+			name: "create with stored secret is rolled forward",
+			op:   domain.ConnectorOperation{Kind: domain.OperationKindCreate, State: domain.OperationStateSecretStored, Connector: conn},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, conn.ID).Return(nil, sql.ErrNoRows)
+				repo.On("Create", mock.Anything, conn).Return(nil)
+			},
+			expectedFinal: domain.OperationStateCompleted,
+		},
+		{
+			name: "create whose row already exists is completed",
+			op:   domain.ConnectorOperation{Kind: domain.OperationKindCreate, State: domain.OperationStateSecretStored, Connector: conn},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil)
+			},
+			expectedFinal: domain.OperationStateCompleted,
+		},
+		{
+			name: "create that lost its slot is rolled back",
+			op:   domain.ConnectorOperation{Kind: domain.OperationKindCreate, State: domain.OperationStateSecretStored, Connector: conn},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, conn.ID).Return(nil, sql.ErrNoRows)
+				repo.On("Create", mock.Anything, conn).Return(domain.ErrConflict)
+				sm.On("DeleteToken", mock.Anything, "secret").Return(nil)
+			},
+			expectedFinal: domain.OperationStateCompensated,
+		},
+		{
+			name: "create interrupted before the secret was recorded is rolled back",
+			op:   domain.ConnectorOperation{Kind: domain.OperationKindCreate, State: domain.OperationStateStarted, Connector: conn},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, conn.ID).Return(nil, sql.ErrNoRows)
+				sm.On("DeleteToken", mock.Anything, "secret").Return(nil)
+			},
+			expectedFinal: domain.OperationStateCompensated,
+		},
+		{
+			name: "create stuck in started whose row exists is completed, not rolled back",
+			op:   domain.ConnectorOperation{Kind: domain.OperationKindCreate, State: domain.OperationStateStarted, Connector: conn},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil)
+			},
+			expectedFinal: domain.OperationStateCompleted,
+		},
+		{
+			name: "interrupted delete is resumed",
+			op:   domain.ConnectorOperation{Kind: domain.OperationKindDelete, State: domain.OperationStateStarted},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("Delete", mock.Anything, conn.ID).Return(nil)
+				repo.On("GetDeletedByID", mock.Anything, conn.ID).Return(conn, nil)
+				sm.On("DeleteToken", mock.Anything, "secret").Return(nil)
+			},
+			expectedFinal: domain.OperationStateCompleted,
+		},
+		{
+			name: "delete of a purged connector still removes the secret",
+			op:   domain.ConnectorOperation{Kind: domain.OperationKindDelete, State: domain.OperationStateRowDeleted},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetDeletedByID", mock.Anything, conn.ID).Return(nil, sql.ErrNoRows)
+				repo.On("GetByID", mock.Anything, conn.ID).Return(nil, sql.ErrNoRows)
+				sm.On("DeleteToken", mock.Anything, "secret").Return(nil)
+			},
+			expectedFinal: domain.OperationStateCompleted,
+		},
+		{
+			name: "delete of a restored connector is cancelled, keeping its secret",
+			op:   domain.ConnectorOperation{Kind: domain.OperationKindDelete, State: domain.OperationStateRowDeleted},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetDeletedByID", mock.Anything, conn.ID).Return(nil, sql.ErrNoRows)
+				repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil)
+			},
+			expectedFinal: domain.OperationStateCancelled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mocks2.MockConnectorRepository)
+			sm := new(mocks2.MockSecretsManager)
+			ops := new(mocks2.MockOperationLog)
+			tt.setupMocks(repo, sm)
+
+			op := tt.op
+			op.ID = uuid.New()
+			op.ConnectorID = conn.ID
+			op.SecretName = "secret"
+			ops.On("ListUnfinished", mock.Anything, mock.Anything, mock.Anything).
+				Return([]domain.ConnectorOperation{op}, nil)
+			var final domain.OperationState
+			ops.On("Advance", mock.Anything, op.ID, mock.Anything).Run(func(args mock.Arguments) {
+				final = args.Get(2).(domain.OperationState)
+			}).Return(nil)
+
+			connector.NewOperationReconciler(ops, repo, sm, time.Minute, time.Minute).RunOnce(context.Background())
+
+			assert.Equal(t, tt.expectedFinal, final)
+			repo.AssertExpectations(t)
+			sm.AssertExpectations(t)
+		})
+	}
+
+	t.Run("failure is recorded and retried later", func(t *testing.T) {
+		repo := new(mocks2.MockConnectorRepository)
+		sm := new(mocks2.MockSecretsManager)
+		ops := new(mocks2.MockOperationLog)
+		op := domain.ConnectorOperation{ID: uuid.New(), Kind: domain.OperationKindDelete, State: domain.OperationStateRowDeleted, ConnectorID: conn.ID, SecretName: "secret"}
+		ops.On("ListUnfinished", mock.Anything, mock.Anything, mock.Anything).Return([]domain.ConnectorOperation{op}, nil)
+		repo.On("GetDeletedByID", mock.Anything, conn.ID).Return(conn, nil)
+		sm.On("DeleteToken", mock.Anything, "secret").Return(errors.New("throttled"))
+		ops.On("RecordFailure", mock.Anything, op.ID, mock.Anything).Return(nil)
+
+		connector.NewOperationReconciler(ops, repo, sm, time.Minute, time.Minute).RunOnce(context.Background())
+
+		ops.AssertExpectations(t)
+		ops.AssertNotCalled(t, "Advance", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// memoryOperationLog keeps operations in memory, so a test can follow one
+// through the service and the reconciler.
+type memoryOperationLog struct {
+	ops map[uuid.UUID]*domain.ConnectorOperation
+}
+
+func newMemoryOperationLog() *memoryOperationLog {
+	return &memoryOperationLog{ops: make(map[uuid.UUID]*domain.ConnectorOperation)}
+}
+
+func (l *memoryOperationLog) Begin(_ context.Context, op *domain.ConnectorOperation) error {
+	stored := *op
+	l.ops[op.ID] = &stored
+	return nil
+}
+
+func (l *memoryOperationLog) Advance(_ context.Context, id uuid.UUID, state domain.OperationState) error {
+	l.ops[id].State = state
+	return nil
+}
+
+func (l *memoryOperationLog) RecordFailure(_ context.Context, id uuid.UUID, reason string) error {
+	l.ops[id].Attempts++
+	l.ops[id].LastError = reason
+	return nil
+}
+
+func (l *memoryOperationLog) ListUnfinished(_ context.Context, _ time.Time, _ int) ([]domain.ConnectorOperation, error) {
+	var unfinished []domain.ConnectorOperation
+	for _, op := range l.ops {
+		if !op.IsFinished() {
+			unfinished = append(unfinished, *op)
+		}
+	}
+	return unfinished, nil
+}
+
+func (l *memoryOperationLog) CancelUnfinished(_ context.Context, connectorID uuid.UUID, kind domain.OperationKind) error {
+	for _, op := range l.ops {
+		if op.ConnectorID == connectorID && op.Kind == kind && !op.IsFinished() {
+			op.State = domain.OperationStateCancelled
+		}
+	}
+	return nil
+}
+
+func TestDeleteRestoreReconcile_KeepsRestoredSecret(t *testing.T) {
+	conn := &domain.Connector{
+		ID:          uuid.New(),
+		WorkspaceID: "workspace123",
+		TenantID:    "tenant123",
+		SecretName:  "connector/development/connectors/1",
+	}
+	deleted := *conn
+	deletedAt := time.Now().UTC()
+	deleted.DeletedAt = &deletedAt
+
+	repo := new(mocks2.MockConnectorRepository)
+	sm := new(mocks2.MockSecretsManager)
+	ops := newMemoryOperationLog()
+	service := connector.NewService(repo, sm, nil,
+		resilience.NewRegistry(&config.Config{CircuitBreakerTimeout: time.Second}),
+		connector.WithOperationLog(ops))
+
+	// The delete removes the row but not the secret, leaving its operation
+	// for the reconciler.
+	repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil).Once()
+	repo.On("Delete", mock.Anything, conn.ID).Return(nil).Once()
+	sm.On("DeleteToken", mock.Anything, conn.SecretName).Return(errors.New("throttled")).Once()
+	require.NoError(t, service.DeleteConnector(context.Background(), conn.ID, "workspace123", "tenant123"))
+
+	repo.On("GetDeletedByID", mock.Anything, conn.ID).Return(&deleted, nil).Once()
+	sm.On("RestoreToken", mock.Anything, conn.SecretName).Return(nil).Once()
+	repo.On("Restore", mock.Anything, conn.ID).Return(nil).Once()
+	_, err := service.RestoreConnector(context.Background(), conn.ID)
+	require.NoError(t, err)
+
+	connector.NewOperationReconciler(ops, repo, sm, time.Minute, 0).RunOnce(context.Background())
+
+	for _, op := range ops.ops {
+		assert.Equal(t, domain.OperationStateCancelled, op.State)
+	}
+	sm.AssertNumberOfCalls(t, "DeleteToken", 1)
+	repo.AssertNumberOfCalls(t, "Delete", 1)
+}
@@ -0,0 +1,117 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_RestoreConnector(t *testing.T) {
+	deletedConnector := func(deletedAgo time.Duration) *domain.Connector {
+		deletedAt := time.Now().Add(-deletedAgo)
+		return &domain.Connector{
+			ID:          uuid.New(),
+			WorkspaceID: "workspace123",
+			TenantID:    "tenant123",
+			SecretName:  "connector/development/connectors/1",
+			DeletedAt:   &deletedAt,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		conn        *domain.Connector
+		setupMocks  func(*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager, *domain.Connector)
+		expectedErr error
+	}{
+		{
+			name: "successful restore",
+			conn: deletedConnector(time.Hour),
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager, conn *domain.Connector) {
+				sm.On("RestoreToken", mock.Anything, conn.SecretName).Return(nil)
+				repo.On("Restore", mock.Anything, conn.ID).Return(nil)
+			},
+		},
+		{
+			name:        "recovery window expired",
+			conn:        deletedConnector(domain.SecretRecoveryWindow + time.Hour),
+			setupMocks:  func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager, conn *domain.Connector) {},
+			expectedErr: connector.ErrRestoreExpired,
+		},
+		{
+			name: "slot taken by a newer connector",
+			conn: deletedConnector(time.Hour),
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager, conn *domain.Connector) {
+				sm.On("RestoreToken", mock.Anything, conn.SecretName).Return(nil)
+				repo.On("Restore", mock.Anything, conn.ID).Return(domain.ErrConflict)
+				sm.On("DeleteToken", mock.Anything, conn.SecretName).Return(nil)
+				repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").
+					Return(&domain.Connector{ID: uuid.New()}, nil)
+			},
+			expectedErr: connector.ErrAlreadyExists,
+		},
+		{
+			name: "secret restore failure leaves row deleted and its secret scheduled",
+			conn: deletedConnector(time.Hour),
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager, conn *domain.Connector) {
+				sm.On("RestoreToken", mock.Anything, conn.SecretName).Return(errors.New("restore error"))
+				sm.On("DeleteToken", mock.Anything, conn.SecretName).Return(nil)
+			},
+			expectedErr: errors.New("any"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, sm, _, _, service := setupServiceTest()
+			repo.On("GetDeletedByID", mock.Anything, tt.conn.ID).Return(tt.conn, nil)
+			tt.setupMocks(repo, sm, tt.conn)
+
+			restored, err := service.RestoreConnector(context.Background(), tt.conn.ID)
+			switch {
+			case tt.expectedErr == nil:
+				assert.NoError(t, err)
+				assert.Nil(t, restored.DeletedAt)
+			case errors.Is(tt.expectedErr, connector.ErrRestoreExpired), errors.Is(tt.expectedErr, connector.ErrAlreadyExists):
+				assert.ErrorIs(t, err, tt.expectedErr)
+			default:
+				assert.Error(t, err)
+				repo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+			}
+
+			repo.AssertExpectations(t)
+			sm.AssertExpectations(t)
+		})
+	}
+
+	t.Run("unknown connector", func(t *testing.T) {
+		repo, _, _, _, service := setupServiceTest()
+		repo.On("GetDeletedByID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+
+		_, err := service.RestoreConnector(context.Background(), uuid.New())
+		assert.ErrorIs(t, err, connector.ErrNotFound)
+	})
+}
+
+func TestPurgeJob_RunOnce(t *testing.T) {
+	repo := new(mocks2.MockConnectorRepository)
+	expired := domain.Connector{ID: uuid.New()}
+	repo.On("ListDeletedBefore", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= domain.SecretRecoveryWindow
+	}), mock.Anything).Return([]domain.Connector{expired}, nil)
+	repo.On("Purge", mock.Anything, expired.ID).Return(nil)
+
+	connector.NewPurgeJob(repo, time.Minute).RunOnce(context.Background())
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,85 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSecretMigrator_Run(t *testing.T) {
+	naming := domain.NewSecretNaming("connector", "test")
+	legacy := domain.Connector{ID: uuid.New(), WorkspaceID: "ws", TenantID: "a-b", SecretName: domain.LegacySecretName("ws", "a-b")}
+	current := domain.Connector{ID: uuid.New(), WorkspaceID: "ws", TenantID: "c"}
+	current.SecretName = naming.Name(current.ID)
+	target := naming.Name(legacy.ID)
+
+	tests := []struct {
+		name       string
+		opts       []connector.SecretMigratorOption
+		setupMocks func(*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager)
+		expected   connector.SecretMigrationReport
+	}{
+		{
+			name: "copies, verifies and switches",
+			opts: []connector.SecretMigratorOption{connector.WithDeleteOldSecrets()},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				sm.On("GetToken", mock.Anything, legacy.SecretName).Return("token", nil)
+				sm.On("StoreToken", mock.Anything, target, "token").Return(nil)
+				sm.On("GetToken", mock.Anything, target).Return("token", nil)
+				repo.On("UpdateSecretName", mock.Anything, legacy.ID, target).Return(nil)
+				sm.On("DeleteToken", mock.Anything, legacy.SecretName).Return(nil)
+			},
+			expected: connector.SecretMigrationReport{Migrated: 1, Skipped: 1},
+		},
+		{
+			name: "verification mismatch keeps old name",
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				sm.On("GetToken", mock.Anything, legacy.SecretName).Return("token", nil)
+				sm.On("StoreToken", mock.Anything, target, "token").Return(nil)
+				sm.On("GetToken", mock.Anything, target).Return("stale", nil)
+			},
+			expected: connector.SecretMigrationReport{Skipped: 1, Failed: 1},
+		},
+		{
+			name: "source secret unreadable",
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				sm.On("GetToken", mock.Anything, legacy.SecretName).Return("", errors.New("not found"))
+			},
+			expected: connector.SecretMigrationReport{Skipped: 1, Failed: 1},
+		},
+		{
+			name:       "dry run touches nothing",
+			opts:       []connector.SecretMigratorOption{connector.WithDryRun()},
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {},
+			expected:   connector.SecretMigrationReport{Migrated: 1, Skipped: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mocks2.MockConnectorRepository)
+			sm := new(mocks2.MockSecretsManager)
+			repo.On("ListConnectors", mock.Anything, mock.Anything, mock.Anything).
+				Return([]domain.Connector{legacy, current}, (*domain.ListCursor)(nil), nil)
+			tt.setupMocks(repo, sm)
+
+			report, err := connector.NewSecretMigrator(repo, sm, naming, tt.opts...).Run(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, report)
+
+			repo.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			if tt.expected.Failed > 0 {
+				repo.AssertNotCalled(t, "UpdateSecretName", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
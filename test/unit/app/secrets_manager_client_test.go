@@ -0,0 +1,58 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssm "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	smInfra "github.com/connector-recruitment/internal/infrastructure/aws/secretsmanager"
+	"github.com/stretchr/testify/assert"
+)
+
+// deleteSecretAPI answers DeleteSecret with err; the other methods are not
+// used.
+type deleteSecretAPI struct {
+	smInfra.ManagerAPI
+	err error
+}
+
+func (a *deleteSecretAPI) DeleteSecret(context.Context, *awssm.DeleteSecretInput, ...func(*awssm.Options)) (*awssm.DeleteSecretOutput, error) {
+	return &awssm.DeleteSecretOutput{}, a.err
+}
+
+func TestSecretsManagerClient_DeleteTokenIsIdempotent(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{name: "deleted", err: nil},
+		{name: "already gone", err: &types.ResourceNotFoundException{Message: aws.String("Secrets Manager can't find the specified secret.")}},
+		{
+			name: "already scheduled for deletion",
+			err: &types.InvalidRequestException{Message: aws.String(
+				"You can't perform this operation on the secret because it was already scheduled for deletion.")},
+		},
+		{
+			name:    "other invalid request",
+			err:     &types.InvalidRequestException{Message: aws.String("You can't delete a secret that is replicated.")},
+			wantErr: true,
+		},
+		{name: "throttled", err: errors.New("throttling"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := smInfra.NewClientWithAPI(&deleteSecretAPI{err: tt.err})
+			err := client.DeleteToken(context.Background(), "connector-secret")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
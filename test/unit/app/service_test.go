@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,8 +31,8 @@ func setupServiceTest() (*mocks2.MockConnectorRepository, *mocks2.MockSecretsMan
 		CircuitBreakerInterval: 60 * time.Second,
 		CircuitBreakerTimeout:  30 * time.Second,
 	}
-	cb := resilience.New("github.com/connector-recruitment", testConfig)
-	service := connector.NewService(repo, sm, sc, cb, connector.WithOAuthManager(oauthManager))
+	breakers := resilience.NewRegistry(testConfig)
+	service := connector.NewService(repo, sm, sc, breakers, connector.WithOAuthManager(oauthManager))
 	return repo, sm, sc, rd, service
 }
 
@@ -51,14 +52,17 @@ func TestService_CreateConnector(t *testing.T) {
 				DefaultChannel: "general",
 			},
 			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager, sc *mocks2.MockSlackClient) {
+				repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").
+					Return(nil, sql.ErrNoRows)
 				sc.On("ResolveChannelID", mock.Anything, "valid-token-12345", "general").
 					Return("C123", nil)
-				sm.On("StoreToken", mock.Anything, "connector-workspace123-tenant123", "valid-token-12345").
+				sm.On("StoreToken", mock.Anything, mock.MatchedBy(isConnectorSecretName), "valid-token-12345").
 					Return(nil)
 				repo.On("Create", mock.Anything, mock.MatchedBy(func(c *domain.Connector) bool {
 					return c.WorkspaceID == "workspace123" &&
 						c.TenantID == "tenant123" &&
-						c.DefaultChannelID == "C123"
+						c.DefaultChannelID == "C123" &&
+						c.SecretName == "connector/development/connectors/"+c.ID.String()
 				})).Return(nil)
 			},
 			expectError: false,
@@ -82,6 +86,8 @@ func TestService_CreateConnector(t *testing.T) {
 				DefaultChannel: "general",
 			},
 			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager, sc *mocks2.MockSlackClient) {
+				repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").
+					Return(nil, sql.ErrNoRows)
 				sc.On("ResolveChannelID", mock.Anything, "valid-token-12345", "general").
 					Return("", errors.New("channel not found"))
 			},
@@ -96,9 +102,11 @@ func TestService_CreateConnector(t *testing.T) {
 				DefaultChannel: "general",
 			},
 			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager, sc *mocks2.MockSlackClient) {
+				repo.On("GetByWorkspaceAndTenant", mock.Anything, "workspace123", "tenant123").
+					Return(nil, sql.ErrNoRows)
 				sc.On("ResolveChannelID", mock.Anything, "valid-token-12345", "general").
 					Return("C123", nil)
-				sm.On("StoreToken", mock.Anything, "connector-workspace123-tenant123", "valid-token-12345").
+				sm.On("StoreToken", mock.Anything, mock.MatchedBy(isConnectorSecretName), "valid-token-12345").
 					Return(errors.New("storage error"))
 			},
 			expectError: true,
@@ -179,6 +187,13 @@ func TestService_GetConnector(t *testing.T) {
 }
 
 func TestService_DeleteConnector(t *testing.T) {
+	ownedConnector := &domain.Connector{
+		ID:          uuid.New(),
+		WorkspaceID: "workspace123",
+		TenantID:    "tenant123",
+		SecretName:  "connector/development/connectors/owned",
+	}
+
 	tests := []struct {
 		name        string
 		setupMocks  func(*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager)
@@ -187,9 +202,11 @@ func TestService_DeleteConnector(t *testing.T) {
 		{
 			name: "successful deletion",
 			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+					Return(ownedConnector, nil)
 				repo.On("Delete", mock.Anything, mock.AnythingOfType("uuid.UUID")).
 					Return(nil)
-				sm.On("DeleteToken", mock.Anything, mock.AnythingOfType("string")).
+				sm.On("DeleteToken", mock.Anything, ownedConnector.SecretName).
 					Return(nil)
 			},
 			expectError: false,
@@ -197,6 +214,8 @@ func TestService_DeleteConnector(t *testing.T) {
 		{
 			name: "repository deletion failure",
 			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+					Return(ownedConnector, nil)
 				repo.On("Delete", mock.Anything, mock.AnythingOfType("uuid.UUID")).
 					Return(sql.ErrNoRows)
 			},
@@ -205,13 +224,23 @@ func TestService_DeleteConnector(t *testing.T) {
 		{
 			name: "secret deletion failure",
 			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+					Return(ownedConnector, nil)
 				repo.On("Delete", mock.Anything, mock.AnythingOfType("uuid.UUID")).
 					Return(nil)
-				sm.On("DeleteToken", mock.Anything, mock.AnythingOfType("string")).
+				sm.On("DeleteToken", mock.Anything, ownedConnector.SecretName).
 					Return(errors.New("deletion error"))
 			},
 			expectError: true,
 		},
+		{
+			name: "connector belongs to another tenant",
+			setupMocks: func(repo *mocks2.MockConnectorRepository, sm *mocks2.MockSecretsManager) {
+				repo.On("GetByID", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+					Return(&domain.Connector{WorkspaceID: "workspace123", TenantID: "other"}, nil)
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -320,3 +349,7 @@ func TestService_ExchangeOAuthCode(t *testing.T) {
 		})
 	}
 }
+
+func isConnectorSecretName(name string) bool {
+	return strings.HasPrefix(name, "connector/development/connectors/")
+}
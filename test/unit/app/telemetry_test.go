@@ -0,0 +1,82 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/pkg/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTelemetryConfig_Defaults(t *testing.T) {
+	t.Setenv("APP_ENV", "test")
+
+	cfg, err := config.LoadTelemetryConfig("connector-recruitment")
+	require.NoError(t, err)
+
+	assert.False(t, cfg.Disabled)
+	assert.Equal(t, "connector-recruitment", cfg.ServiceName)
+	assert.Equal(t, "localhost:4317", cfg.Endpoint)
+	assert.True(t, cfg.Insecure, "the default http:// endpoint is plaintext")
+	assert.Equal(t, "parentbased_always_on", cfg.Sampler)
+	assert.Equal(t, 10*time.Second, cfg.Timeout)
+	assert.Equal(t, "test", cfg.ResourceAttributes["deployment.environment"])
+	assert.True(t, cfg.UsesOTLP())
+}
+
+func TestLoadTelemetryConfig_FromEnv(t *testing.T) {
+	t.Setenv("OTEL_SERVICE_NAME", "connector-api")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "https://collector.example.com:4317")
+	t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "authorization=Bearer%20abc, x-team=core")
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "deployment.environment=staging,service.namespace=connectors")
+	t.Setenv("OTEL_TRACES_SAMPLER", "parentbased_traceidratio")
+	t.Setenv("OTEL_TRACES_SAMPLER_ARG", "0.25")
+	t.Setenv("OTEL_METRICS_EXPORTER", "console")
+	t.Setenv("OTEL_LOGS_EXPORTER", "none")
+
+	cfg, err := config.LoadTelemetryConfig("connector-recruitment")
+	require.NoError(t, err)
+
+	assert.Equal(t, "connector-api", cfg.ServiceName)
+	assert.Equal(t, "collector.example.com:4317", cfg.Endpoint)
+	assert.False(t, cfg.Insecure)
+	assert.Equal(t, map[string]string{"authorization": "Bearer abc", "x-team": "core"}, cfg.Headers)
+	assert.Equal(t, map[string]string{"deployment.environment": "staging", "service.namespace": "connectors"}, cfg.ResourceAttributes)
+	assert.Equal(t, "parentbased_traceidratio", cfg.Sampler)
+	assert.Equal(t, 0.25, cfg.SamplerArg)
+	assert.Equal(t, config.TelemetryExporterConsole, cfg.MetricsExporter)
+	assert.Equal(t, config.TelemetryExporterNone, cfg.LogsExporter)
+}
+
+func TestLoadTelemetryConfig_Invalid(t *testing.T) {
+	for name, env := range map[string][2]string{
+		"unknown sampler":   {"OTEL_TRACES_SAMPLER", "sometimes"},
+		"ratio above one":   {"OTEL_TRACES_SAMPLER_ARG", "2"},
+		"unknown exporter":  {"OTEL_TRACES_EXPORTER", "jaeger"},
+		"unsupported proto": {"OTEL_EXPORTER_OTLP_ENDPOINT", "grpc://collector:4317"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(env[0], env[1])
+			_, err := config.LoadTelemetryConfig("connector-recruitment")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestInitTelemetry_UnreachableCollector(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://127.0.0.1:1")
+	t.Setenv("OTEL_LOGS_EXPORTER", "none")
+	cfg, err := config.LoadTelemetryConfig("connector-recruitment")
+	require.NoError(t, err)
+
+	telemetry, err := observability.InitTelemetry(context.Background(), cfg)
+	require.NoError(t, err, "the service starts without a collector")
+	assert.NoError(t, telemetry.Shutdown(context.Background()))
+
+	cfg.Required = true
+	_, err = observability.InitTelemetry(context.Background(), cfg)
+	assert.ErrorIs(t, err, observability.ErrCollectorUnreachable)
+}
@@ -0,0 +1,381 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/connector-recruitment/internal/app/config"
+	smInfra "github.com/connector-recruitment/internal/infrastructure/aws/secretsmanager"
+	"github.com/connector-recruitment/pkg/resilience"
+
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTenantService_CreateTenant(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       connector.CreateTenantInput
+		setupMocks  func(*mocks2.MockTenantRepository)
+		expectedErr error
+	}{
+		{
+			name:  "successful creation",
+			input: connector.CreateTenantInput{ID: "tenant123", Name: "Tenant"},
+			setupMocks: func(tenants *mocks2.MockTenantRepository) {
+				tenants.On("Create", mock.Anything, mock.MatchedBy(func(t *domain.Tenant) bool {
+					return t.ID == "tenant123" && t.Status == domain.TenantStatusActive
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "invalid input",
+			input:       connector.CreateTenantInput{ID: "tenant123"},
+			setupMocks:  func(tenants *mocks2.MockTenantRepository) {},
+			expectedErr: connector.ErrInvalidInput,
+		},
+		{
+			name:  "duplicate tenant",
+			input: connector.CreateTenantInput{ID: "tenant123", Name: "Tenant"},
+			setupMocks: func(tenants *mocks2.MockTenantRepository) {
+				tenants.On("Create", mock.Anything, mock.Anything).
+					Return(fmt.Errorf("tenants_pkey: %w", domain.ErrConflict))
+			},
+			expectedErr: connector.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenants := new(mocks2.MockTenantRepository)
+			tt.setupMocks(tenants)
+			service := connector.NewTenantService(tenants)
+
+			tenant, err := service.CreateTenant(context.Background(), tt.input)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input.ID, tenant.ID)
+			}
+
+			tenants.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTenantService_SuspendAndResume(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     domain.TenantStatus
+		suspend     bool
+		expectWrite bool
+		expectedErr error
+	}{
+		{name: "suspend active tenant", current: domain.TenantStatusActive, suspend: true, expectWrite: true},
+		{name: "suspend is idempotent", current: domain.TenantStatusSuspended, suspend: true},
+		{name: "resume suspended tenant", current: domain.TenantStatusSuspended, expectWrite: true},
+		{name: "cannot resume deleting tenant", current: domain.TenantStatusDeleting, expectedErr: connector.ErrTenantNotActive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenants := new(mocks2.MockTenantRepository)
+			tenants.On("GetByID", mock.Anything, "tenant123").
+				Return(&domain.Tenant{ID: "tenant123", Status: tt.current}, nil)
+			if tt.expectWrite {
+				tenants.On("UpdateStatus", mock.Anything, "tenant123", mock.Anything).Return(nil)
+			}
+			service := connector.NewTenantService(tenants)
+
+			var err error
+			if tt.suspend {
+				_, err = service.SuspendTenant(context.Background(), "tenant123")
+			} else {
+				_, err = service.ResumeTenant(context.Background(), "tenant123")
+			}
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			tenants.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTenantService_DeleteTenant(t *testing.T) {
+	t.Run("marks tenant for deletion", func(t *testing.T) {
+		tenants := new(mocks2.MockTenantRepository)
+		tenants.On("GetByID", mock.Anything, "tenant123").
+			Return(&domain.Tenant{ID: "tenant123", Status: domain.TenantStatusActive}, nil)
+		tenants.On("UpdateStatus", mock.Anything, "tenant123", domain.TenantStatusDeleting).Return(nil)
+
+		err := connector.NewTenantService(tenants).DeleteTenant(context.Background(), "tenant123")
+		assert.NoError(t, err)
+		tenants.AssertExpectations(t)
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		tenants := new(mocks2.MockTenantRepository)
+		tenants.On("GetByID", mock.Anything, "missing").Return(nil, sql.ErrNoRows)
+
+		err := connector.NewTenantService(tenants).DeleteTenant(context.Background(), "missing")
+		assert.ErrorIs(t, err, connector.ErrNotFound)
+		tenants.AssertExpectations(t)
+	})
+}
+
+func TestTenantDeletionJob_RunOnce(t *testing.T) {
+	conn := domain.Connector{ID: uuid.New(), WorkspaceID: "workspace123", TenantID: "tenant123", SecretName: "connector/test/connectors/1"}
+
+	t.Run("removes connectors, secrets and tenant", func(t *testing.T) {
+		tenants := new(mocks2.MockTenantRepository)
+		repo := new(mocks2.MockConnectorRepository)
+		sm := new(mocks2.MockSecretsManager)
+
+		tenants.On("ListByStatus", mock.Anything, domain.TenantStatusDeleting, mock.Anything).
+			Return([]domain.Tenant{{ID: "tenant123", Status: domain.TenantStatusDeleting}}, nil)
+		repo.On("ListByTenant", mock.Anything, "tenant123", mock.Anything).
+			Return([]domain.Connector{conn}, nil).Once()
+		repo.On("ListByTenant", mock.Anything, "tenant123", mock.Anything).
+			Return([]domain.Connector{}, nil).Once()
+		sm.On("DeleteToken", mock.Anything, conn.SecretName).Return(nil)
+		repo.On("Purge", mock.Anything, conn.ID).Return(nil)
+		tenants.On("Delete", mock.Anything, "tenant123").Return(nil)
+
+		connector.NewTenantDeletionJob(tenants, repo, sm, time.Minute).RunOnce(context.Background())
+
+		tenants.AssertExpectations(t)
+		repo.AssertExpectations(t)
+		sm.AssertExpectations(t)
+	})
+
+	t.Run("soft-deleted connectors are purged without touching their secret", func(t *testing.T) {
+		tenants := new(mocks2.MockTenantRepository)
+		repo := new(mocks2.MockConnectorRepository)
+		sm := new(mocks2.MockSecretsManager)
+
+		deletedAt := time.Now()
+		deleted := conn
+		deleted.DeletedAt = &deletedAt
+
+		tenants.On("ListByStatus", mock.Anything, domain.TenantStatusDeleting, mock.Anything).
+			Return([]domain.Tenant{{ID: "tenant123", Status: domain.TenantStatusDeleting}}, nil)
+		repo.On("ListByTenant", mock.Anything, "tenant123", mock.Anything).
+			Return([]domain.Connector{deleted}, nil).Once()
+		repo.On("ListByTenant", mock.Anything, "tenant123", mock.Anything).
+			Return([]domain.Connector{}, nil).Once()
+		repo.On("Purge", mock.Anything, conn.ID).Return(nil)
+		tenants.On("Delete", mock.Anything, "tenant123").Return(nil)
+
+		connector.NewTenantDeletionJob(tenants, repo, sm, time.Minute).RunOnce(context.Background())
+
+		repo.AssertExpectations(t)
+		sm.AssertNotCalled(t, "DeleteToken", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a run after a failed purge finishes the tenant", func(t *testing.T) {
+		tenants := new(mocks2.MockTenantRepository)
+		repo := new(mocks2.MockConnectorRepository)
+		// The first run scheduled the secret for deletion, so Secrets Manager
+		// now rejects deleting it again.
+		sm := smInfra.NewClientWithAPI(&deleteSecretAPI{err: &types.InvalidRequestException{
+			Message: aws.String("You can't perform this operation on the secret because it was already scheduled for deletion."),
+		}})
+
+		tenants.On("ListByStatus", mock.Anything, domain.TenantStatusDeleting, mock.Anything).
+			Return([]domain.Tenant{{ID: "tenant123", Status: domain.TenantStatusDeleting}}, nil)
+		repo.On("ListByTenant", mock.Anything, "tenant123", mock.Anything).
+			Return([]domain.Connector{conn}, nil).Once()
+		repo.On("ListByTenant", mock.Anything, "tenant123", mock.Anything).
+			Return([]domain.Connector{}, nil).Once()
+		repo.On("Purge", mock.Anything, conn.ID).Return(nil)
+		tenants.On("Delete", mock.Anything, "tenant123").Return(nil)
+
+		connector.NewTenantDeletionJob(tenants, repo, sm, time.Minute).RunOnce(context.Background())
+
+		tenants.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("keeps tenant when secret deletion fails", func(t *testing.T) {
+		tenants := new(mocks2.MockTenantRepository)
+		repo := new(mocks2.MockConnectorRepository)
+		sm := new(mocks2.MockSecretsManager)
+
+		tenants.On("ListByStatus", mock.Anything, domain.TenantStatusDeleting, mock.Anything).
+			Return([]domain.Tenant{{ID: "tenant123", Status: domain.TenantStatusDeleting}}, nil)
+		repo.On("ListByTenant", mock.Anything, "tenant123", mock.Anything).
+			Return([]domain.Connector{conn}, nil)
+		sm.On("DeleteToken", mock.Anything, mock.Anything).Return(errors.New("deletion error"))
+
+		connector.NewTenantDeletionJob(tenants, repo, sm, time.Minute).RunOnce(context.Background())
+
+		repo.AssertNotCalled(t, "Purge", mock.Anything, mock.Anything)
+		tenants.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_CreateConnector_TenantChecks(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMocks  func(*mocks2.MockTenantRepository)
+		expectedErr error
+	}{
+		{
+			name: "unknown tenant",
+			setupMocks: func(tenants *mocks2.MockTenantRepository) {
+				tenants.On("GetByID", mock.Anything, "tenant123").Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: connector.ErrNotFound,
+		},
+		{
+			name: "suspended tenant",
+			setupMocks: func(tenants *mocks2.MockTenantRepository) {
+				tenants.On("GetByID", mock.Anything, "tenant123").
+					Return(&domain.Tenant{ID: "tenant123", Status: domain.TenantStatusSuspended}, nil)
+			},
+			expectedErr: connector.ErrTenantNotActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mocks2.MockConnectorRepository)
+			sm := new(mocks2.MockSecretsManager)
+			sc := new(mocks2.MockSlackClient)
+			tenants := new(mocks2.MockTenantRepository)
+			tt.setupMocks(tenants)
+
+			testConfig := &config.Config{
+				CircuitBreakerInterval: 60 * time.Second,
+				CircuitBreakerTimeout:  30 * time.Second,
+			}
+			service := connector.NewService(repo, sm, sc,
+				resilience.NewRegistry(testConfig),
+				connector.WithTenantRepository(tenants))
+
+			_, err := service.CreateConnector(context.Background(), connector.CreateInput{
+				WorkspaceID:    "workspace123",
+				TenantID:       "tenant123",
+				Token:          "valid-token-12345",
+				DefaultChannel: "general",
+			})
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			tenants.AssertExpectations(t)
+			sc.AssertNotCalled(t, "ResolveChannelID", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// A suspended tenant's connectors can be neither read, deleted, exported nor
+// rotated until the tenant is reactivated.
+func TestService_SuspendedTenantConnectors(t *testing.T) {
+	newService := func() (*mocks2.MockConnectorRepository, *mocks2.MockSecretsManager, *connector.Service) {
+		repo := new(mocks2.MockConnectorRepository)
+		sm := new(mocks2.MockSecretsManager)
+		tenants := new(mocks2.MockTenantRepository)
+		tenants.On("GetByID", mock.Anything, "active").
+			Return(&domain.Tenant{ID: "active", Status: domain.TenantStatusActive}, nil)
+		tenants.On("GetByID", mock.Anything, "suspended").
+			Return(&domain.Tenant{ID: "suspended", Status: domain.TenantStatusSuspended}, nil)
+		service := connector.NewService(repo, sm, new(mocks2.MockSlackClient),
+			resilience.NewRegistry(&config.Config{
+				CircuitBreakerInterval: 60 * time.Second,
+				CircuitBreakerTimeout:  30 * time.Second,
+			}),
+			connector.WithTenantRepository(tenants))
+		return repo, sm, service
+	}
+	suspended := domain.Connector{ID: uuid.New(), WorkspaceID: "workspace1", TenantID: "suspended", SecretName: "secret-suspended"}
+	active := domain.Connector{ID: uuid.New(), WorkspaceID: "workspace2", TenantID: "active", SecretName: "secret-active"}
+
+	t.Run("get", func(t *testing.T) {
+		repo, _, service := newService()
+		repo.On("GetByID", mock.Anything, suspended.ID).Return(&suspended, nil)
+
+		conn, err := service.GetConnector(context.Background(), suspended.ID)
+		assert.ErrorIs(t, err, connector.ErrTenantNotActive)
+		assert.Nil(t, conn)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		repo, sm, service := newService()
+		repo.On("GetByID", mock.Anything, suspended.ID).Return(&suspended, nil)
+
+		err := service.DeleteConnector(context.Background(), suspended.ID, suspended.WorkspaceID, suspended.TenantID)
+		assert.ErrorIs(t, err, connector.ErrTenantNotActive)
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+		sm.AssertNotCalled(t, "DeleteToken", mock.Anything, mock.Anything)
+	})
+
+	t.Run("export of the tenant", func(t *testing.T) {
+		repo, _, service := newService()
+
+		err := service.ExportConnectors(context.Background(), "suspended", nil, func(connector.ExportedConnector) error { return nil })
+		assert.ErrorIs(t, err, connector.ErrTenantNotActive)
+		repo.AssertNotCalled(t, "ListConnectors", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("export of every tenant", func(t *testing.T) {
+		repo, _, service := newService()
+		repo.On("ListConnectors", mock.Anything, mock.Anything, (*domain.ListCursor)(nil)).
+			Return([]domain.Connector{suspended, active}, (*domain.ListCursor)(nil), nil)
+
+		var exported []uuid.UUID
+		err := service.ExportConnectors(context.Background(), "", nil, func(item connector.ExportedConnector) error {
+			exported = append(exported, item.Connector.ID)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{active.ID}, exported)
+	})
+
+	t.Run("rotation", func(t *testing.T) {
+		repo := new(mocks2.MockConnectorRepository)
+		sm := new(mocks2.MockSecretsManager)
+		tenants := new(mocks2.MockTenantRepository)
+		tenants.On("GetByID", mock.Anything, "active").
+			Return(&domain.Tenant{ID: "active", Status: domain.TenantStatusActive}, nil)
+		tenants.On("GetByID", mock.Anything, "suspended").
+			Return(&domain.Tenant{ID: "suspended", Status: domain.TenantStatusSuspended}, nil)
+		repo.On("ListConnectors", mock.Anything, mock.Anything, mock.Anything).
+			Return([]domain.Connector{suspended, active}, (*domain.ListCursor)(nil), nil)
+		rotated := make(chan struct{}, 1)
+		sm.On("StoreToken", mock.Anything, active.SecretName, mock.Anything).
+			Run(func(mock.Arguments) {
+				select {
+				case rotated <- struct{}{}:
+				default:
+				}
+			}).Return(nil)
+		repo.On("UpdateConnector", mock.Anything, active.ID, mock.Anything).Return(nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		rs := connector.NewRotationService(repo, sm, 10*time.Millisecond, connector.WithRotationTenantRepository(tenants))
+		go rs.Start(ctx)
+
+		select {
+		case <-rotated:
+		case <-time.After(time.Second):
+			t.Fatal("active tenant's connector was not rotated")
+		}
+		cancel()
+		sm.AssertNotCalled(t, "StoreToken", mock.Anything, suspended.SecretName, mock.Anything)
+	})
+}
@@ -0,0 +1,262 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func outboxEntry(seq int64, tenantID string) domain.OutboxEntry {
+	return domain.OutboxEntry{
+		Seq: seq,
+		Event: domain.CloudEvent{
+			ID:       "event-" + tenantID,
+			Type:     string(domain.ConnectorEventUpdated),
+			TenantID: tenantID,
+		},
+	}
+}
+
+// startWatch runs Watch in the background and returns the updates it sends
+// and its result. It returns once the stream is subscribed, which it detects
+// from the OldestSeq call made for the resume token.
+func startWatch(t *testing.T, ctx context.Context, watcher *connector.ConnectorWatcher, log *mocks2.MockEventLog,
+	tenantID, resumeToken string) (<-chan connector.WatchUpdate, <-chan error) {
+	t.Helper()
+	subscribed := make(chan struct{})
+	log.On("OldestSeq", mock.Anything).Return(int64(1), nil).Run(func(mock.Arguments) { close(subscribed) }).Once()
+
+	updates := make(chan connector.WatchUpdate, 16)
+	result := make(chan error, 1)
+	go func() {
+		result <- watcher.Watch(ctx, tenantID, resumeToken, func(update connector.WatchUpdate) error {
+			updates <- update
+			return nil
+		})
+	}()
+
+	select {
+	case <-subscribed:
+	case err := <-result:
+		t.Fatalf("watch ended before subscribing: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("watch did not subscribe")
+	}
+	return updates, result
+}
+
+func nextUpdate(t *testing.T, updates <-chan connector.WatchUpdate) connector.WatchUpdate {
+	t.Helper()
+	select {
+	case update := <-updates:
+		return update
+	case <-time.After(time.Second):
+		t.Fatal("no update received")
+		return connector.WatchUpdate{}
+	}
+}
+
+func TestConnectorWatcher_StreamsTenantEvents(t *testing.T) {
+	log := new(mocks2.MockEventLog)
+	log.On("LatestSeq", mock.Anything).Return(int64(10), nil)
+	watcher := connector.NewConnectorWatcher(log, time.Hour, connector.WithWatchHeartbeat(time.Hour))
+	watcher.RunOnce(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, result := startWatch(t, ctx, watcher, log, "tenant-a", "10")
+
+	log.On("ListAfter", mock.Anything, int64(10), mock.Anything).Return([]domain.OutboxEntry{
+		outboxEntry(11, "tenant-a"),
+		outboxEntry(12, "tenant-b"),
+		outboxEntry(13, "tenant-a"),
+	}, nil).Once()
+	watcher.RunOnce(context.Background())
+
+	first := nextUpdate(t, updates)
+	require.NotNil(t, first.Event)
+	assert.Equal(t, "11", first.ResumeToken)
+	second := nextUpdate(t, updates)
+	require.NotNil(t, second.Event)
+	assert.Equal(t, "13", second.ResumeToken)
+	assert.Equal(t, "tenant-a", second.Event.TenantID)
+
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+}
+
+func TestConnectorWatcher_ReplaysFromResumeToken(t *testing.T) {
+	log := new(mocks2.MockEventLog)
+	log.On("LatestSeq", mock.Anything).Return(int64(20), nil)
+	log.On("ListTenantAfter", mock.Anything, "tenant-a", int64(5), int64(20), mock.Anything).
+		Return([]domain.OutboxEntry{outboxEntry(7, "tenant-a"), outboxEntry(9, "tenant-a")}, nil)
+	watcher := connector.NewConnectorWatcher(log, time.Hour, connector.WithWatchHeartbeat(time.Hour))
+	watcher.RunOnce(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, result := startWatch(t, ctx, watcher, log, "tenant-a", "5")
+
+	assert.Equal(t, "7", nextUpdate(t, updates).ResumeToken)
+	assert.Equal(t, "9", nextUpdate(t, updates).ResumeToken)
+
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+}
+
+func TestConnectorWatcher_WaitsForGaps(t *testing.T) {
+	log := new(mocks2.MockEventLog)
+	log.On("LatestSeq", mock.Anything).Return(int64(10), nil)
+	watcher := connector.NewConnectorWatcher(log, time.Hour, connector.WithWatchHeartbeat(time.Hour))
+	watcher.RunOnce(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, _ := startWatch(t, ctx, watcher, log, "tenant-a", "10")
+
+	// Seq 11 is still being written; 12 must wait for it.
+	log.On("ListAfter", mock.Anything, int64(10), mock.Anything).
+		Return([]domain.OutboxEntry{outboxEntry(12, "tenant-a")}, nil).Once()
+	watcher.RunOnce(context.Background())
+	select {
+	case update := <-updates:
+		t.Fatalf("unexpected update %q before the gap closed", update.ResumeToken)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	log.On("ListAfter", mock.Anything, int64(10), mock.Anything).
+		Return([]domain.OutboxEntry{outboxEntry(11, "tenant-a"), outboxEntry(12, "tenant-a")}, nil).Once()
+	watcher.RunOnce(context.Background())
+	assert.Equal(t, "11", nextUpdate(t, updates).ResumeToken)
+	assert.Equal(t, "12", nextUpdate(t, updates).ResumeToken)
+}
+
+func TestConnectorWatcher_SkipsGapAfterTimeout(t *testing.T) {
+	log := new(mocks2.MockEventLog)
+	log.On("LatestSeq", mock.Anything).Return(int64(10), nil)
+	watcher := connector.NewConnectorWatcher(log, time.Hour,
+		connector.WithWatchHeartbeat(time.Hour), connector.WithWatchGapTimeout(0))
+	watcher.RunOnce(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, _ := startWatch(t, ctx, watcher, log, "tenant-a", "10")
+
+	log.On("ListAfter", mock.Anything, int64(10), mock.Anything).
+		Return([]domain.OutboxEntry{outboxEntry(12, "tenant-a")}, nil).Once()
+	watcher.RunOnce(context.Background())
+	assert.Equal(t, "12", nextUpdate(t, updates).ResumeToken)
+}
+
+func TestConnectorWatcher_HeartbeatAdvancesToken(t *testing.T) {
+	log := new(mocks2.MockEventLog)
+	log.On("LatestSeq", mock.Anything).Return(int64(10), nil)
+	log.On("ListAfter", mock.Anything, int64(10), mock.Anything).
+		Return([]domain.OutboxEntry{outboxEntry(11, "tenant-b")}, nil).Once()
+	watcher := connector.NewConnectorWatcher(log, time.Hour, connector.WithWatchHeartbeat(20*time.Millisecond))
+	watcher.RunOnce(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, _ := startWatch(t, ctx, watcher, log, "tenant-a", "10")
+	watcher.RunOnce(context.Background())
+
+	heartbeat := nextUpdate(t, updates)
+	assert.Nil(t, heartbeat.Event)
+	assert.Equal(t, "11", heartbeat.ResumeToken)
+}
+
+func TestConnectorWatcher_DropsSlowStreams(t *testing.T) {
+	log := new(mocks2.MockEventLog)
+	log.On("LatestSeq", mock.Anything).Return(int64(10), nil)
+	subscribed := make(chan struct{})
+	log.On("OldestSeq", mock.Anything).Return(int64(1), nil).Run(func(mock.Arguments) { close(subscribed) })
+	watcher := connector.NewConnectorWatcher(log, time.Hour, connector.WithWatchBufferSize(1))
+	watcher.RunOnce(context.Background())
+
+	received := make(chan struct{}, 1)
+	release := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		result <- watcher.Watch(context.Background(), "tenant-a", "10", func(connector.WatchUpdate) error {
+			received <- struct{}{}
+			<-release
+			return nil
+		})
+	}()
+	<-subscribed
+
+	entries := []domain.OutboxEntry{outboxEntry(11, "tenant-a"), outboxEntry(12, "tenant-a"), outboxEntry(13, "tenant-a")}
+	log.On("ListAfter", mock.Anything, int64(10), mock.Anything).Return(entries[:1], nil).Once()
+	watcher.RunOnce(context.Background())
+	<-received
+
+	// The stream is stuck sending seq 11; 12 fills its buffer and 13 overflows it.
+	log.On("ListAfter", mock.Anything, int64(11), mock.Anything).Return(entries[1:], nil).Once()
+	watcher.RunOnce(context.Background())
+	close(release)
+
+	select {
+	case err := <-result:
+		assert.ErrorIs(t, err, connector.ErrWatchLagging)
+	case <-time.After(time.Second):
+		t.Fatal("slow stream was not dropped")
+	}
+}
+
+func TestConnectorWatcher_Errors(t *testing.T) {
+	log := new(mocks2.MockEventLog)
+	watcher := connector.NewConnectorWatcher(log, time.Hour)
+	send := func(connector.WatchUpdate) error { return nil }
+
+	err := watcher.Watch(context.Background(), "tenant-a", "", send)
+	assert.ErrorIs(t, err, connector.ErrWatchUnavailable, "watch before the first run")
+
+	log.On("LatestSeq", mock.Anything).Return(int64(100), nil)
+	watcher.RunOnce(context.Background())
+
+	err = watcher.Watch(context.Background(), "tenant-a", "abc", send)
+	assert.ErrorIs(t, err, connector.ErrInvalidInput)
+
+	log.On("OldestSeq", mock.Anything).Return(int64(50), nil)
+	err = watcher.Watch(context.Background(), "tenant-a", "10", send)
+	assert.ErrorIs(t, err, connector.ErrResumeTokenExpired)
+
+	log.On("ListAfter", mock.Anything, int64(100), mock.Anything).Return(nil, errors.New("db down"))
+	assert.NotPanics(t, func() { watcher.RunOnce(context.Background()) })
+}
+
+func TestConnectorWatcher_StopEndsStreams(t *testing.T) {
+	log := new(mocks2.MockEventLog)
+	ready := make(chan struct{})
+	log.On("LatestSeq", mock.Anything).Return(int64(10), nil).Run(func(mock.Arguments) { close(ready) }).Once()
+	log.On("ListAfter", mock.Anything, mock.Anything, mock.Anything).Return([]domain.OutboxEntry{}, nil)
+	watcher := connector.NewConnectorWatcher(log, 10*time.Millisecond, connector.WithWatchHeartbeat(time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		watcher.Start(ctx)
+		close(stopped)
+	}()
+	<-ready
+
+	_, result := startWatch(t, context.Background(), watcher, log, "tenant-a", "10")
+	cancel()
+	<-stopped
+
+	select {
+	case err := <-result:
+		assert.ErrorIs(t, err, connector.ErrWatchUnavailable)
+	case <-time.After(time.Second):
+		t.Fatal("stream outlived the watcher")
+	}
+}
@@ -0,0 +1,383 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	webhookInfra "github.com/connector-recruitment/internal/infrastructure/webhook"
+	"github.com/connector-recruitment/pkg/webhook"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookService_CreateSubscription(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         connector.CreateWebhookInput
+		insecure      bool
+		tenantStatus  domain.TenantStatus
+		expectCreate  bool
+		expectedError error
+	}{
+		{
+			name: "success with generated secret",
+			input: connector.CreateWebhookInput{
+				TenantID:   "tenant123",
+				URL:        "https://example.com/hooks",
+				EventTypes: []string{string(domain.ConnectorEventCreated), string(domain.ConnectorEventCreated)},
+			},
+			tenantStatus: domain.TenantStatusActive,
+			expectCreate: true,
+		},
+		{
+			name:          "http rejected by default",
+			input:         connector.CreateWebhookInput{TenantID: "tenant123", URL: "http://example.com/hooks"},
+			expectedError: connector.ErrInvalidInput,
+		},
+		{
+			name:         "http allowed when insecure urls are enabled",
+			input:        connector.CreateWebhookInput{TenantID: "tenant123", URL: "http://localhost:9000/hooks"},
+			insecure:     true,
+			tenantStatus: domain.TenantStatusActive,
+			expectCreate: true,
+		},
+		{
+			name:          "private address rejected",
+			input:         connector.CreateWebhookInput{TenantID: "tenant123", URL: "https://10.0.0.5/hooks"},
+			expectedError: connector.ErrInvalidInput,
+		},
+		{
+			name:          "cloud metadata address rejected",
+			input:         connector.CreateWebhookInput{TenantID: "tenant123", URL: "https://169.254.169.254/latest/meta-data"},
+			expectedError: connector.ErrInvalidInput,
+		},
+		{
+			name:          "localhost rejected",
+			input:         connector.CreateWebhookInput{TenantID: "tenant123", URL: "https://localhost/hooks"},
+			expectedError: connector.ErrInvalidInput,
+		},
+		{
+			name: "unknown event type",
+			input: connector.CreateWebhookInput{
+				TenantID:   "tenant123",
+				URL:        "https://example.com/hooks",
+				EventTypes: []string{"connector.exploded"},
+			},
+			expectedError: connector.ErrInvalidInput,
+		},
+		{
+			name: "short secret",
+			input: connector.CreateWebhookInput{
+				TenantID: "tenant123",
+				URL:      "https://example.com/hooks",
+				Secret:   "short",
+			},
+			expectedError: connector.ErrInvalidInput,
+		},
+		{
+			name:          "suspended tenant",
+			input:         connector.CreateWebhookInput{TenantID: "tenant123", URL: "https://example.com/hooks"},
+			tenantStatus:  domain.TenantStatusSuspended,
+			expectedError: connector.ErrTenantNotActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subs := new(mocks2.MockWebhookSubscriptionRepository)
+			tenants := new(mocks2.MockTenantRepository)
+			if tt.tenantStatus != "" {
+				tenants.On("GetByID", mock.Anything, "tenant123").
+					Return(&domain.Tenant{ID: "tenant123", Status: tt.tenantStatus}, nil)
+			}
+			if tt.expectCreate {
+				subs.On("Create", mock.Anything, mock.AnythingOfType("*domain.WebhookSubscription")).Return(nil)
+			}
+
+			service := connector.NewWebhookService(subs, new(mocks2.MockWebhookDeliveryRepository), tenants, tt.insecure)
+			sub, err := service.CreateSubscription(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				subs.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, domain.WebhookStatusActive, sub.Status)
+			assert.True(t, strings.HasPrefix(sub.Secret, "whsec_"))
+			assert.Len(t, sub.EventTypes, len(uniqueStrings(tt.input.EventTypes)))
+			subs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookService_NotFound(t *testing.T) {
+	id := uuid.New()
+	subs := new(mocks2.MockWebhookSubscriptionRepository)
+	subs.On("GetByID", mock.Anything, id).Return(nil, sql.ErrNoRows)
+	subs.On("Delete", mock.Anything, id).Return(sql.ErrNoRows)
+	subs.On("Enable", mock.Anything, id).Return(sql.ErrNoRows)
+	deliveries := new(mocks2.MockWebhookDeliveryRepository)
+
+	service := connector.NewWebhookService(subs, deliveries, new(mocks2.MockTenantRepository), false)
+
+	_, err := service.GetSubscription(context.Background(), id)
+	assert.ErrorIs(t, err, connector.ErrNotFound)
+	assert.ErrorIs(t, service.DeleteSubscription(context.Background(), id), connector.ErrNotFound)
+	_, err = service.EnableSubscription(context.Background(), id)
+	assert.ErrorIs(t, err, connector.ErrNotFound)
+	_, err = service.ListDeliveryAttempts(context.Background(), id, 10)
+	assert.ErrorIs(t, err, connector.ErrNotFound)
+	deliveries.AssertNotCalled(t, "ListAttempts", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWebhookDispatcher_Publish(t *testing.T) {
+	matching := domain.WebhookSubscription{ID: uuid.New(), TenantID: "tenant123",
+		EventTypes: []string{string(domain.ConnectorEventCreated)}}
+	wildcard := domain.WebhookSubscription{ID: uuid.New(), TenantID: "tenant123"}
+	other := domain.WebhookSubscription{ID: uuid.New(), TenantID: "tenant123",
+		EventTypes: []string{string(domain.ConnectorEventDeleted)}}
+
+	subs := new(mocks2.MockWebhookSubscriptionRepository)
+	subs.On("ListActiveByTenant", mock.Anything, "tenant123").
+		Return([]domain.WebhookSubscription{matching, wildcard, other}, nil)
+
+	var queued []domain.WebhookDelivery
+	deliveries := new(mocks2.MockWebhookDeliveryRepository)
+	deliveries.On("Enqueue", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { queued = args.Get(1).([]domain.WebhookDelivery) }).
+		Return(nil)
+
+	event := &domain.CloudEvent{
+		ID:       uuid.NewString(),
+		Type:     string(domain.ConnectorEventCreated),
+		TenantID: "tenant123",
+		Data:     json.RawMessage(`{"id":"abc"}`),
+	}
+	err := connector.NewWebhookDispatcher(subs, deliveries).Publish(context.Background(), event)
+
+	require.NoError(t, err)
+	require.Len(t, queued, 2)
+	assert.Equal(t, matching.ID, queued[0].SubscriptionID)
+	assert.Equal(t, wildcard.ID, queued[1].SubscriptionID)
+	for _, d := range queued {
+		assert.Equal(t, event.ID, d.EventID)
+		assert.Equal(t, domain.WebhookDeliveryPending, d.Status)
+		var decoded domain.CloudEvent
+		require.NoError(t, json.Unmarshal(d.Payload, &decoded))
+		assert.Equal(t, event.ID, decoded.ID)
+	}
+}
+
+func TestFanoutPublisher(t *testing.T) {
+	assert.Nil(t, connector.NewFanoutPublisher(nil, nil))
+
+	first := new(mocks2.MockEventPublisher)
+	second := new(mocks2.MockEventPublisher)
+	event := &domain.CloudEvent{ID: uuid.NewString()}
+	first.On("Publish", mock.Anything, event).Return(errors.New("broker down"))
+
+	err := connector.NewFanoutPublisher(first, nil, second).Publish(context.Background(), event)
+
+	assert.EqualError(t, err, "broker down")
+	second.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
+
+func TestWebhookDeliveryWorker_RunOnce(t *testing.T) {
+	tests := []struct {
+		name           string
+		priorAttempts  int
+		statusCode     int
+		sendErr        error
+		resultStatus   domain.WebhookStatus
+		expectedStatus domain.WebhookDeliveryStatus
+		expectRetry    bool
+	}{
+		{
+			name:           "success",
+			statusCode:     204,
+			resultStatus:   domain.WebhookStatusActive,
+			expectedStatus: domain.WebhookDeliverySucceeded,
+		},
+		{
+			name:           "server error is retried",
+			statusCode:     503,
+			resultStatus:   domain.WebhookStatusActive,
+			expectedStatus: domain.WebhookDeliveryPending,
+			expectRetry:    true,
+		},
+		{
+			name:           "transport error is retried",
+			sendErr:        errors.New("connection refused"),
+			resultStatus:   domain.WebhookStatusActive,
+			expectedStatus: domain.WebhookDeliveryPending,
+			expectRetry:    true,
+		},
+		{
+			name:           "last attempt fails the delivery",
+			priorAttempts:  2,
+			statusCode:     500,
+			resultStatus:   domain.WebhookStatusDisabled,
+			expectedStatus: domain.WebhookDeliveryFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := &domain.WebhookSubscription{
+				ID:       uuid.New(),
+				TenantID: "tenant123",
+				URL:      "https://example.com/hooks",
+				Secret:   "whsec_test-secret-value",
+				Status:   domain.WebhookStatusActive,
+			}
+			delivery := domain.WebhookDelivery{
+				ID:             uuid.New(),
+				SubscriptionID: sub.ID,
+				EventID:        uuid.NewString(),
+				EventType:      string(domain.ConnectorEventCreated),
+				Payload:        json.RawMessage(`{"id":"evt"}`),
+				Status:         domain.WebhookDeliveryPending,
+				Attempts:       tt.priorAttempts,
+			}
+
+			subs := new(mocks2.MockWebhookSubscriptionRepository)
+			deliveries := new(mocks2.MockWebhookDeliveryRepository)
+			sender := new(mocks2.MockWebhookSender)
+
+			deliveries.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything).
+				Return([]domain.WebhookDelivery{delivery}, nil)
+			subs.On("GetByID", mock.Anything, sub.ID).Return(sub, nil)
+
+			var headers map[string]string
+			sender.On("Send", mock.Anything, sub.URL, mock.Anything, []byte(delivery.Payload)).
+				Run(func(args mock.Arguments) { headers = args.Get(2).(map[string]string) }).
+				Return(tt.statusCode, tt.sendErr)
+
+			var recorded *domain.WebhookDelivery
+			var attempt *domain.WebhookDeliveryAttempt
+			deliveries.On("RecordAttempt", mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					recorded = args.Get(1).(*domain.WebhookDelivery)
+					attempt = args.Get(2).(*domain.WebhookDeliveryAttempt)
+				}).
+				Return(nil)
+			succeeded := tt.expectedStatus == domain.WebhookDeliverySucceeded
+			subs.On("RecordDeliveryResult", mock.Anything, sub.ID, succeeded, 5).Return(tt.resultStatus, nil)
+
+			worker := connector.NewWebhookDeliveryWorker(subs, deliveries, sender, time.Second,
+				connector.WithWebhookRetryPolicy(3, time.Minute, time.Hour),
+				connector.WithWebhookDisableAfter(5))
+			before := time.Now()
+			worker.RunOnce(context.Background())
+
+			require.NotNil(t, recorded)
+			assert.Equal(t, tt.expectedStatus, recorded.Status)
+			assert.Equal(t, tt.priorAttempts+1, recorded.Attempts)
+			assert.Equal(t, tt.priorAttempts+1, attempt.AttemptNumber)
+			assert.Equal(t, succeeded, attempt.Succeeded())
+			if tt.sendErr != nil {
+				assert.Equal(t, tt.sendErr.Error(), attempt.Error)
+			}
+			if tt.expectRetry {
+				wait := recorded.NextAttemptAt.Sub(before)
+				assert.GreaterOrEqual(t, wait, 30*time.Second)
+				assert.LessOrEqual(t, wait, time.Minute+time.Second)
+			}
+
+			assert.NoError(t, webhook.Verify(sub.Secret, headers[webhook.HeaderTimestamp],
+				headers[webhook.HeaderSignature], delivery.Payload, time.Minute))
+			assert.Equal(t, delivery.ID.String(), headers[webhook.HeaderID])
+			subs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookDeliveryWorker_SkipsDisabledSubscription(t *testing.T) {
+	sub := &domain.WebhookSubscription{ID: uuid.New(), Status: domain.WebhookStatusDisabled}
+	subs := new(mocks2.MockWebhookSubscriptionRepository)
+	deliveries := new(mocks2.MockWebhookDeliveryRepository)
+	sender := new(mocks2.MockWebhookSender)
+
+	deliveries.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything).
+		Return([]domain.WebhookDelivery{{ID: uuid.New(), SubscriptionID: sub.ID}}, nil)
+	subs.On("GetByID", mock.Anything, sub.ID).Return(sub, nil)
+
+	connector.NewWebhookDeliveryWorker(subs, deliveries, sender, time.Second).RunOnce(context.Background())
+
+	sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	deliveries.AssertNotCalled(t, "RecordAttempt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWebhookSignature(t *testing.T) {
+	body := []byte(`{"id":"evt"}`)
+	now := time.Now()
+	headers := webhook.Headers("secret", "delivery-1", "type", now, body)
+
+	assert.NoError(t, webhook.Verify("secret", headers[webhook.HeaderTimestamp], headers[webhook.HeaderSignature], body, time.Minute))
+	assert.ErrorIs(t, webhook.Verify("other", headers[webhook.HeaderTimestamp], headers[webhook.HeaderSignature], body, time.Minute),
+		webhook.ErrInvalidSignature)
+	assert.ErrorIs(t, webhook.Verify("secret", headers[webhook.HeaderTimestamp], headers[webhook.HeaderSignature], []byte(`{}`), time.Minute),
+		webhook.ErrInvalidSignature)
+
+	stale := webhook.Headers("secret", "delivery-1", "type", now.Add(-time.Hour), body)
+	assert.ErrorIs(t, webhook.Verify("secret", stale[webhook.HeaderTimestamp], stale[webhook.HeaderSignature], body, time.Minute),
+		webhook.ErrInvalidTimestamp)
+}
+
+func uniqueStrings(values []string) map[string]bool {
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		seen[v] = true
+	}
+	return seen
+}
+
+func TestWebhookSender_RefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	// The test server listens on loopback, as an internal service would.
+	_, err := webhookInfra.NewSender(time.Second).Send(context.Background(), srv.URL, nil, []byte("{}"))
+	assert.ErrorIs(t, err, webhook.ErrPrivateAddress)
+
+	status, err := webhookInfra.NewSender(time.Second, webhookInfra.WithPrivateAddresses()).
+		Send(context.Background(), srv.URL, nil, []byte("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+}
+
+func TestWebhookPublicAddress(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"93.184.216.34":    true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"0.0.0.0":          false,
+		"::1":              false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"::ffff:127.0.0.1": false,
+	} {
+		assert.Equal(t, public, webhook.PublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildAuditChain(n int) []domain.AuditEvent {
+	events := make([]domain.AuditEvent, 0, n)
+	prevHash := ""
+	for i := 1; i <= n; i++ {
+		event := domain.AuditEvent{
+			ID:         int64(i),
+			OccurredAt: time.Date(2024, 1, 1, 0, 0, i, 0, time.UTC),
+			Actor:      "alice",
+			TenantID:   "tenant123",
+			Action:     domain.AuditActionConnectorCreate,
+			TargetType: "connector",
+			TargetID:   "target",
+			Outcome:    domain.AuditOutcomeSuccess,
+			Diff:       json.RawMessage(`{"status":{"new":"active"}}`),
+			PrevHash:   prevHash,
+		}
+		event.Hash = event.ComputeHash()
+		prevHash = event.Hash
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestAuditEvent_ComputeHash(t *testing.T) {
+	event := buildAuditChain(1)[0]
+
+	// Sub-microsecond precision is lost in Postgres and must not change the hash.
+	stored := event
+	stored.OccurredAt = stored.OccurredAt.Add(500 * time.Nanosecond)
+	assert.Equal(t, event.Hash, stored.ComputeHash())
+
+	changed := event
+	changed.Actor = "mallory"
+	assert.NotEqual(t, event.Hash, changed.ComputeHash())
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	tests := []struct {
+		name      string
+		tamper    func([]domain.AuditEvent) []domain.AuditEvent
+		brokenAt  int64
+		expectErr bool
+	}{
+		{
+			name:   "intact chain",
+			tamper: func(events []domain.AuditEvent) []domain.AuditEvent { return events },
+		},
+		{
+			name: "edited event",
+			tamper: func(events []domain.AuditEvent) []domain.AuditEvent {
+				events[1].Outcome = domain.AuditOutcomeFailure
+				return events
+			},
+			brokenAt:  2,
+			expectErr: true,
+		},
+		{
+			name: "edited and rehashed event",
+			tamper: func(events []domain.AuditEvent) []domain.AuditEvent {
+				events[1].Actor = "mallory"
+				events[1].Hash = events[1].ComputeHash()
+				return events
+			},
+			brokenAt:  3,
+			expectErr: true,
+		},
+		{
+			name: "removed event",
+			tamper: func(events []domain.AuditEvent) []domain.AuditEvent {
+				return append(events[:1], events[2:]...)
+			},
+			brokenAt:  3,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain.VerifyAuditChain(tt.tamper(buildAuditChain(3)), 0, "")
+			if !tt.expectErr {
+				assert.NoError(t, err)
+				return
+			}
+			var chainErr *domain.AuditChainError
+			require.True(t, errors.As(err, &chainErr))
+			assert.Equal(t, tt.brokenAt, chainErr.EventID)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,6 +25,12 @@ func TestGRPCError(t *testing.T) {
 			expectedCode:  codes.InvalidArgument,
 			expectedError: connector.ErrInvalidInput.Error(),
 		},
+		{
+			name:          "request validation error",
+			err:           (&connectorv1.GetConnectorRequest{Id: "not-a-uuid"}).Validate(),
+			expectedCode:  codes.InvalidArgument,
+			expectedError: (&connectorv1.GetConnectorRequest{Id: "not-a-uuid"}).Validate().Error(),
+		},
 		{
 			name:          "connector not found error",
 			err:           connector.ErrNotFound,
@@ -41,6 +49,60 @@ func TestGRPCError(t *testing.T) {
 			expectedCode:  codes.NotFound,
 			expectedError: "wrapped\nrecord not found",
 		},
+		{
+			name:          "already exists error",
+			err:           connector.ErrAlreadyExists,
+			expectedCode:  codes.AlreadyExists,
+			expectedError: connector.ErrAlreadyExists.Error(),
+		},
+		{
+			name:          "tenant not active error",
+			err:           connector.ErrTenantNotActive,
+			expectedCode:  codes.FailedPrecondition,
+			expectedError: connector.ErrTenantNotActive.Error(),
+		},
+		{
+			name:          "restore window expired error",
+			err:           connector.ErrRestoreExpired,
+			expectedCode:  codes.FailedPrecondition,
+			expectedError: connector.ErrRestoreExpired.Error(),
+		},
+		{
+			name:          "resume token expired error",
+			err:           connector.ErrResumeTokenExpired,
+			expectedCode:  codes.OutOfRange,
+			expectedError: connector.ErrResumeTokenExpired.Error(),
+		},
+		{
+			name:          "watch lagging error",
+			err:           connector.ErrWatchLagging,
+			expectedCode:  codes.Aborted,
+			expectedError: connector.ErrWatchLagging.Error(),
+		},
+		{
+			name:          "watch unavailable error",
+			err:           connector.ErrWatchUnavailable,
+			expectedCode:  codes.Unavailable,
+			expectedError: connector.ErrWatchUnavailable.Error(),
+		},
+		{
+			name:          "slack channel not found error",
+			err:           domain.ErrSlackChannelNotFound,
+			expectedCode:  codes.InvalidArgument,
+			expectedError: domain.ErrSlackChannelNotFound.Error(),
+		},
+		{
+			name:          "slack invalid auth error",
+			err:           domain.ErrSlackInvalidAuth,
+			expectedCode:  codes.FailedPrecondition,
+			expectedError: domain.ErrSlackInvalidAuth.Error(),
+		},
+		{
+			name:          "slack rate limited error",
+			err:           domain.ErrSlackRateLimited,
+			expectedCode:  codes.ResourceExhausted,
+			expectedError: "slack rate limit exceeded; try again later",
+		},
 		{
 			name:          "unknown error",
 			err:           errors.New("unknown error"),
@@ -0,0 +1,24 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/connector-recruitment/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretNaming_Name(t *testing.T) {
+	id := uuid.MustParse("8f14e45f-ceea-467f-a0e6-4f1c1a2b3c4d")
+
+	naming := domain.NewSecretNaming("/connector/", "prod")
+	assert.Equal(t, "connector/prod/connectors/8f14e45f-ceea-467f-a0e6-4f1c1a2b3c4d", naming.Name(id))
+}
+
+func TestLegacySecretName_Collides(t *testing.T) {
+	// The reason the legacy scheme was replaced: distinct pairs map to one name.
+	assert.Equal(t, domain.LegacySecretName("ws-a", "b"), domain.LegacySecretName("ws", "a-b"))
+
+	naming := domain.NewSecretNaming("connector", "prod")
+	assert.NotEqual(t, naming.Name(uuid.New()), naming.Name(uuid.New()))
+}
@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/connector-recruitment/internal/domain"
+)
+
+type MockAuditLog struct {
+	mock.Mock
+}
+
+func (m *MockAuditLog) Append(ctx context.Context, event *domain.AuditEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockAuditLog) List(ctx context.Context, filter domain.AuditFilter, limit int, afterID int64) ([]domain.AuditEvent, int64, error) {
+	args := m.Called(ctx, filter, limit, afterID)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]domain.AuditEvent), args.Get(1).(int64), args.Error(2)
+}
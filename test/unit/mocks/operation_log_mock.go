@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/connector-recruitment/internal/domain"
+)
+
+type MockOperationLog struct {
+	mock.Mock
+}
+
+func (m *MockOperationLog) Begin(ctx context.Context, op *domain.ConnectorOperation) error {
+	args := m.Called(ctx, op)
+	return args.Error(0)
+}
+
+func (m *MockOperationLog) Advance(ctx context.Context, id uuid.UUID, state domain.OperationState) error {
+	args := m.Called(ctx, id, state)
+	return args.Error(0)
+}
+
+func (m *MockOperationLog) RecordFailure(ctx context.Context, id uuid.UUID, reason string) error {
+	args := m.Called(ctx, id, reason)
+	return args.Error(0)
+}
+
+func (m *MockOperationLog) ListUnfinished(ctx context.Context, updatedBefore time.Time, limit int) ([]domain.ConnectorOperation, error) {
+	args := m.Called(ctx, updatedBefore, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ConnectorOperation), args.Error(1)
+}
+
+func (m *MockOperationLog) CancelUnfinished(ctx context.Context, connectorID uuid.UUID, kind domain.OperationKind) error {
+	args := m.Called(ctx, connectorID, kind)
+	return args.Error(0)
+}
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/connector-recruitment/internal/domain"
+)
+
+type MockOutbox struct {
+	mock.Mock
+}
+
+func (m *MockOutbox) Enqueue(ctx context.Context, event *domain.CloudEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockOutbox) Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEntry, error) {
+	args := m.Called(ctx, limit, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.OutboxEntry), args.Error(1)
+}
+
+func (m *MockOutbox) MarkPublished(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockOutbox) RecordFailure(ctx context.Context, id string, reason string) error {
+	args := m.Called(ctx, id, reason)
+	return args.Error(0)
+}
+
+func (m *MockOutbox) PrunePublished(ctx context.Context, before time.Time) error {
+	args := m.Called(ctx, before)
+	return args.Error(0)
+}
+
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockEventPublisher) Publish(ctx context.Context, event *domain.CloudEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// MockTransactor runs fn directly and records whether the transaction would
+// have committed.
+type MockTransactor struct {
+	Committed  int
+	RolledBack int
+}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		m.RolledBack++
+		return err
+	}
+	m.Committed++
+	return nil
+}
+
+type MockEventLog struct {
+	mock.Mock
+}
+
+func (m *MockEventLog) LatestSeq(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockEventLog) OldestSeq(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockEventLog) ListAfter(ctx context.Context, afterSeq int64, limit int) ([]domain.OutboxEntry, error) {
+	args := m.Called(ctx, afterSeq, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.OutboxEntry), args.Error(1)
+}
+
+func (m *MockEventLog) ListTenantAfter(ctx context.Context, tenantID string, afterSeq, upToSeq int64, limit int) ([]domain.OutboxEntry, error) {
+	args := m.Called(ctx, tenantID, afterSeq, upToSeq, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.OutboxEntry), args.Error(1)
+}
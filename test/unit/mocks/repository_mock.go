@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -43,3 +44,60 @@ func (m *MockConnectorRepository) UpdateConnector(ctx context.Context, id uuid.U
 	args := m.Called(ctx, id, token)
 	return args.Error(0)
 }
+
+func (m *MockConnectorRepository) ListByTenant(ctx context.Context, tenantID string, limit int) ([]domain.Connector, error) {
+	args := m.Called(ctx, tenantID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Connector), args.Error(1)
+}
+
+func (m *MockConnectorRepository) GetByWorkspaceAndTenant(ctx context.Context, workspaceID, tenantID string) (*domain.Connector, error) {
+	args := m.Called(ctx, workspaceID, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Connector), args.Error(1)
+}
+
+func (m *MockConnectorRepository) UpdateSecretName(ctx context.Context, id uuid.UUID, secretName string) error {
+	args := m.Called(ctx, id, secretName)
+	return args.Error(0)
+}
+
+func (m *MockConnectorRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ConnectorStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockConnectorRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Connector, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Connector), args.Error(1)
+}
+
+func (m *MockConnectorRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockConnectorRepository) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]domain.Connector, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Connector), args.Error(1)
+}
+
+func (m *MockConnectorRepository) ReferencesSecret(ctx context.Context, secretName string) (bool, error) {
+	args := m.Called(ctx, secretName)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockConnectorRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/stretchr/testify/mock"
+
+	"github.com/connector-recruitment/internal/domain"
 )
 
 type MockSecretsManager struct {
@@ -24,3 +26,16 @@ func (m *MockSecretsManager) DeleteToken(ctx context.Context, secretName string)
 	args := m.Called(ctx, secretName)
 	return args.Error(0)
 }
+
+func (m *MockSecretsManager) RestoreToken(ctx context.Context, secretName string) error {
+	args := m.Called(ctx, secretName)
+	return args.Error(0)
+}
+
+func (m *MockSecretsManager) ListSecrets(ctx context.Context, prefix string) ([]domain.SecretSummary, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.SecretSummary), args.Error(1)
+}
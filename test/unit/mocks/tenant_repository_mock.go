@@ -0,0 +1,52 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/connector-recruitment/internal/domain"
+)
+
+type MockTenantRepository struct {
+	mock.Mock
+}
+
+func (m *MockTenantRepository) Create(ctx context.Context, t *domain.Tenant) error {
+	args := m.Called(ctx, t)
+	return args.Error(0)
+}
+
+func (m *MockTenantRepository) GetByID(ctx context.Context, id string) (*domain.Tenant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Tenant), args.Error(1)
+}
+
+func (m *MockTenantRepository) List(ctx context.Context, limit int, afterID string) ([]domain.Tenant, string, error) {
+	args := m.Called(ctx, limit, afterID)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).([]domain.Tenant), args.String(1), args.Error(2)
+}
+
+func (m *MockTenantRepository) ListByStatus(ctx context.Context, status domain.TenantStatus, limit int) ([]domain.Tenant, error) {
+	args := m.Called(ctx, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Tenant), args.Error(1)
+}
+
+func (m *MockTenantRepository) UpdateStatus(ctx context.Context, id string, status domain.TenantStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockTenantRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,98 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/connector-recruitment/internal/domain"
+)
+
+type MockWebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookSubscriptionRepository) Create(ctx context.Context, sub *domain.WebhookSubscription) error {
+	args := m.Called(ctx, sub)
+	return args.Error(0)
+}
+
+func (m *MockWebhookSubscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookSubscriptionRepository) ListByTenant(ctx context.Context, tenantID string) ([]domain.WebhookSubscription, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookSubscriptionRepository) ListActiveByTenant(ctx context.Context, tenantID string) ([]domain.WebhookSubscription, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookSubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookSubscriptionRepository) Enable(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookSubscriptionRepository) RecordDeliveryResult(ctx context.Context, id uuid.UUID, success bool, disableAfter int) (domain.WebhookStatus, error) {
+	args := m.Called(ctx, id, success, disableAfter)
+	return args.Get(0).(domain.WebhookStatus), args.Error(1)
+}
+
+type MockWebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookDeliveryRepository) Enqueue(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	args := m.Called(ctx, deliveries)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookDelivery, error) {
+	args := m.Called(ctx, limit, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryRepository) RecordAttempt(ctx context.Context, delivery *domain.WebhookDelivery, attempt *domain.WebhookDeliveryAttempt) error {
+	args := m.Called(ctx, delivery, attempt)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) ListAttempts(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]domain.WebhookDeliveryAttempt, error) {
+	args := m.Called(ctx, subscriptionID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.WebhookDeliveryAttempt), args.Error(1)
+}
+
+type MockWebhookSender struct {
+	mock.Mock
+}
+
+func (m *MockWebhookSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	args := m.Called(ctx, url, headers, body)
+	return args.Int(0), args.Error(1)
+}
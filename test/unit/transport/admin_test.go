@@ -0,0 +1,161 @@
+package transport_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	grpcTransport "github.com/connector-recruitment/internal/transport/grpc"
+	"github.com/connector-recruitment/pkg/resilience"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/connector-recruitment/test/unit/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newAdminAudit returns an audit service whose events are sent to the
+// returned channel.
+func newAdminAudit() (*connector.AuditService, chan domain.AuditEvent) {
+	events := make(chan domain.AuditEvent, 10)
+	auditLog := new(mocks.MockAuditLog)
+	auditLog.On("Append", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		events <- *args.Get(1).(*domain.AuditEvent)
+	}).Return(nil)
+	return connector.NewAuditService(auditLog), events
+}
+
+func TestAdminHandler_CircuitBreakers(t *testing.T) {
+	breakers := resilience.NewRegistry(&config.Config{CircuitBreakerTimeout: time.Minute})
+	breakers.Get(resilience.BreakerSlack)
+	breakers.Get(resilience.BreakerPostgres)
+	audit, events := newAdminAudit()
+	handler := grpcTransport.NewAdminHandler(breakers, audit)
+	ctx := context.Background()
+
+	set, err := handler.SetCircuitBreakerMode(ctx, &connectorv1.SetCircuitBreakerModeRequest{
+		Name: resilience.BreakerSlack,
+		Mode: connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_FORCED_OPEN,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, connectorv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, set.CircuitBreaker.State)
+	assert.Equal(t, connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_FORCED_OPEN, set.CircuitBreaker.Mode)
+
+	event := <-events
+	assert.Equal(t, domain.AuditActionCircuitBreakerSetMode, event.Action)
+	assert.Equal(t, domain.AuditOutcomeSuccess, event.Outcome)
+	assert.Equal(t, resilience.BreakerSlack, event.TargetID)
+	assert.JSONEq(t, `{"mode":{"new":"forced_open"}}`, string(event.Diff))
+
+	list, err := handler.ListCircuitBreakers(ctx, &connectorv1.ListCircuitBreakersRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.CircuitBreakers, 2)
+	assert.Equal(t, resilience.BreakerPostgres, list.CircuitBreakers[0].Name)
+	assert.Equal(t, connectorv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED, list.CircuitBreakers[0].State)
+	assert.Equal(t, connectorv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, list.CircuitBreakers[1].State)
+
+	_, err = handler.SetCircuitBreakerMode(ctx, &connectorv1.SetCircuitBreakerModeRequest{
+		Name: "redis",
+		Mode: connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_AUTO,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, domain.AuditOutcomeFailure, (<-events).Outcome)
+
+	_, err = handler.SetCircuitBreakerMode(ctx, &connectorv1.SetCircuitBreakerModeRequest{Name: resilience.BreakerSlack})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// newAdminTestConn serves AdminService and ConnectorService through
+// NewServer, guarded by token, and returns a client connection to it.
+func newAdminTestConn(t *testing.T, token string) (*grpc.ClientConn, chan domain.AuditEvent) {
+	t.Helper()
+	breakers := resilience.NewRegistry(&config.Config{CircuitBreakerTimeout: time.Minute})
+	breakers.Get(resilience.BreakerSlack)
+	audit, events := newAdminAudit()
+	server, lis, err := grpcTransport.NewServer(nil, nil, audit, nil, "0",
+		grpcTransport.WithCircuitBreakers(breakers), grpcTransport.WithAdminToken(token))
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn, events
+}
+
+func TestAdminService_RequiresAdminToken(t *testing.T) {
+	newClient := func(t *testing.T, token string) (connectorv1.AdminServiceClient, chan domain.AuditEvent) {
+		t.Helper()
+		conn, events := newAdminTestConn(t, token)
+		return connectorv1.NewAdminServiceClient(conn), events
+	}
+	forceOpen := &connectorv1.SetCircuitBreakerModeRequest{
+		Name: resilience.BreakerSlack,
+		Mode: connectorv1.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_FORCED_OPEN,
+	}
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(),
+			"authorization", "Bearer "+token, "x-actor", "mallory")
+	}
+
+	t.Run("rejects calls without the token", func(t *testing.T) {
+		client, events := newClient(t, "s3cret")
+
+		_, err := client.SetCircuitBreakerMode(context.Background(), forceOpen)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = client.SetCircuitBreakerMode(withToken("guess"), forceOpen)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = client.ListCircuitBreakers(context.Background(), &connectorv1.ListCircuitBreakersRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Empty(t, events)
+	})
+
+	t.Run("audits the authenticated admin", func(t *testing.T) {
+		client, events := newClient(t, "s3cret")
+
+		_, err := client.SetCircuitBreakerMode(withToken("s3cret"), forceOpen)
+		require.NoError(t, err)
+		event := <-events
+		assert.Equal(t, grpcTransport.AdminActor, event.Actor)
+		assert.Equal(t, domain.AuditActionCircuitBreakerSetMode, event.Action)
+	})
+
+	t.Run("refuses every call when no token is configured", func(t *testing.T) {
+		client, _ := newClient(t, "")
+
+		_, err := client.SetCircuitBreakerMode(withToken(""), forceOpen)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestBackupRPCs_RequireAdminToken(t *testing.T) {
+	conn, _ := newAdminTestConn(t, "s3cret")
+	client := connectorv1.NewConnectorServiceClient(conn)
+	// An unparsable key fails before the handler reaches the service.
+	req := &connectorv1.ExportConnectorsRequest{PublicKeyPem: "not a key"}
+
+	stream, err := client.ExportConnectors(context.Background(), req)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	restore, err := client.RestoreConnectors(context.Background())
+	require.NoError(t, err)
+	_, err = restore.CloseAndRecv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer s3cret")
+	stream, err = client.ExportConnectors(ctx, req)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
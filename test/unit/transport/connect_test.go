@@ -0,0 +1,174 @@
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/internal/app/connector"
+	"github.com/connector-recruitment/internal/domain"
+	grpcTransport "github.com/connector-recruitment/internal/transport/grpc"
+	httpTransport "github.com/connector-recruitment/internal/transport/http"
+	"github.com/connector-recruitment/pkg/requestctx"
+	"github.com/connector-recruitment/pkg/resilience"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/connector-recruitment/proto/gen/connector/v1/connectorv1connect"
+	"github.com/connector-recruitment/test/unit/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newConnectTestServer(t *testing.T, allowedOrigins []string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	// Requests below fail validation, so the handler never reaches the service.
+	mux.Handle(grpcTransport.NewConnectHandler(grpcTransport.NewHandler(nil)))
+	srv := httptest.NewServer(httpTransport.WithCORS(mux, allowedOrigins, time.Hour))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestConnectHandler_ValidationError(t *testing.T) {
+	srv := newConnectTestServer(t, nil)
+
+	for name, opts := range map[string][]connect.ClientOption{
+		"connect":  nil,
+		"grpc-web": {connect.WithGRPCWeb()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := connectorv1connect.NewConnectorServiceClient(srv.Client(), srv.URL, opts...)
+			req := connect.NewRequest(&connectorv1.GetConnectorRequest{Id: "not-a-uuid"})
+			req.Header().Set("X-Request-Id", "req-123")
+
+			_, err := client.GetConnector(context.Background(), req)
+			require.Error(t, err)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			assert.Equal(t, "req-123", connectErr.Meta().Get("X-Request-Id"))
+		})
+	}
+}
+
+func TestConnectHandler_ActorIsMarkedAsserted(t *testing.T) {
+	conn := &domain.Connector{ID: uuid.New(), WorkspaceID: "workspace123", TenantID: "tenant123"}
+	repo := new(mocks.MockConnectorRepository)
+	repo.On("GetByID", mock.Anything, conn.ID).Return(conn, nil)
+	auditLog := new(mocks.MockAuditLog)
+	var actor string
+	auditLog.On("Append", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		actor = args.Get(1).(*domain.AuditEvent).Actor
+	}).Return(nil)
+	breakers := resilience.NewRegistry(&config.Config{CircuitBreakerTimeout: time.Second})
+	svc := connector.NewService(repo, nil, nil, breakers, connector.WithAuditLog(auditLog))
+
+	mux := http.NewServeMux()
+	mux.Handle(grpcTransport.NewConnectHandler(grpcTransport.NewHandler(svc)))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client := connectorv1connect.NewConnectorServiceClient(srv.Client(), srv.URL)
+	req := connect.NewRequest(&connectorv1.GetConnectorRequest{Id: conn.ID.String()})
+	req.Header().Set("X-Actor", "alice@example.com")
+	_, err := client.GetConnector(context.Background(), req)
+	require.NoError(t, err)
+
+	// Nothing authenticates the header, so the audit log must not present
+	// the caller as verified.
+	assert.Equal(t, requestctx.AssertedActor("alice@example.com"), actor)
+}
+
+func TestConnectHandler_ExportRequiresAdminToken(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(grpcTransport.NewConnectHandler(grpcTransport.NewHandler(nil, grpcTransport.WithAdminToken("s3cret"))))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := connectorv1connect.NewConnectorServiceClient(srv.Client(), srv.URL)
+
+	export := func(token string) error {
+		// An unparsable key fails before the handler reaches the service.
+		req := connect.NewRequest(&connectorv1.ExportConnectorsRequest{PublicKeyPem: "not a key"})
+		if token != "" {
+			req.Header().Set("Authorization", "Bearer "+token)
+		}
+		stream, err := client.ExportConnectors(context.Background(), req)
+		require.NoError(t, err)
+		defer stream.Close()
+		for stream.Receive() {
+		}
+		return stream.Err()
+	}
+
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(export("")))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(export("guess")))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(export("s3cret")))
+}
+
+func TestWithCORS_Preflight(t *testing.T) {
+	srv := newConnectTestServer(t, []string{"https://app.example.com"})
+	path := srv.URL + connectorv1connect.ConnectorServiceGetConnectorProcedure
+
+	preflight := func(origin string) *http.Response {
+		req, err := http.NewRequest(http.MethodOptions, path, nil)
+		require.NoError(t, err)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "connect-protocol-version,content-type,x-grpc-web")
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	allowed := preflight("https://app.example.com")
+	assert.Equal(t, "https://app.example.com", allowed.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "3600", allowed.Header.Get("Access-Control-Max-Age"))
+
+	denied := preflight("https://evil.example.com")
+	assert.Empty(t, denied.Header.Get("Access-Control-Allow-Origin"))
+}
+
+func TestWithCORS_DisabledWithoutOrigins(t *testing.T) {
+	handler := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	httpTransport.WithCORS(handler, nil, time.Hour).ServeHTTP(rec, req)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestConnectHandler_WatchOutlivesWriteTimeout(t *testing.T) {
+	log := new(mocks.MockEventLog)
+	log.On("LatestSeq", mock.Anything).Return(int64(0), nil)
+	log.On("ListAfter", mock.Anything, mock.Anything, mock.Anything).Return([]domain.OutboxEntry{}, nil).Maybe()
+	watcher := connector.NewConnectorWatcher(log, time.Hour, connector.WithWatchHeartbeat(50*time.Millisecond))
+	watcher.RunOnce(context.Background())
+
+	const writeTimeout = 200 * time.Millisecond
+	mux := http.NewServeMux()
+	mux.Handle(grpcTransport.NewConnectHandler(grpcTransport.NewHandler(nil, grpcTransport.WithWatcher(watcher))))
+	srv := httptest.NewUnstartedServer(mux)
+	srv.Config.WriteTimeout = writeTimeout
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := connectorv1connect.NewConnectorServiceClient(srv.Client(), srv.URL)
+	stream, err := client.WatchConnectors(ctx, connect.NewRequest(&connectorv1.WatchConnectorsRequest{TenantId: "tenant123"}))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	start := time.Now()
+	for time.Since(start) < 3*writeTimeout {
+		require.True(t, stream.Receive(), "stream ended after %v: %v", time.Since(start), stream.Err())
+		assert.NotNil(t, stream.Msg().GetHeartbeat())
+	}
+}
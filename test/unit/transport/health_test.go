@@ -0,0 +1,95 @@
+package transport_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/health"
+	grpcTransport "github.com/connector-recruitment/internal/transport/grpc"
+	httpTransport "github.com/connector-recruitment/internal/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func newTestMonitor(redisErr *error) *health.Monitor {
+	ok := func(context.Context) error { return nil }
+	return health.NewMonitor([]health.Check{
+		{Name: health.CheckPostgres, Critical: true, Probe: ok},
+		{Name: health.CheckRedis, Critical: true, Probe: func(context.Context) error { return *redisErr }},
+		{Name: health.CheckSecretsManager, Critical: true, Probe: ok},
+	}, time.Minute)
+}
+
+func TestReadyz(t *testing.T) {
+	var redisErr error
+	monitor := newTestMonitor(&redisErr)
+	monitor.RunOnce(context.Background())
+	handler := httpTransport.ReadyzHandler(monitor)
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	redisErr = errors.New("dial tcp: connection refused")
+	monitor.RunOnce(context.Background())
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var report health.Report
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, health.StatusNotReady, report.Status)
+	assert.Equal(t, health.CheckDown, report.Checks[health.CheckRedis].Status)
+	assert.Equal(t, "dial tcp: connection refused", report.Checks[health.CheckRedis].Error)
+	assert.Equal(t, health.CheckUp, report.Checks[health.CheckPostgres].Status)
+
+	rec = httptest.NewRecorder()
+	httpTransport.Livez(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	assert.Equal(t, http.StatusOK, rec.Code, "liveness ignores dependencies")
+}
+
+func TestGRPCHealthFollowsMonitor(t *testing.T) {
+	var redisErr error
+	monitor := newTestMonitor(&redisErr)
+	monitor.RunOnce(context.Background())
+
+	server, lis, err := grpcTransport.NewServer(nil, nil, nil, nil, "0", grpcTransport.WithHealth(monitor))
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := healthpb.NewHealthClient(conn)
+
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status("connector.v1.ConnectorService"))
+
+	redisErr = errors.New("redis down")
+	monitor.RunOnce(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status("connector.v1.ConnectorService"))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status("connector.v1.TenantService"),
+		"services that do not use Redis keep serving")
+
+	redisErr = nil
+	monitor.RunOnce(context.Background())
+	monitor.Drain()
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status("connector.v1.TenantService"))
+}
@@ -0,0 +1,98 @@
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/internal/app/connector"
+	grpcTransport "github.com/connector-recruitment/internal/transport/grpc"
+	"github.com/connector-recruitment/pkg/resilience"
+	connectorv1 "github.com/connector-recruitment/proto/gen/connector/v1"
+	"github.com/connector-recruitment/proto/gen/connector/v1/connectorv1connect"
+	"github.com/connector-recruitment/test/unit/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newImportTestHandler returns a handler whose imports accept maxItems items.
+// The tests send only items missing their connector, which never reach the
+// service.
+func newImportTestHandler(maxItems int) *grpcTransport.Handler {
+	service := connector.NewService(new(mocks.MockConnectorRepository), new(mocks.MockSecretsManager), new(mocks.MockSlackClient),
+		resilience.NewRegistry(&config.Config{CircuitBreakerTimeout: time.Minute}))
+	importer := connector.NewImporter(service, connector.WithImportMaxItems(maxItems))
+	return grpcTransport.NewHandler(service, grpcTransport.WithImporter(importer))
+}
+
+func TestConnectHandler_ImportOverLimitReturnsReport(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(grpcTransport.NewConnectHandler(newImportTestHandler(2)))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client := connectorv1connect.NewConnectorServiceClient(srv.Client(), srv.URL)
+	stream := client.ImportConnectors(context.Background())
+	for i := 0; i < 4; i++ {
+		if err := stream.Send(&connectorv1.ImportConnectorsRequest{}); err != nil {
+			break
+		}
+	}
+	res, err := stream.CloseAndReceive()
+	require.NoError(t, err)
+
+	summary := res.Msg.Summary
+	assert.True(t, summary.Truncated)
+	assert.Equal(t, int32(3), summary.Total)
+	assert.Equal(t, int32(3), summary.Invalid)
+	require.Len(t, res.Msg.Results, 3)
+	assert.Contains(t, res.Msg.Results[2].Error, "limited to 2 connectors")
+}
+
+// importStream is a gRPC ImportConnectors stream that hands out its items and
+// then fails with err.
+type importStream struct {
+	grpc.ServerStream
+	items []*connectorv1.ImportConnectorsRequest
+	err   error
+}
+
+func (s *importStream) Context() context.Context { return context.Background() }
+
+func (s *importStream) Recv() (*connectorv1.ImportConnectorsRequest, error) {
+	if len(s.items) == 0 {
+		return nil, s.err
+	}
+	item := s.items[0]
+	s.items = s.items[1:]
+	return item, nil
+}
+
+func (s *importStream) SendAndClose(*connectorv1.ImportConnectorsResponse) error {
+	return errors.New("an import that failed must not send a response")
+}
+
+func TestHandler_ImportStreamErrorCarriesReport(t *testing.T) {
+	stream := &importStream{
+		items: []*connectorv1.ImportConnectorsRequest{{}, {}},
+		err:   status.Error(codes.Canceled, "client went away"),
+	}
+
+	err := newImportTestHandler(10).ImportConnectors(stream)
+	require.Error(t, err)
+	st := status.Convert(err)
+	assert.Equal(t, codes.Canceled, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	partial, ok := st.Details()[0].(*connectorv1.ImportConnectorsResponse)
+	require.True(t, ok, "detail is %T", st.Details()[0])
+	assert.Equal(t, int32(2), partial.Summary.Total)
+	assert.False(t, partial.Summary.Truncated)
+}
@@ -0,0 +1,190 @@
+package transport_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/connector-recruitment/internal/app/config"
+	"github.com/connector-recruitment/pkg/observability"
+	"github.com/connector-recruitment/pkg/resilience"
+	mocks2 "github.com/connector-recruitment/test/unit/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// metricValue returns the value of the counter or gauge called name whose
+// labels include labels, or of a histogram's sample count.
+func metricValue(t *testing.T, m *observability.Metrics, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := m.Gatherer().Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, metric := range family.GetMetric() {
+			got := make(map[string]string, len(metric.GetLabel()))
+			for _, label := range metric.GetLabel() {
+				got[label.GetName()] = label.GetValue()
+			}
+			for k, v := range labels {
+				if got[k] != v {
+					continue metrics
+				}
+			}
+			switch {
+			case metric.Counter != nil:
+				return metric.Counter.GetValue()
+			case metric.Gauge != nil:
+				return metric.Gauge.GetValue()
+			case metric.Histogram != nil:
+				return float64(metric.Histogram.GetSampleCount())
+			}
+		}
+	}
+	return 0
+}
+
+func TestMetrics_UnaryInterceptorCountsCodes(t *testing.T) {
+	m := observability.NewMetrics()
+	interceptor := m.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/connector.v1.ConnectorService/GetConnector"}
+
+	_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "connector not found")
+	})
+
+	for _, code := range []codes.Code{codes.OK, codes.NotFound} {
+		assert.Equal(t, 1.0, metricValue(t, m, "connector_rpc_requests_total", map[string]string{
+			"method": info.FullMethod, "code": code.String(),
+		}), code.String())
+	}
+	assert.Equal(t, 2.0, metricValue(t, m, "connector_rpc_duration_seconds", map[string]string{"method": info.FullMethod}))
+}
+
+func TestMetrics_InstrumentedSlackClient(t *testing.T) {
+	m := observability.NewMetrics()
+	slack := new(mocks2.MockSlackClient)
+	slack.On("SendMessage", mock.Anything, "token", "C1", "hello").Return(nil).Once()
+	slack.On("SendMessage", mock.Anything, "token", "C1", "hello").Return(errors.New("channel_not_found")).Once()
+
+	client := observability.InstrumentSlackClient(slack, m)
+	require.NoError(t, client.SendMessage(context.Background(), "token", "C1", "hello"))
+	require.Error(t, client.SendMessage(context.Background(), "token", "C1", "hello"))
+
+	for _, outcome := range []string{"success", "error"} {
+		assert.Equal(t, 1.0, metricValue(t, m, "connector_dependency_requests_total", map[string]string{
+			"dependency": observability.DependencySlack, "operation": "send_message", "outcome": outcome,
+		}), outcome)
+	}
+}
+
+func TestMetrics_InstrumentedRepositoryIgnoresNoRows(t *testing.T) {
+	m := observability.NewMetrics()
+	repo := new(mocks2.MockConnectorRepository)
+	repo.On("GetByID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+
+	_, err := observability.InstrumentConnectorRepository(repo, m).GetByID(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, sql.ErrNoRows, "the error is still returned")
+
+	labels := map[string]string{"dependency": observability.DependencyPostgres, "operation": "connector_get_by_id"}
+	labels["outcome"] = "success"
+	assert.Equal(t, 1.0, metricValue(t, m, "connector_dependency_requests_total", labels))
+	labels["outcome"] = "error"
+	assert.Equal(t, 0.0, metricValue(t, m, "connector_dependency_requests_total", labels))
+}
+
+func TestMetrics_NilIsDisabled(t *testing.T) {
+	slack := new(mocks2.MockSlackClient)
+	assert.Same(t, slack, observability.InstrumentSlackClient(slack, nil))
+
+	var m *observability.Metrics
+	m.ObserveRPC("/method", codes.OK, time.Second)
+	m.RecordRotation(nil)
+
+	families, err := m.Gatherer().Gather()
+	assert.NoError(t, err)
+	assert.Empty(t, families)
+}
+
+func TestMetrics_CircuitBreakerState(t *testing.T) {
+	m := observability.NewMetrics()
+	cb := resilience.New("slack", &config.Config{
+		CircuitBreakerInterval: time.Minute,
+		CircuitBreakerTimeout:  time.Minute,
+	}, resilience.WithMetrics(m))
+
+	assert.Equal(t, 1.0, metricValue(t, m, "connector_circuit_breaker_state", map[string]string{"breaker": "slack", "state": "closed"}))
+
+	for i := 0; i < 6; i++ {
+		_, _ = cb.Execute(context.Background(), func() (interface{}, error) {
+			return nil, errors.New("boom")
+		})
+	}
+	assert.Equal(t, 0.0, metricValue(t, m, "connector_circuit_breaker_state", map[string]string{"breaker": "slack", "state": "closed"}))
+	assert.Equal(t, 1.0, metricValue(t, m, "connector_circuit_breaker_state", map[string]string{"breaker": "slack", "state": "open"}))
+}
+
+func TestMetrics_WorkspaceCircuitBreakersAreCounted(t *testing.T) {
+	m := observability.NewMetrics()
+	breakers := resilience.NewRegistry(&config.Config{
+		CircuitBreakerInterval:      time.Minute,
+		CircuitBreakerTimeout:       time.Minute,
+		CircuitBreakerMaxWorkspaces: 1,
+	}, resilience.WithMetrics(m))
+	workspaceState := func(state string) float64 {
+		return metricValue(t, m, "connector_circuit_breaker_workspaces", map[string]string{"state": state})
+	}
+
+	cb := breakers.Get(resilience.SlackWorkspace("T1"))
+	for i := 0; i < 6; i++ {
+		_ = cb.Do(context.Background(), func() error { return errors.New("boom") })
+	}
+	assert.Equal(t, 1.0, workspaceState("open"))
+	assert.Equal(t, 0.0, workspaceState("closed"))
+
+	// T1 is dropped to make room for T2.
+	breakers.Get(resilience.SlackWorkspace("T2"))
+	assert.Equal(t, 0.0, workspaceState("open"))
+	assert.Equal(t, 1.0, workspaceState("closed"))
+
+	families, err := m.Gatherer().Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() == "connector_circuit_breaker_state" {
+			t.Errorf("per-workspace breakers exported %d state series", len(family.GetMetric()))
+		}
+	}
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	m := observability.NewMetrics()
+	m.RecordRotation(errors.New("slack unavailable"))
+
+	srv := httptest.NewServer(m.Handler())
+	t.Cleanup(srv.Close)
+	res, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Contains(t, string(body), `connector_secret_rotations_total{outcome="error"} 1`)
+	assert.Contains(t, string(body), "go_goroutines")
+}
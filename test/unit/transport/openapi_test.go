@@ -0,0 +1,85 @@
+package transport_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/connector-recruitment/internal/openapi"
+	httpTransport "github.com/connector-recruitment/internal/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPISpec_UpToDate(t *testing.T) {
+	generated, err := httpTransport.GenerateOpenAPISpec()
+	require.NoError(t, err)
+	assert.Equal(t, string(generated), string(httpTransport.OpenAPISpec),
+		"openapi.json is stale; run go generate ./internal/transport/http")
+}
+
+func TestOpenAPISpec_Content(t *testing.T) {
+	var doc openapi.Document
+	require.NoError(t, json.Unmarshal(httpTransport.OpenAPISpec, &doc))
+
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	require.Contains(t, doc.Paths, "/v1/connectors")
+	require.Contains(t, doc.Paths, "/v1/connectors/{id}")
+	require.Contains(t, doc.Paths, "/v1/connectors/{id}:restore")
+
+	create := doc.Paths["/v1/connectors"].Post
+	require.NotNil(t, create)
+	assert.Equal(t, "ConnectorService_CreateConnector", create.OperationID)
+	assert.Equal(t, "#/components/schemas/CreateConnectorRequest",
+		create.RequestBody.Content["application/json"].Schema.Ref)
+
+	request := doc.Components.Schemas["CreateConnectorRequest"]
+	require.NotNil(t, request)
+	token := request.Properties["token"]
+	require.NotNil(t, token.MinLength)
+	require.NotNil(t, token.MaxLength)
+	assert.Equal(t, uint64(4), *token.MinLength)
+	assert.Equal(t, uint64(512), *token.MaxLength)
+	assert.Equal(t, "^[A-Za-z0-9_-]+$", request.Properties["workspace_id"].Pattern)
+	assert.ElementsMatch(t, []string{"workspace_id", "tenant_id", "token", "default_channel_name"}, request.Required)
+	assert.Nil(t, request.Properties["idempotency_key"].MinLength)
+
+	del := doc.Paths["/v1/connectors/{id}"].Delete
+	require.NotNil(t, del)
+	params := map[string]openapi.Parameter{}
+	for _, p := range del.Parameters {
+		params[p.Name] = p
+	}
+	assert.Equal(t, "path", params["id"].In)
+	assert.Equal(t, "query", params["workspace_id"].In)
+	assert.True(t, params["tenant_id"].Required)
+
+	connector := doc.Components.Schemas["Connector"]
+	require.NotNil(t, connector)
+	assert.Equal(t, "date-time", connector.Properties["created_at"].Format)
+	assert.Contains(t, connector.Properties["status"].Enum, "CONNECTOR_STATUS_ACTIVE")
+	assert.Contains(t, doc.Components.Schemas, "Status")
+}
+
+func TestServeOpenAPI(t *testing.T) {
+	rec := httptest.NewRecorder()
+	httpTransport.ServeOpenAPI(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, httpTransport.OpenAPISpec, rec.Body.Bytes())
+
+	rec = httptest.NewRecorder()
+	httpTransport.ServeOpenAPI(rec, httptest.NewRequest(http.MethodPost, "/openapi.json", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestSwaggerUIHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	httpTransport.SwaggerUIHandler("https://assets.example.com/swagger")(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `https://assets.example.com/swagger/swagger-ui-bundle.js`)
+	assert.Contains(t, rec.Body.String(), `url: "/openapi.json"`)
+}